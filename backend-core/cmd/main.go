@@ -14,9 +14,11 @@ import (
 	"github.com/Wave-95/boards/backend-core/internal/board"
 	"github.com/Wave-95/boards/backend-core/internal/config"
 	"github.com/Wave-95/boards/backend-core/internal/endpoint"
+	"github.com/Wave-95/boards/backend-core/internal/jobs"
 	"github.com/Wave-95/boards/backend-core/internal/jwt"
 	"github.com/Wave-95/boards/backend-core/internal/middleware"
 	"github.com/Wave-95/boards/backend-core/internal/post"
+	"github.com/Wave-95/boards/backend-core/internal/storage"
 	"github.com/Wave-95/boards/backend-core/internal/user"
 	"github.com/Wave-95/boards/backend-core/internal/ws"
 	"github.com/Wave-95/boards/backend-core/pkg/logger"
@@ -50,13 +52,23 @@ func main() {
 	}
 	amqp.Declare(queues.Notification, 10000, true) //10sec ttl with dlx
 
+	// Set up attachment storage
+	attachmentStorage, err := storage.New(cfg.Storage)
+	if err != nil {
+		logger.Fatalf("Error setting up storage: %v", err)
+	}
+
 	// Setup server
 	r := chi.NewRouter()
 	server := http.Server{
 		Addr:    cfg.ServerPort,
-		Handler: setupHandler(r, conn, amqp, logger, validator, cfg),
+		Handler: setupHandler(r, conn, amqp, attachmentStorage, logger, validator, cfg),
 	}
 
+	// Purge boards that have been in the trash for longer than the retention period
+	trashJob := jobs.NewTrashJob(board.NewService(board.NewRepository(conn), attachmentStorage, amqp, validator, cfg.Trash.Retention), cfg.Trash.Retention, cfg.Trash.PurgeInterval)
+	go trashJob.Run()
+
 	// Graceful shutdown
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
@@ -80,6 +92,7 @@ func setupHandler(
 	r chi.Router,
 	db *db.DB,
 	amqp amqp.Amqp,
+	attachmentStorage storage.Storage,
 	logger logger.Logger,
 	v validator.Validate,
 	cfg *config.Config,
@@ -97,24 +110,27 @@ func setupHandler(
 	jwtService := jwt.New(cfg.JwtSecret, cfg.JwtExpiration)
 	authService := auth.NewService(userRepo, jwtService, v)
 	userService := user.NewService(userRepo, amqp, v)
-	boardService := board.NewService(boardRepo, amqp, v)
-	postService := post.NewService(postRepo)
+	boardService := board.NewService(boardRepo, attachmentStorage, amqp, v, cfg.Trash.Retention)
+	postService := post.NewService(postRepo, attachmentStorage, boardService, amqp)
 	rdb := ws.NewRedis(cfg.Rdb)
 
 	// Set up APIs
+	websocket := ws.NewWebSocket(userService, boardService, postService, jwtService, rdb)
+	// Emit timer.finished events for board timers that run out
+	go websocket.RunTimers()
 	userAPI := user.NewAPI(userService, jwtService, v)
 	authAPI := auth.NewAPI(authService, v)
-	boardAPI := board.NewAPI(boardService, v)
+	boardAPI := board.NewAPI(boardService, websocket, v)
 	postAPI := post.NewAPI(postService, boardService, v)
-	websocket := ws.NewWebSocket(userService, boardService, postService, jwtService, rdb)
 
-	// Set up auth handler
+	// Set up auth handlers
 	authHandler := middleware.Auth(jwtService)
+	serviceHandler := middleware.ServiceAuth(cfg.ServiceToken)
 
 	// Register handlers
 	userAPI.RegisterHandlers(r, authHandler)
 	authAPI.RegisterHandlers(r)
-	boardAPI.RegisterHandlers(r, authHandler)
+	boardAPI.RegisterHandlers(r, authHandler, serviceHandler)
 	postAPI.RegisterHandlers(r, authHandler)
 	websocket.RegisterHandlers(r)
 	r.Get("/ping", handlePingCheck)
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS board_invite_links (
+  id UUID PRIMARY KEY,
+  board_id UUID REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+  token VARCHAR(64) NOT NULL UNIQUE,
+  role VARCHAR(20) NOT NULL,
+  max_uses INTEGER,
+  use_count INTEGER NOT NULL DEFAULT 0,
+  expires_at TIMESTAMP,
+  revoked_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_board_invite_links_board_id ON board_invite_links (board_id);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS board_email_invites (
+  id UUID PRIMARY KEY,
+  board_id UUID REFERENCES boards(id) ON DELETE CASCADE,
+  sender_id UUID REFERENCES users(id) ON DELETE CASCADE,
+  email VARCHAR(255) NOT NULL,
+  status VARCHAR(20) DEFAULT 'PENDING',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_board_email_invites_email ON board_email_invites (email);
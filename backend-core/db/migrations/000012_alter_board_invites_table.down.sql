@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_board_invites_status_expires_at;
+
+ALTER TABLE board_invites
+DROP COLUMN IF EXISTS expires_at,
+DROP COLUMN IF EXISTS reminded_at;
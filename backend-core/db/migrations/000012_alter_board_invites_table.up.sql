@@ -0,0 +1,9 @@
+ALTER TABLE board_invites
+ADD COLUMN expires_at TIMESTAMP,
+ADD COLUMN reminded_at TIMESTAMP;
+
+-- Give existing pending invites the default invite expiry of 14 days so that they can expire
+UPDATE board_invites SET expires_at = created_at + interval '14 days'
+WHERE expires_at IS NULL AND status = 'PENDING';
+
+CREATE INDEX idx_board_invites_status_expires_at ON board_invites (status, expires_at);
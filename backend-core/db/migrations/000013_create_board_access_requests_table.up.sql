@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS board_access_requests (
+  id UUID PRIMARY KEY,
+  board_id UUID REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+  status VARCHAR(20) DEFAULT 'PENDING',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_board_access_requests_board_id ON board_access_requests (board_id);
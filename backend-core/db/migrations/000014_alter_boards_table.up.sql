@@ -0,0 +1,2 @@
+ALTER TABLE boards
+ADD COLUMN allowed_domains TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_boards_deleted_at;
+
+ALTER TABLE boards
+DROP COLUMN IF EXISTS archived_at,
+DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,5 @@
+ALTER TABLE boards
+ADD COLUMN archived_at TIMESTAMP,
+ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX idx_boards_deleted_at ON boards (deleted_at);
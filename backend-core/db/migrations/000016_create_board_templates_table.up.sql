@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS board_templates (
+  id UUID PRIMARY KEY,
+  user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+  name VARCHAR(255) NOT NULL,
+  description TEXT,
+  post_groups JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_board_templates_user_id ON board_templates (user_id);
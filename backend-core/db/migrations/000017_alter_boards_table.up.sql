@@ -0,0 +1,2 @@
+ALTER TABLE boards
+ADD COLUMN phase VARCHAR(20) NOT NULL DEFAULT 'BRAINSTORM';
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS post_votes (
+  id UUID PRIMARY KEY,
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_post_votes_board_id_user_id ON post_votes (board_id, user_id);
+CREATE INDEX idx_post_votes_post_id ON post_votes (post_id);
@@ -0,0 +1,3 @@
+ALTER TABLE boards
+DROP COLUMN IF EXISTS vote_limit,
+DROP COLUMN IF EXISTS hide_votes;
@@ -0,0 +1,3 @@
+ALTER TABLE boards
+ADD COLUMN vote_limit INTEGER NOT NULL DEFAULT 5,
+ADD COLUMN hide_votes BOOLEAN NOT NULL DEFAULT FALSE;
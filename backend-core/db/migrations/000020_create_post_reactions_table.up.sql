@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS post_reactions (
+  id UUID PRIMARY KEY,
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  emoji VARCHAR(32) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  UNIQUE (post_id, user_id, emoji)
+);
+
+CREATE INDEX idx_post_reactions_board_id ON post_reactions (board_id);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS action_items (
+  id UUID PRIMARY KEY,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  post_id UUID REFERENCES posts(id) ON DELETE SET NULL,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  assignee_id UUID REFERENCES users(id) ON DELETE SET NULL,
+  title VARCHAR(255) NOT NULL,
+  due_date DATE,
+  status VARCHAR(20) NOT NULL DEFAULT 'OPEN',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_action_items_board_id ON action_items (board_id);
+CREATE INDEX idx_action_items_assignee_id ON action_items (assignee_id);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS board_tags (
+  id UUID PRIMARY KEY,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  name VARCHAR(50) NOT NULL,
+  color VARCHAR(7) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  UNIQUE (board_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS post_tags (
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  tag_id UUID NOT NULL REFERENCES board_tags(id) ON DELETE CASCADE,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL,
+  PRIMARY KEY (post_id, tag_id)
+);
+
+CREATE INDEX idx_post_tags_board_id ON post_tags (board_id);
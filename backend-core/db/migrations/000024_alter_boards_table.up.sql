@@ -0,0 +1,2 @@
+ALTER TABLE boards
+ADD COLUMN anonymous_posts BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+ALTER TABLE boards
+DROP COLUMN IF EXISTS hide_posts;
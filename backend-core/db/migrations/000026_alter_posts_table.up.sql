@@ -0,0 +1,2 @@
+ALTER TABLE posts
+ADD COLUMN content_format VARCHAR(20) NOT NULL DEFAULT 'PLAIN';
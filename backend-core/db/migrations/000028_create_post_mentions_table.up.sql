@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS post_mentions (
+  id UUID PRIMARY KEY,
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  comment_id UUID REFERENCES post_comments(id) ON DELETE CASCADE,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  mentioned_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_post_mentions_post_id_created_at ON post_mentions (post_id, created_at);
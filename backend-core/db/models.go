@@ -8,13 +8,53 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ActionItem struct {
+	ID         pgtype.UUID
+	BoardID    pgtype.UUID
+	PostID     pgtype.UUID
+	UserID     pgtype.UUID
+	AssigneeID pgtype.UUID
+	Title      string
+	DueDate    pgtype.Date
+	Status     string
+	CreatedAt  pgtype.Timestamp
+	UpdatedAt  pgtype.Timestamp
+}
+
 type Board struct {
-	ID          pgtype.UUID
-	Name        pgtype.Text
-	Description pgtype.Text
-	UserID      pgtype.UUID
-	CreatedAt   pgtype.Timestamp
-	UpdatedAt   pgtype.Timestamp
+	ID             pgtype.UUID
+	Name           pgtype.Text
+	Description    pgtype.Text
+	UserID         pgtype.UUID
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+	AllowedDomains []string
+	ArchivedAt     pgtype.Timestamp
+	DeletedAt      pgtype.Timestamp
+	Phase          string
+	VoteLimit      int32
+	HideVotes      bool
+	AnonymousPosts bool
+	HidePosts      bool
+}
+
+type BoardAccessRequest struct {
+	ID        pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	Status    pgtype.Text
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+type BoardEmailInvite struct {
+	ID        pgtype.UUID
+	BoardID   pgtype.UUID
+	SenderID  pgtype.UUID
+	Email     string
+	Status    pgtype.Text
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
 }
 
 type BoardInvite struct {
@@ -25,6 +65,40 @@ type BoardInvite struct {
 	Status     pgtype.Text
 	CreatedAt  pgtype.Timestamp
 	UpdatedAt  pgtype.Timestamp
+	ExpiresAt  pgtype.Timestamp
+	RemindedAt pgtype.Timestamp
+}
+
+type BoardInviteLink struct {
+	ID        pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	Token     string
+	Role      string
+	MaxUses   pgtype.Int4
+	UseCount  int32
+	ExpiresAt pgtype.Timestamp
+	RevokedAt pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+type BoardTag struct {
+	ID        pgtype.UUID
+	BoardID   pgtype.UUID
+	Name      string
+	Color     string
+	CreatedAt pgtype.Timestamp
+}
+
+type BoardTemplate struct {
+	ID          pgtype.UUID
+	UserID      pgtype.UUID
+	Name        string
+	Description pgtype.Text
+	PostGroups  []byte
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
 }
 
 type BoardMembership struct {
@@ -46,15 +120,38 @@ type EmailVerification struct {
 }
 
 type Post struct {
+	ID            pgtype.UUID
+	UserID        pgtype.UUID
+	Content       pgtype.Text
+	Color         pgtype.Text
+	Height        pgtype.Int4
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	PostOrder     pgtype.Float8
+	PostGroupID   pgtype.UUID
+	ContentFormat string
+}
+
+type PostAttachment struct {
 	ID          pgtype.UUID
+	PostID      pgtype.UUID
+	BoardID     pgtype.UUID
 	UserID      pgtype.UUID
-	Content     pgtype.Text
-	Color       pgtype.Text
-	Height      pgtype.Int4
+	FileName    string
+	ContentType string
+	Size        int64
 	CreatedAt   pgtype.Timestamp
-	UpdatedAt   pgtype.Timestamp
-	PostOrder   pgtype.Float8
-	PostGroupID pgtype.UUID
+}
+
+type PostComment struct {
+	ID        pgtype.UUID
+	PostID    pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	ParentID  pgtype.UUID
+	Content   string
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
 }
 
 type PostGroup struct {
@@ -68,6 +165,40 @@ type PostGroup struct {
 	UpdatedAt pgtype.Timestamp
 }
 
+type PostMention struct {
+	ID          pgtype.UUID
+	PostID      pgtype.UUID
+	CommentID   pgtype.UUID
+	BoardID     pgtype.UUID
+	UserID      pgtype.UUID
+	MentionedBy pgtype.UUID
+	CreatedAt   pgtype.Timestamp
+}
+
+type PostReaction struct {
+	ID        pgtype.UUID
+	PostID    pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	Emoji     string
+	CreatedAt pgtype.Timestamp
+}
+
+type PostTag struct {
+	PostID    pgtype.UUID
+	TagID     pgtype.UUID
+	BoardID   pgtype.UUID
+	CreatedAt pgtype.Timestamp
+}
+
+type PostVote struct {
+	ID        pgtype.UUID
+	PostID    pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	CreatedAt pgtype.Timestamp
+}
+
 type User struct {
 	ID         pgtype.UUID
 	Name       pgtype.Text
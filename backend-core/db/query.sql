@@ -22,8 +22,8 @@ WHERE users.id = $1;
 
 -- name: CreateBoard :exec
 INSERT INTO boards 
-(id, name, description, user_id, created_at, updated_at) 
-VALUES ($1, $2, $3, $4, $5, $6);
+(id, name, description, user_id, created_at, updated_at, allowed_domains) 
+VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: GetBoard :one
 SELECT * FROM boards
@@ -46,6 +46,8 @@ SELECT sqlc.embed(boards), sqlc.embed(users), sqlc.embed(board_memberships) FROM
 INNER JOIN board_memberships on board_memberships.board_id = boards.id
 INNER JOIN users on board_memberships.user_id = users.id
 WHERE boards.user_id = $1
+AND boards.archived_at IS NULL
+AND boards.deleted_at IS NULL
 ORDER BY boards.created_at DESC;
 
 -- name: ListSharedBoardAndUsers :many
@@ -53,21 +55,71 @@ SELECT sqlc.embed(boards), sqlc.embed(users), sqlc.embed(board_memberships) FROM
 INNER JOIN board_memberships on board_memberships.board_id = boards.id
 INNER JOIN users on board_memberships.user_id = users.id
 WHERE board_memberships.user_id = $1
-AND board_memberships.role = 'MEMBER'
+AND boards.user_id <> $1
+AND boards.archived_at IS NULL
+AND boards.deleted_at IS NULL
 ORDER BY board_memberships.created_at DESC;
 
+-- name: ListArchivedBoardAndUsers :many
+SELECT sqlc.embed(boards), sqlc.embed(users), sqlc.embed(board_memberships) FROM boards
+INNER JOIN board_memberships on board_memberships.board_id = boards.id
+INNER JOIN users on board_memberships.user_id = users.id
+WHERE boards.id IN (
+  SELECT board_id FROM board_memberships WHERE board_memberships.user_id = $1
+)
+AND boards.archived_at IS NOT NULL
+AND boards.deleted_at IS NULL
+ORDER BY boards.archived_at DESC;
+
+-- name: ListTrashedBoards :many
+SELECT boards.* FROM boards
+INNER JOIN board_memberships on board_memberships.board_id = boards.id
+WHERE board_memberships.user_id = $1
+AND board_memberships.role = 'ADMIN'
+AND boards.deleted_at IS NOT NULL
+ORDER BY boards.deleted_at DESC;
+
 -- name: CreateMembership :exec
 INSERT INTO board_memberships 
 (id, user_id, board_id, role, created_at, updated_at) 
 VALUES ($1, $2, $3, $4, $5, $6);
 
+-- name: UpdateMembership :exec
+UPDATE board_memberships SET
+(role, updated_at) =
+($3, $4) WHERE board_id = $1 AND user_id = $2;
+
+-- name: DeleteMembership :exec
+DELETE FROM board_memberships
+WHERE board_id = $1 AND user_id = $2;
+
+-- name: UpdateBoard :exec
+UPDATE boards SET
+(id, name, description, user_id, created_at, updated_at, allowed_domains, archived_at, deleted_at, phase, vote_limit, hide_votes, anonymous_posts, hide_posts) =
+($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) WHERE id = $1;
+
 -- name: DeleteBoard :exec
 DELETE from boards WHERE id = $1;
 
+-- name: PurgePosts :exec
+DELETE FROM posts USING post_groups, boards
+WHERE posts.post_group_id = post_groups.id
+AND post_groups.board_id = boards.id
+AND boards.deleted_at < $1;
+
+-- name: PurgePostGroups :exec
+DELETE FROM post_groups USING boards
+WHERE post_groups.board_id = boards.id
+AND boards.deleted_at < $1;
+
+-- name: PurgeBoards :execrows
+DELETE FROM boards
+WHERE boards.deleted_at < $1;
+
 -- name: CreatePost :exec
 INSERT INTO posts
-(id, user_id, content, color, height, created_at, updated_at, post_order, post_group_id) 
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+(id, user_id, content, color, height, created_at, updated_at, post_order, post_group_id, content_format) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
 
 -- name: CreatePostGroup :exec
 INSERT INTO post_groups
@@ -80,14 +132,25 @@ WHERE posts.id = $1;
 
 -- name: ListPostGroups :many
 SELECT sqlc.embed(post_groups), sqlc.embed(posts) FROM post_groups
-INNER JOIN posts on posts.post_group_id = post_groups.id
+LEFT JOIN posts on posts.post_group_id = post_groups.id
+WHERE post_groups.board_id = $1
+ORDER BY posts.post_order ASC;
+
+-- name: ListPostGroupsByBoard :many
+SELECT * FROM post_groups
+WHERE post_groups.board_id = $1
+ORDER BY post_groups.created_at ASC;
+
+-- name: ListPostsByBoard :many
+SELECT posts.* FROM posts
+INNER JOIN post_groups on posts.post_group_id = post_groups.id
 WHERE post_groups.board_id = $1
 ORDER BY posts.post_order ASC;
 
 -- name: UpdatePost :exec
 UPDATE posts SET
-(id, user_id, content, color, height, created_at, updated_at, post_order, post_group_id) =
-($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE id = $1;
+(id, user_id, content, color, height, created_at, updated_at, post_order, post_group_id, content_format) =
+($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) WHERE id = $1;
 
 -- name: DeletePost :exec
 DELETE from posts WHERE id = $1;
@@ -104,14 +167,170 @@ UPDATE post_groups SET
 -- name: DeletePostGroup :exec
 DELETE from post_groups WHERE id = $1;
 
+-- name: LockPostVotes :exec
+SELECT pg_advisory_xact_lock(hashtext(@board_id::uuid::text), hashtext(@user_id::uuid::text));
+
+-- name: CreatePostVote :execrows
+INSERT INTO post_votes
+(id, post_id, board_id, user_id, created_at)
+SELECT @id::uuid, @post_id::uuid, @board_id::uuid, @user_id::uuid, @created_at::timestamp
+WHERE (
+  SELECT COUNT(*) FROM post_votes
+  WHERE post_votes.board_id = @board_id::uuid AND post_votes.user_id = @user_id::uuid
+) < @vote_limit::int;
+
+-- name: DeletePostVote :execrows
+DELETE FROM post_votes
+WHERE id = (
+  SELECT post_votes.id FROM post_votes
+  WHERE post_votes.post_id = $1 AND post_votes.user_id = $2
+  ORDER BY post_votes.created_at DESC
+  LIMIT 1
+);
+
+-- name: CountUserVotesByBoard :one
+SELECT COUNT(*) FROM post_votes
+WHERE post_votes.board_id = $1 AND post_votes.user_id = $2;
+
+-- name: ListPostVoteCounts :many
+SELECT post_votes.post_id, COUNT(*) AS votes, COUNT(*) FILTER (WHERE post_votes.user_id = $2) AS user_votes
+FROM post_votes
+WHERE post_votes.board_id = $1
+GROUP BY post_votes.post_id;
+
+-- name: CreatePostReaction :execrows
+INSERT INTO post_reactions
+(id, post_id, board_id, user_id, emoji, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (post_id, user_id, emoji) DO NOTHING;
+
+-- name: DeletePostReaction :execrows
+DELETE FROM post_reactions
+WHERE post_id = $1 AND user_id = $2 AND emoji = $3;
+
+-- name: ListPostReactionsByBoard :many
+SELECT post_reactions.post_id, post_reactions.emoji, ARRAY_AGG(post_reactions.user_id ORDER BY post_reactions.created_at)::uuid[] AS user_ids
+FROM post_reactions
+WHERE post_reactions.board_id = $1
+GROUP BY post_reactions.post_id, post_reactions.emoji
+ORDER BY MIN(post_reactions.created_at) ASC;
+
+-- name: CreatePostTag :execrows
+INSERT INTO post_tags
+(post_id, tag_id, board_id, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (post_id, tag_id) DO NOTHING;
+
+-- name: DeletePostTag :execrows
+DELETE FROM post_tags
+WHERE post_id = $1 AND tag_id = $2;
+
+-- name: ListPostTagsByBoard :many
+SELECT post_tags.post_id, post_tags.tag_id FROM post_tags
+WHERE post_tags.board_id = $1
+ORDER BY post_tags.created_at ASC;
+
+-- name: CreatePostComment :exec
+INSERT INTO post_comments
+(id, post_id, board_id, user_id, parent_id, content, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetPostComment :one
+SELECT * FROM post_comments
+WHERE post_comments.id = $1;
+
+-- name: ListPostCommentsByPost :many
+SELECT * FROM post_comments
+WHERE post_comments.post_id = $1
+ORDER BY post_comments.created_at ASC;
+
+-- name: UpdatePostComment :exec
+UPDATE post_comments SET
+(content, updated_at) = ($2, $3) WHERE id = $1;
+
+-- name: DeletePostComment :exec
+DELETE FROM post_comments WHERE id = $1;
+
+-- name: CreatePostAttachment :exec
+INSERT INTO post_attachments
+(id, post_id, board_id, user_id, file_name, content_type, size, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetPostAttachment :one
+SELECT * FROM post_attachments
+WHERE post_attachments.id = $1;
+
+-- name: ListPostAttachmentsByPost :many
+SELECT * FROM post_attachments
+WHERE post_attachments.post_id = $1
+ORDER BY post_attachments.created_at ASC;
+
+-- name: ListPostAttachmentsByPostGroup :many
+SELECT post_attachments.* FROM post_attachments
+JOIN posts ON posts.id = post_attachments.post_id
+WHERE posts.post_group_id = $1;
+
+-- name: ListPurgeablePostAttachments :many
+SELECT post_attachments.* FROM post_attachments
+JOIN boards ON boards.id = post_attachments.board_id
+WHERE boards.deleted_at < $1;
+
+-- name: DeletePostAttachment :exec
+DELETE FROM post_attachments WHERE id = $1;
+
+-- name: CreatePostMention :exec
+INSERT INTO post_mentions
+(id, post_id, comment_id, board_id, user_id, mentioned_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: ListPostMentionsByPost :many
+SELECT * FROM post_mentions
+WHERE post_mentions.post_id = $1
+ORDER BY post_mentions.created_at ASC;
+
+-- name: DeletePostMention :exec
+DELETE FROM post_mentions WHERE id = $1;
+
+-- name: CreateActionItem :exec
+INSERT INTO action_items
+(id, board_id, post_id, user_id, assignee_id, title, due_date, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: GetActionItem :one
+SELECT * FROM action_items
+WHERE action_items.id = $1;
+
+-- name: ListActionItemsByBoard :many
+SELECT * FROM action_items
+WHERE action_items.board_id = $1
+ORDER BY action_items.created_at ASC;
+
+-- name: ListActionItemsByAssignee :many
+SELECT sqlc.embed(action_items), boards.name AS board_name FROM action_items
+INNER JOIN boards ON boards.id = action_items.board_id
+INNER JOIN board_memberships ON board_memberships.board_id = action_items.board_id
+  AND board_memberships.user_id = action_items.assignee_id
+WHERE action_items.assignee_id = $1 AND boards.deleted_at IS NULL
+ORDER BY action_items.status DESC, action_items.due_date ASC NULLS LAST, action_items.created_at ASC;
+
+-- name: UpdateActionItem :exec
+UPDATE action_items SET
+(assignee_id, title, due_date, status, updated_at) =
+($2, $3, $4, $5, $6) WHERE id = $1;
+
+-- name: DeleteActionItem :exec
+DELETE FROM action_items WHERE id = $1;
+
 -- name: ListUsersByFuzzyEmail :many
 SELECT * FROM users
 ORDER BY levenshtein(users.email, $1) LIMIT 10;
 
 -- name: CreateInvite :exec
 INSERT INTO board_invites
-(id, board_id, sender_id, receiver_id, status, created_at, updated_at) 
-VALUES ($1, $2, $3, $4, $5, $6, $7);
+(id, board_id, sender_id, receiver_id, status, created_at, updated_at, expires_at, reminded_at) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (id) DO UPDATE SET
+(updated_at, expires_at, reminded_at) = (EXCLUDED.updated_at, EXCLUDED.expires_at, EXCLUDED.reminded_at);
 
 -- name: GetInvite :one
 SELECT sqlc.embed(board_invites), sqlc.embed(s), sqlc.embed(r) FROM board_invites
@@ -121,8 +340,8 @@ WHERE board_invites.id = $1;
 
 -- name: UpdateInvite :exec
 UPDATE board_invites SET
-(id, board_id, sender_id, receiver_id, status, created_at, updated_at) =
-($1, $2, $3, $4, $5, $6, $7) WHERE id = $1;
+(id, board_id, sender_id, receiver_id, status, created_at, updated_at, expires_at, reminded_at) =
+($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE id = $1;
 
 -- name: ListInvitesByBoard :many
 SELECT sqlc.embed(board_invites), sqlc.embed(users) FROM board_invites
@@ -139,6 +358,122 @@ WHERE board_invites.receiver_id = sqlc.arg('receiver_id') AND
 (status = sqlc.narg('status') OR sqlc.narg('status') IS NULL)
 ORDER BY board_invites.updated_at DESC;
 
+-- name: MarkInvitesReminded :many
+UPDATE board_invites SET reminded_at = sqlc.arg('now')
+WHERE status = 'PENDING' AND reminded_at IS NULL AND
+expires_at > sqlc.arg('now') AND expires_at <= sqlc.arg('remind_before')
+RETURNING id;
+
+-- name: ExpireInvites :execrows
+UPDATE board_invites SET (status, updated_at) = ('EXPIRED', sqlc.arg('now'))
+WHERE status = 'PENDING' AND expires_at <= sqlc.arg('now');
+
+-- name: CreateInviteLink :exec
+INSERT INTO board_invite_links
+(id, board_id, user_id, token, role, max_uses, use_count, expires_at, revoked_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: GetInviteLink :one
+SELECT * FROM board_invite_links
+WHERE board_invite_links.id = $1;
+
+-- name: GetInviteLinkByToken :one
+SELECT * FROM board_invite_links
+WHERE board_invite_links.token = $1;
+
+-- name: ListInviteLinksByBoard :many
+SELECT * FROM board_invite_links
+WHERE board_invite_links.board_id = $1 AND revoked_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: UpdateInviteLink :exec
+UPDATE board_invite_links SET
+(id, board_id, user_id, token, role, max_uses, use_count, expires_at, revoked_at, created_at, updated_at) =
+($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) WHERE id = $1;
+
+-- name: IncrementInviteLinkUseCount :execrows
+UPDATE board_invite_links SET
+(use_count, updated_at) = (use_count + 1, $2)
+WHERE id = $1 AND revoked_at IS NULL AND (max_uses IS NULL OR use_count < max_uses);
+
+-- name: CreateEmailInvite :exec
+INSERT INTO board_email_invites
+(id, board_id, sender_id, email, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO UPDATE SET sender_id = EXCLUDED.sender_id, updated_at = EXCLUDED.updated_at;
+
+-- name: ListEmailInvitesByBoard :many
+SELECT * FROM board_email_invites
+WHERE board_email_invites.board_id = $1 AND board_email_invites.status = $2
+ORDER BY created_at DESC;
+
+-- name: ListEmailInvitesByEmail :many
+SELECT * FROM board_email_invites
+WHERE board_email_invites.email = $1 AND board_email_invites.status = $2;
+
+-- name: UpdateEmailInvite :exec
+UPDATE board_email_invites SET
+(id, board_id, sender_id, email, status, created_at, updated_at) =
+($1, $2, $3, $4, $5, $6, $7) WHERE id = $1;
+
+-- name: CreateAccessRequest :exec
+INSERT INTO board_access_requests
+(id, board_id, user_id, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetAccessRequest :one
+SELECT * FROM board_access_requests
+WHERE board_access_requests.id = $1;
+
+-- name: ListAccessRequestsByBoard :many
+SELECT sqlc.embed(board_access_requests), sqlc.embed(users) FROM board_access_requests
+INNER JOIN users on users.id = board_access_requests.user_id
+WHERE board_access_requests.board_id = sqlc.arg('board_id') AND
+(board_access_requests.status = sqlc.narg('status') OR sqlc.narg('status') IS NULL)
+ORDER BY board_access_requests.created_at DESC;
+
+-- name: UpdateAccessRequest :exec
+UPDATE board_access_requests SET
+(id, board_id, user_id, status, created_at, updated_at) =
+($1, $2, $3, $4, $5, $6) WHERE id = $1;
+
+-- name: CreateBoardTemplate :exec
+INSERT INTO board_templates
+(id, user_id, name, description, post_groups, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetBoardTemplate :one
+SELECT * FROM board_templates
+WHERE board_templates.id = $1;
+
+-- name: ListBoardTemplatesByUser :many
+SELECT * FROM board_templates
+WHERE board_templates.user_id = $1
+ORDER BY board_templates.created_at DESC;
+
+-- name: DeleteBoardTemplate :exec
+DELETE FROM board_templates
+WHERE board_templates.id = $1;
+
+-- name: CreateBoardTag :execrows
+INSERT INTO board_tags
+(id, board_id, name, color, created_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (board_id, name) DO NOTHING;
+
+-- name: GetBoardTag :one
+SELECT * FROM board_tags
+WHERE board_tags.id = $1;
+
+-- name: ListBoardTagsByBoard :many
+SELECT * FROM board_tags
+WHERE board_tags.board_id = $1
+ORDER BY board_tags.created_at ASC;
+
+-- name: DeleteBoardTag :exec
+DELETE FROM board_tags
+WHERE board_tags.id = $1;
+
 -- name: CreateEmailVerification :exec
 INSERT INTO email_verifications
 (id, code, user_id, created_at, updated_at) 
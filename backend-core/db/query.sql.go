@@ -11,27 +11,196 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countUserVotesByBoard = `-- name: CountUserVotesByBoard :one
+SELECT COUNT(*) FROM post_votes
+WHERE post_votes.board_id = $1 AND post_votes.user_id = $2
+`
+
+type CountUserVotesByBoardParams struct {
+	BoardID pgtype.UUID
+	UserID  pgtype.UUID
+}
+
+func (q *Queries) CountUserVotesByBoard(ctx context.Context, arg CountUserVotesByBoardParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserVotesByBoard, arg.BoardID, arg.UserID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAccessRequest = `-- name: CreateAccessRequest :exec
+INSERT INTO board_access_requests
+(id, board_id, user_id, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateAccessRequestParams struct {
+	ID        pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	Status    pgtype.Text
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateAccessRequest(ctx context.Context, arg CreateAccessRequestParams) error {
+	_, err := q.db.Exec(ctx, createAccessRequest,
+		arg.ID,
+		arg.BoardID,
+		arg.UserID,
+		arg.Status,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const createActionItem = `-- name: CreateActionItem :exec
+INSERT INTO action_items
+(id, board_id, post_id, user_id, assignee_id, title, due_date, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type CreateActionItemParams struct {
+	ID         pgtype.UUID
+	BoardID    pgtype.UUID
+	PostID     pgtype.UUID
+	UserID     pgtype.UUID
+	AssigneeID pgtype.UUID
+	Title      string
+	DueDate    pgtype.Date
+	Status     string
+	CreatedAt  pgtype.Timestamp
+	UpdatedAt  pgtype.Timestamp
+}
+
+func (q *Queries) CreateActionItem(ctx context.Context, arg CreateActionItemParams) error {
+	_, err := q.db.Exec(ctx, createActionItem,
+		arg.ID,
+		arg.BoardID,
+		arg.PostID,
+		arg.UserID,
+		arg.AssigneeID,
+		arg.Title,
+		arg.DueDate,
+		arg.Status,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
 const createBoard = `-- name: CreateBoard :exec
 INSERT INTO boards 
-(id, name, description, user_id, created_at, updated_at) 
-VALUES ($1, $2, $3, $4, $5, $6)
+(id, name, description, user_id, created_at, updated_at, allowed_domains) 
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 `
 
 type CreateBoardParams struct {
+	ID             pgtype.UUID
+	Name           pgtype.Text
+	Description    pgtype.Text
+	UserID         pgtype.UUID
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+	AllowedDomains []string
+}
+
+func (q *Queries) CreateBoard(ctx context.Context, arg CreateBoardParams) error {
+	_, err := q.db.Exec(ctx, createBoard,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.UserID,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.AllowedDomains,
+	)
+	return err
+}
+
+const createBoardTag = `-- name: CreateBoardTag :execrows
+INSERT INTO board_tags
+(id, board_id, name, color, created_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (board_id, name) DO NOTHING
+`
+
+type CreateBoardTagParams struct {
+	ID        pgtype.UUID
+	BoardID   pgtype.UUID
+	Name      string
+	Color     string
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateBoardTag(ctx context.Context, arg CreateBoardTagParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createBoardTag,
+		arg.ID,
+		arg.BoardID,
+		arg.Name,
+		arg.Color,
+		arg.CreatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createBoardTemplate = `-- name: CreateBoardTemplate :exec
+INSERT INTO board_templates
+(id, user_id, name, description, post_groups, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateBoardTemplateParams struct {
 	ID          pgtype.UUID
-	Name        pgtype.Text
-	Description pgtype.Text
 	UserID      pgtype.UUID
+	Name        string
+	Description pgtype.Text
+	PostGroups  []byte
 	CreatedAt   pgtype.Timestamp
 	UpdatedAt   pgtype.Timestamp
 }
 
-func (q *Queries) CreateBoard(ctx context.Context, arg CreateBoardParams) error {
-	_, err := q.db.Exec(ctx, createBoard,
+func (q *Queries) CreateBoardTemplate(ctx context.Context, arg CreateBoardTemplateParams) error {
+	_, err := q.db.Exec(ctx, createBoardTemplate,
 		arg.ID,
+		arg.UserID,
 		arg.Name,
 		arg.Description,
-		arg.UserID,
+		arg.PostGroups,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const createEmailInvite = `-- name: CreateEmailInvite :exec
+INSERT INTO board_email_invites
+(id, board_id, sender_id, email, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO UPDATE SET sender_id = EXCLUDED.sender_id, updated_at = EXCLUDED.updated_at
+`
+
+type CreateEmailInviteParams struct {
+	ID        pgtype.UUID
+	BoardID   pgtype.UUID
+	SenderID  pgtype.UUID
+	Email     string
+	Status    pgtype.Text
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateEmailInvite(ctx context.Context, arg CreateEmailInviteParams) error {
+	_, err := q.db.Exec(ctx, createEmailInvite,
+		arg.ID,
+		arg.BoardID,
+		arg.SenderID,
+		arg.Email,
+		arg.Status,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
@@ -65,8 +234,10 @@ func (q *Queries) CreateEmailVerification(ctx context.Context, arg CreateEmailVe
 
 const createInvite = `-- name: CreateInvite :exec
 INSERT INTO board_invites
-(id, board_id, sender_id, receiver_id, status, created_at, updated_at) 
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+(id, board_id, sender_id, receiver_id, status, created_at, updated_at, expires_at, reminded_at) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (id) DO UPDATE SET
+(updated_at, expires_at, reminded_at) = (EXCLUDED.updated_at, EXCLUDED.expires_at, EXCLUDED.reminded_at)
 `
 
 type CreateInviteParams struct {
@@ -77,6 +248,8 @@ type CreateInviteParams struct {
 	Status     pgtype.Text
 	CreatedAt  pgtype.Timestamp
 	UpdatedAt  pgtype.Timestamp
+	ExpiresAt  pgtype.Timestamp
+	RemindedAt pgtype.Timestamp
 }
 
 func (q *Queries) CreateInvite(ctx context.Context, arg CreateInviteParams) error {
@@ -88,6 +261,45 @@ func (q *Queries) CreateInvite(ctx context.Context, arg CreateInviteParams) erro
 		arg.Status,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.ExpiresAt,
+		arg.RemindedAt,
+	)
+	return err
+}
+
+const createInviteLink = `-- name: CreateInviteLink :exec
+INSERT INTO board_invite_links
+(id, board_id, user_id, token, role, max_uses, use_count, expires_at, revoked_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+`
+
+type CreateInviteLinkParams struct {
+	ID        pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	Token     string
+	Role      string
+	MaxUses   pgtype.Int4
+	UseCount  int32
+	ExpiresAt pgtype.Timestamp
+	RevokedAt pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateInviteLink(ctx context.Context, arg CreateInviteLinkParams) error {
+	_, err := q.db.Exec(ctx, createInviteLink,
+		arg.ID,
+		arg.BoardID,
+		arg.UserID,
+		arg.Token,
+		arg.Role,
+		arg.MaxUses,
+		arg.UseCount,
+		arg.ExpiresAt,
+		arg.RevokedAt,
+		arg.CreatedAt,
+		arg.UpdatedAt,
 	)
 	return err
 }
@@ -121,20 +333,21 @@ func (q *Queries) CreateMembership(ctx context.Context, arg CreateMembershipPara
 
 const createPost = `-- name: CreatePost :exec
 INSERT INTO posts
-(id, user_id, content, color, height, created_at, updated_at, post_order, post_group_id) 
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+(id, user_id, content, color, height, created_at, updated_at, post_order, post_group_id, content_format) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 `
 
 type CreatePostParams struct {
-	ID          pgtype.UUID
-	UserID      pgtype.UUID
-	Content     pgtype.Text
-	Color       pgtype.Text
-	Height      pgtype.Int4
-	CreatedAt   pgtype.Timestamp
-	UpdatedAt   pgtype.Timestamp
-	PostOrder   pgtype.Float8
-	PostGroupID pgtype.UUID
+	ID            pgtype.UUID
+	UserID        pgtype.UUID
+	Content       pgtype.Text
+	Color         pgtype.Text
+	Height        pgtype.Int4
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	PostOrder     pgtype.Float8
+	PostGroupID   pgtype.UUID
+	ContentFormat string
 }
 
 func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) error {
@@ -148,6 +361,69 @@ func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) error {
 		arg.UpdatedAt,
 		arg.PostOrder,
 		arg.PostGroupID,
+		arg.ContentFormat,
+	)
+	return err
+}
+
+const createPostAttachment = `-- name: CreatePostAttachment :exec
+INSERT INTO post_attachments
+(id, post_id, board_id, user_id, file_name, content_type, size, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreatePostAttachmentParams struct {
+	ID          pgtype.UUID
+	PostID      pgtype.UUID
+	BoardID     pgtype.UUID
+	UserID      pgtype.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	CreatedAt   pgtype.Timestamp
+}
+
+func (q *Queries) CreatePostAttachment(ctx context.Context, arg CreatePostAttachmentParams) error {
+	_, err := q.db.Exec(ctx, createPostAttachment,
+		arg.ID,
+		arg.PostID,
+		arg.BoardID,
+		arg.UserID,
+		arg.FileName,
+		arg.ContentType,
+		arg.Size,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createPostComment = `-- name: CreatePostComment :exec
+INSERT INTO post_comments
+(id, post_id, board_id, user_id, parent_id, content, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreatePostCommentParams struct {
+	ID        pgtype.UUID
+	PostID    pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	ParentID  pgtype.UUID
+	Content   string
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreatePostComment(ctx context.Context, arg CreatePostCommentParams) error {
+	_, err := q.db.Exec(ctx, createPostComment,
+		arg.ID,
+		arg.PostID,
+		arg.BoardID,
+		arg.UserID,
+		arg.ParentID,
+		arg.Content,
+		arg.CreatedAt,
+		arg.UpdatedAt,
 	)
 	return err
 }
@@ -183,6 +459,127 @@ func (q *Queries) CreatePostGroup(ctx context.Context, arg CreatePostGroupParams
 	return err
 }
 
+const createPostMention = `-- name: CreatePostMention :exec
+INSERT INTO post_mentions
+(id, post_id, comment_id, board_id, user_id, mentioned_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreatePostMentionParams struct {
+	ID          pgtype.UUID
+	PostID      pgtype.UUID
+	CommentID   pgtype.UUID
+	BoardID     pgtype.UUID
+	UserID      pgtype.UUID
+	MentionedBy pgtype.UUID
+	CreatedAt   pgtype.Timestamp
+}
+
+func (q *Queries) CreatePostMention(ctx context.Context, arg CreatePostMentionParams) error {
+	_, err := q.db.Exec(ctx, createPostMention,
+		arg.ID,
+		arg.PostID,
+		arg.CommentID,
+		arg.BoardID,
+		arg.UserID,
+		arg.MentionedBy,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createPostReaction = `-- name: CreatePostReaction :execrows
+INSERT INTO post_reactions
+(id, post_id, board_id, user_id, emoji, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (post_id, user_id, emoji) DO NOTHING
+`
+
+type CreatePostReactionParams struct {
+	ID        pgtype.UUID
+	PostID    pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	Emoji     string
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreatePostReaction(ctx context.Context, arg CreatePostReactionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createPostReaction,
+		arg.ID,
+		arg.PostID,
+		arg.BoardID,
+		arg.UserID,
+		arg.Emoji,
+		arg.CreatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createPostTag = `-- name: CreatePostTag :execrows
+INSERT INTO post_tags
+(post_id, tag_id, board_id, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (post_id, tag_id) DO NOTHING
+`
+
+type CreatePostTagParams struct {
+	PostID    pgtype.UUID
+	TagID     pgtype.UUID
+	BoardID   pgtype.UUID
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreatePostTag(ctx context.Context, arg CreatePostTagParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createPostTag,
+		arg.PostID,
+		arg.TagID,
+		arg.BoardID,
+		arg.CreatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createPostVote = `-- name: CreatePostVote :execrows
+INSERT INTO post_votes
+(id, post_id, board_id, user_id, created_at)
+SELECT $1::uuid, $2::uuid, $3::uuid, $4::uuid, $5::timestamp
+WHERE (
+  SELECT COUNT(*) FROM post_votes
+  WHERE post_votes.board_id = $3::uuid AND post_votes.user_id = $4::uuid
+) < $6::int
+`
+
+type CreatePostVoteParams struct {
+	ID        pgtype.UUID
+	PostID    pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	VoteLimit int32
+}
+
+func (q *Queries) CreatePostVote(ctx context.Context, arg CreatePostVoteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createPostVote,
+		arg.ID,
+		arg.PostID,
+		arg.BoardID,
+		arg.UserID,
+		arg.CreatedAt,
+		arg.VoteLimit,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createUser = `-- name: CreateUser :exec
 INSERT into users
 (id, name, email, password, is_guest, created_at, updated_at)
@@ -212,6 +609,15 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 	return err
 }
 
+const deleteActionItem = `-- name: DeleteActionItem :exec
+DELETE FROM action_items WHERE id = $1
+`
+
+func (q *Queries) DeleteActionItem(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteActionItem, id)
+	return err
+}
+
 const deleteBoard = `-- name: DeleteBoard :exec
 DELETE from boards WHERE id = $1
 `
@@ -221,36 +627,213 @@ func (q *Queries) DeleteBoard(ctx context.Context, id pgtype.UUID) error {
 	return err
 }
 
-const deletePost = `-- name: DeletePost :exec
-DELETE from posts WHERE id = $1
+const deleteBoardTag = `-- name: DeleteBoardTag :exec
+DELETE FROM board_tags
+WHERE board_tags.id = $1
 `
 
-func (q *Queries) DeletePost(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deletePost, id)
+func (q *Queries) DeleteBoardTag(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteBoardTag, id)
 	return err
 }
 
-const deletePostGroup = `-- name: DeletePostGroup :exec
-DELETE from post_groups WHERE id = $1
+const deleteBoardTemplate = `-- name: DeleteBoardTemplate :exec
+DELETE FROM board_templates
+WHERE board_templates.id = $1
 `
 
-func (q *Queries) DeletePostGroup(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deletePostGroup, id)
+func (q *Queries) DeleteBoardTemplate(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteBoardTemplate, id)
 	return err
 }
 
-const deleteUser = `-- name: DeleteUser :exec
-DELETE FROM users
-WHERE users.id = $1
+const deleteMembership = `-- name: DeleteMembership :exec
+DELETE FROM board_memberships
+WHERE board_id = $1 AND user_id = $2
 `
 
-func (q *Queries) DeleteUser(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteUser, id)
-	return err
+type DeleteMembershipParams struct {
+	BoardID pgtype.UUID
+	UserID  pgtype.UUID
+}
+
+func (q *Queries) DeleteMembership(ctx context.Context, arg DeleteMembershipParams) error {
+	_, err := q.db.Exec(ctx, deleteMembership, arg.BoardID, arg.UserID)
+	return err
+}
+
+const deletePost = `-- name: DeletePost :exec
+DELETE from posts WHERE id = $1
+`
+
+func (q *Queries) DeletePost(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deletePost, id)
+	return err
+}
+
+const deletePostAttachment = `-- name: DeletePostAttachment :exec
+DELETE FROM post_attachments WHERE id = $1
+`
+
+func (q *Queries) DeletePostAttachment(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deletePostAttachment, id)
+	return err
+}
+
+const deletePostComment = `-- name: DeletePostComment :exec
+DELETE FROM post_comments WHERE id = $1
+`
+
+func (q *Queries) DeletePostComment(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deletePostComment, id)
+	return err
+}
+
+const deletePostGroup = `-- name: DeletePostGroup :exec
+DELETE from post_groups WHERE id = $1
+`
+
+func (q *Queries) DeletePostGroup(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deletePostGroup, id)
+	return err
+}
+
+const deletePostMention = `-- name: DeletePostMention :exec
+DELETE FROM post_mentions WHERE id = $1
+`
+
+func (q *Queries) DeletePostMention(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deletePostMention, id)
+	return err
+}
+
+const deletePostReaction = `-- name: DeletePostReaction :execrows
+DELETE FROM post_reactions
+WHERE post_id = $1 AND user_id = $2 AND emoji = $3
+`
+
+type DeletePostReactionParams struct {
+	PostID pgtype.UUID
+	UserID pgtype.UUID
+	Emoji  string
+}
+
+func (q *Queries) DeletePostReaction(ctx context.Context, arg DeletePostReactionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePostReaction, arg.PostID, arg.UserID, arg.Emoji)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePostTag = `-- name: DeletePostTag :execrows
+DELETE FROM post_tags
+WHERE post_id = $1 AND tag_id = $2
+`
+
+type DeletePostTagParams struct {
+	PostID pgtype.UUID
+	TagID  pgtype.UUID
+}
+
+func (q *Queries) DeletePostTag(ctx context.Context, arg DeletePostTagParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePostTag, arg.PostID, arg.TagID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePostVote = `-- name: DeletePostVote :execrows
+DELETE FROM post_votes
+WHERE id = (
+  SELECT post_votes.id FROM post_votes
+  WHERE post_votes.post_id = $1 AND post_votes.user_id = $2
+  ORDER BY post_votes.created_at DESC
+  LIMIT 1
+)
+`
+
+type DeletePostVoteParams struct {
+	PostID pgtype.UUID
+	UserID pgtype.UUID
+}
+
+func (q *Queries) DeletePostVote(ctx context.Context, arg DeletePostVoteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePostVote, arg.PostID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM users
+WHERE users.id = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUser, id)
+	return err
+}
+
+const expireInvites = `-- name: ExpireInvites :execrows
+UPDATE board_invites SET (status, updated_at) = ('EXPIRED', $1)
+WHERE status = 'PENDING' AND expires_at <= $1
+`
+
+func (q *Queries) ExpireInvites(ctx context.Context, now pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, expireInvites, now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAccessRequest = `-- name: GetAccessRequest :one
+SELECT id, board_id, user_id, status, created_at, updated_at FROM board_access_requests
+WHERE board_access_requests.id = $1
+`
+
+func (q *Queries) GetAccessRequest(ctx context.Context, id pgtype.UUID) (BoardAccessRequest, error) {
+	row := q.db.QueryRow(ctx, getAccessRequest, id)
+	var i BoardAccessRequest
+	err := row.Scan(
+		&i.ID,
+		&i.BoardID,
+		&i.UserID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getActionItem = `-- name: GetActionItem :one
+SELECT id, board_id, post_id, user_id, assignee_id, title, due_date, status, created_at, updated_at FROM action_items
+WHERE action_items.id = $1
+`
+
+func (q *Queries) GetActionItem(ctx context.Context, id pgtype.UUID) (ActionItem, error) {
+	row := q.db.QueryRow(ctx, getActionItem, id)
+	var i ActionItem
+	err := row.Scan(
+		&i.ID,
+		&i.BoardID,
+		&i.PostID,
+		&i.UserID,
+		&i.AssigneeID,
+		&i.Title,
+		&i.DueDate,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
 const getBoard = `-- name: GetBoard :one
-SELECT id, name, description, user_id, created_at, updated_at FROM boards
+SELECT id, name, description, user_id, created_at, updated_at, allowed_domains, archived_at, deleted_at, phase, vote_limit, hide_votes, anonymous_posts, hide_posts FROM boards
 WHERE boards.id = $1
 `
 
@@ -264,12 +847,20 @@ func (q *Queries) GetBoard(ctx context.Context, id pgtype.UUID) (Board, error) {
 		&i.UserID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AllowedDomains,
+		&i.ArchivedAt,
+		&i.DeletedAt,
+		&i.Phase,
+		&i.VoteLimit,
+		&i.HideVotes,
+		&i.AnonymousPosts,
+		&i.HidePosts,
 	)
 	return i, err
 }
 
 const getBoardAndUsers = `-- name: GetBoardAndUsers :many
-SELECT boards.id, boards.name, boards.description, boards.user_id, boards.created_at, boards.updated_at, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified, board_memberships.id, board_memberships.user_id, board_memberships.board_id, board_memberships.role, board_memberships.created_at, board_memberships.updated_at FROM boards
+SELECT boards.id, boards.name, boards.description, boards.user_id, boards.created_at, boards.updated_at, boards.allowed_domains, boards.archived_at, boards.deleted_at, boards.phase, boards.vote_limit, boards.hide_votes, boards.anonymous_posts, boards.hide_posts, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified, board_memberships.id, board_memberships.user_id, board_memberships.board_id, board_memberships.role, board_memberships.created_at, board_memberships.updated_at FROM boards
 INNER JOIN board_memberships on board_memberships.board_id = boards.id
 INNER JOIN users on board_memberships.user_id = users.id
 WHERE boards.id = $1
@@ -298,6 +889,14 @@ func (q *Queries) GetBoardAndUsers(ctx context.Context, id pgtype.UUID) ([]GetBo
 			&i.Board.UserID,
 			&i.Board.CreatedAt,
 			&i.Board.UpdatedAt,
+			&i.Board.AllowedDomains,
+			&i.Board.ArchivedAt,
+			&i.Board.DeletedAt,
+			&i.Board.Phase,
+			&i.Board.VoteLimit,
+			&i.Board.HideVotes,
+			&i.Board.AnonymousPosts,
+			&i.Board.HidePosts,
 			&i.User.ID,
 			&i.User.Name,
 			&i.User.Email,
@@ -323,6 +922,44 @@ func (q *Queries) GetBoardAndUsers(ctx context.Context, id pgtype.UUID) ([]GetBo
 	return items, nil
 }
 
+const getBoardTag = `-- name: GetBoardTag :one
+SELECT id, board_id, name, color, created_at FROM board_tags
+WHERE board_tags.id = $1
+`
+
+func (q *Queries) GetBoardTag(ctx context.Context, id pgtype.UUID) (BoardTag, error) {
+	row := q.db.QueryRow(ctx, getBoardTag, id)
+	var i BoardTag
+	err := row.Scan(
+		&i.ID,
+		&i.BoardID,
+		&i.Name,
+		&i.Color,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getBoardTemplate = `-- name: GetBoardTemplate :one
+SELECT id, user_id, name, description, post_groups, created_at, updated_at FROM board_templates
+WHERE board_templates.id = $1
+`
+
+func (q *Queries) GetBoardTemplate(ctx context.Context, id pgtype.UUID) (BoardTemplate, error) {
+	row := q.db.QueryRow(ctx, getBoardTemplate, id)
+	var i BoardTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Description,
+		&i.PostGroups,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getEmailVerification = `-- name: GetEmailVerification :one
 SELECT id, code, user_id, is_verified, created_at, updated_at FROM email_verifications WHERE user_id = $1 AND is_verified IS NULL
 ORDER BY created_at DESC LIMIT 1
@@ -343,7 +980,7 @@ func (q *Queries) GetEmailVerification(ctx context.Context, userID pgtype.UUID)
 }
 
 const getInvite = `-- name: GetInvite :one
-SELECT board_invites.id, board_invites.board_id, board_invites.sender_id, board_invites.receiver_id, board_invites.status, board_invites.created_at, board_invites.updated_at, s.id, s.name, s.email, s.password, s.is_guest, s.created_at, s.updated_at, s.is_verified, r.id, r.name, r.email, r.password, r.is_guest, r.created_at, r.updated_at, r.is_verified FROM board_invites
+SELECT board_invites.id, board_invites.board_id, board_invites.sender_id, board_invites.receiver_id, board_invites.status, board_invites.created_at, board_invites.updated_at, board_invites.expires_at, board_invites.reminded_at, s.id, s.name, s.email, s.password, s.is_guest, s.created_at, s.updated_at, s.is_verified, r.id, r.name, r.email, r.password, r.is_guest, r.created_at, r.updated_at, r.is_verified FROM board_invites
 JOIN users s on s.id = board_invites.sender_id
 JOIN users r on r.id = board_invites.receiver_id
 WHERE board_invites.id = $1
@@ -366,6 +1003,8 @@ func (q *Queries) GetInvite(ctx context.Context, id pgtype.UUID) (GetInviteRow,
 		&i.BoardInvite.Status,
 		&i.BoardInvite.CreatedAt,
 		&i.BoardInvite.UpdatedAt,
+		&i.BoardInvite.ExpiresAt,
+		&i.BoardInvite.RemindedAt,
 		&i.User.ID,
 		&i.User.Name,
 		&i.User.Email,
@@ -386,8 +1025,56 @@ func (q *Queries) GetInvite(ctx context.Context, id pgtype.UUID) (GetInviteRow,
 	return i, err
 }
 
+const getInviteLink = `-- name: GetInviteLink :one
+SELECT id, board_id, user_id, token, role, max_uses, use_count, expires_at, revoked_at, created_at, updated_at FROM board_invite_links
+WHERE board_invite_links.id = $1
+`
+
+func (q *Queries) GetInviteLink(ctx context.Context, id pgtype.UUID) (BoardInviteLink, error) {
+	row := q.db.QueryRow(ctx, getInviteLink, id)
+	var i BoardInviteLink
+	err := row.Scan(
+		&i.ID,
+		&i.BoardID,
+		&i.UserID,
+		&i.Token,
+		&i.Role,
+		&i.MaxUses,
+		&i.UseCount,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getInviteLinkByToken = `-- name: GetInviteLinkByToken :one
+SELECT id, board_id, user_id, token, role, max_uses, use_count, expires_at, revoked_at, created_at, updated_at FROM board_invite_links
+WHERE board_invite_links.token = $1
+`
+
+func (q *Queries) GetInviteLinkByToken(ctx context.Context, token string) (BoardInviteLink, error) {
+	row := q.db.QueryRow(ctx, getInviteLinkByToken, token)
+	var i BoardInviteLink
+	err := row.Scan(
+		&i.ID,
+		&i.BoardID,
+		&i.UserID,
+		&i.Token,
+		&i.Role,
+		&i.MaxUses,
+		&i.UseCount,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getPost = `-- name: GetPost :one
-SELECT id, user_id, content, color, height, created_at, updated_at, post_order, post_group_id FROM posts
+SELECT id, user_id, content, color, height, created_at, updated_at, post_order, post_group_id, content_format FROM posts
 WHERE posts.id = $1
 `
 
@@ -404,6 +1091,49 @@ func (q *Queries) GetPost(ctx context.Context, id pgtype.UUID) (Post, error) {
 		&i.UpdatedAt,
 		&i.PostOrder,
 		&i.PostGroupID,
+		&i.ContentFormat,
+	)
+	return i, err
+}
+
+const getPostAttachment = `-- name: GetPostAttachment :one
+SELECT id, post_id, board_id, user_id, file_name, content_type, size, created_at FROM post_attachments
+WHERE post_attachments.id = $1
+`
+
+func (q *Queries) GetPostAttachment(ctx context.Context, id pgtype.UUID) (PostAttachment, error) {
+	row := q.db.QueryRow(ctx, getPostAttachment, id)
+	var i PostAttachment
+	err := row.Scan(
+		&i.ID,
+		&i.PostID,
+		&i.BoardID,
+		&i.UserID,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPostComment = `-- name: GetPostComment :one
+SELECT id, post_id, board_id, user_id, parent_id, content, created_at, updated_at FROM post_comments
+WHERE post_comments.id = $1
+`
+
+func (q *Queries) GetPostComment(ctx context.Context, id pgtype.UUID) (PostComment, error) {
+	row := q.db.QueryRow(ctx, getPostComment, id)
+	var i PostComment
+	err := row.Scan(
+		&i.ID,
+		&i.PostID,
+		&i.BoardID,
+		&i.UserID,
+		&i.ParentID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
@@ -471,41 +1201,59 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email pgtype.Text) (User,
 	return i, err
 }
 
-const listInvitesByBoard = `-- name: ListInvitesByBoard :many
-SELECT board_invites.id, board_invites.board_id, board_invites.sender_id, board_invites.receiver_id, board_invites.status, board_invites.created_at, board_invites.updated_at, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified FROM board_invites
-INNER JOIN users on users.id = board_invites.receiver_id
-WHERE board_invites.board_id = $1 AND
-(status = $2 OR $2 IS NULL)
-ORDER BY board_invites.updated_at DESC
+const incrementInviteLinkUseCount = `-- name: IncrementInviteLinkUseCount :execrows
+UPDATE board_invite_links SET
+(use_count, updated_at) = (use_count + 1, $2)
+WHERE id = $1 AND revoked_at IS NULL AND (max_uses IS NULL OR use_count < max_uses)
 `
 
-type ListInvitesByBoardParams struct {
+type IncrementInviteLinkUseCountParams struct {
+	ID        pgtype.UUID
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) IncrementInviteLinkUseCount(ctx context.Context, arg IncrementInviteLinkUseCountParams) (int64, error) {
+	result, err := q.db.Exec(ctx, incrementInviteLinkUseCount, arg.ID, arg.UpdatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listAccessRequestsByBoard = `-- name: ListAccessRequestsByBoard :many
+SELECT board_access_requests.id, board_access_requests.board_id, board_access_requests.user_id, board_access_requests.status, board_access_requests.created_at, board_access_requests.updated_at, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified FROM board_access_requests
+INNER JOIN users on users.id = board_access_requests.user_id
+WHERE board_access_requests.board_id = $1 AND
+(board_access_requests.status = $2 OR $2 IS NULL)
+ORDER BY board_access_requests.created_at DESC
+`
+
+type ListAccessRequestsByBoardParams struct {
 	BoardID pgtype.UUID
 	Status  pgtype.Text
 }
 
-type ListInvitesByBoardRow struct {
-	BoardInvite BoardInvite
-	User        User
+type ListAccessRequestsByBoardRow struct {
+	BoardAccessRequest BoardAccessRequest
+	User               User
 }
 
-func (q *Queries) ListInvitesByBoard(ctx context.Context, arg ListInvitesByBoardParams) ([]ListInvitesByBoardRow, error) {
-	rows, err := q.db.Query(ctx, listInvitesByBoard, arg.BoardID, arg.Status)
+func (q *Queries) ListAccessRequestsByBoard(ctx context.Context, arg ListAccessRequestsByBoardParams) ([]ListAccessRequestsByBoardRow, error) {
+	rows, err := q.db.Query(ctx, listAccessRequestsByBoard, arg.BoardID, arg.Status)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListInvitesByBoardRow
+	var items []ListAccessRequestsByBoardRow
 	for rows.Next() {
-		var i ListInvitesByBoardRow
+		var i ListAccessRequestsByBoardRow
 		if err := rows.Scan(
-			&i.BoardInvite.ID,
-			&i.BoardInvite.BoardID,
-			&i.BoardInvite.SenderID,
-			&i.BoardInvite.ReceiverID,
-			&i.BoardInvite.Status,
-			&i.BoardInvite.CreatedAt,
-			&i.BoardInvite.UpdatedAt,
+			&i.BoardAccessRequest.ID,
+			&i.BoardAccessRequest.BoardID,
+			&i.BoardAccessRequest.UserID,
+			&i.BoardAccessRequest.Status,
+			&i.BoardAccessRequest.CreatedAt,
+			&i.BoardAccessRequest.UpdatedAt,
 			&i.User.ID,
 			&i.User.Name,
 			&i.User.Email,
@@ -525,57 +1273,41 @@ func (q *Queries) ListInvitesByBoard(ctx context.Context, arg ListInvitesByBoard
 	return items, nil
 }
 
-const listInvitesByReceiver = `-- name: ListInvitesByReceiver :many
-SELECT board_invites.id, board_invites.board_id, board_invites.sender_id, board_invites.receiver_id, board_invites.status, board_invites.created_at, board_invites.updated_at, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified, boards.id, boards.name, boards.description, boards.user_id, boards.created_at, boards.updated_at FROM board_invites
-INNER JOIN boards on boards.id = board_invites.board_id
-INNER JOIN users on users.id = board_invites.sender_id 
-WHERE board_invites.receiver_id = $1 AND
-(status = $2 OR $2 IS NULL)
-ORDER BY board_invites.updated_at DESC
+const listActionItemsByAssignee = `-- name: ListActionItemsByAssignee :many
+SELECT action_items.id, action_items.board_id, action_items.post_id, action_items.user_id, action_items.assignee_id, action_items.title, action_items.due_date, action_items.status, action_items.created_at, action_items.updated_at, boards.name AS board_name FROM action_items
+INNER JOIN boards ON boards.id = action_items.board_id
+INNER JOIN board_memberships ON board_memberships.board_id = action_items.board_id
+  AND board_memberships.user_id = action_items.assignee_id
+WHERE action_items.assignee_id = $1 AND boards.deleted_at IS NULL
+ORDER BY action_items.status DESC, action_items.due_date ASC NULLS LAST, action_items.created_at ASC
 `
 
-type ListInvitesByReceiverParams struct {
-	ReceiverID pgtype.UUID
-	Status     pgtype.Text
-}
-
-type ListInvitesByReceiverRow struct {
-	BoardInvite BoardInvite
-	User        User
-	Board       Board
+type ListActionItemsByAssigneeRow struct {
+	ActionItem ActionItem
+	BoardName  pgtype.Text
 }
 
-func (q *Queries) ListInvitesByReceiver(ctx context.Context, arg ListInvitesByReceiverParams) ([]ListInvitesByReceiverRow, error) {
-	rows, err := q.db.Query(ctx, listInvitesByReceiver, arg.ReceiverID, arg.Status)
+func (q *Queries) ListActionItemsByAssignee(ctx context.Context, assigneeID pgtype.UUID) ([]ListActionItemsByAssigneeRow, error) {
+	rows, err := q.db.Query(ctx, listActionItemsByAssignee, assigneeID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListInvitesByReceiverRow
+	var items []ListActionItemsByAssigneeRow
 	for rows.Next() {
-		var i ListInvitesByReceiverRow
+		var i ListActionItemsByAssigneeRow
 		if err := rows.Scan(
-			&i.BoardInvite.ID,
-			&i.BoardInvite.BoardID,
-			&i.BoardInvite.SenderID,
-			&i.BoardInvite.ReceiverID,
-			&i.BoardInvite.Status,
-			&i.BoardInvite.CreatedAt,
-			&i.BoardInvite.UpdatedAt,
-			&i.User.ID,
-			&i.User.Name,
-			&i.User.Email,
-			&i.User.Password,
-			&i.User.IsGuest,
-			&i.User.CreatedAt,
-			&i.User.UpdatedAt,
-			&i.User.IsVerified,
-			&i.Board.ID,
-			&i.Board.Name,
-			&i.Board.Description,
-			&i.Board.UserID,
-			&i.Board.CreatedAt,
-			&i.Board.UpdatedAt,
+			&i.ActionItem.ID,
+			&i.ActionItem.BoardID,
+			&i.ActionItem.PostID,
+			&i.ActionItem.UserID,
+			&i.ActionItem.AssigneeID,
+			&i.ActionItem.Title,
+			&i.ActionItem.DueDate,
+			&i.ActionItem.Status,
+			&i.ActionItem.CreatedAt,
+			&i.ActionItem.UpdatedAt,
+			&i.BoardName,
 		); err != nil {
 			return nil, err
 		}
@@ -587,20 +1319,435 @@ func (q *Queries) ListInvitesByReceiver(ctx context.Context, arg ListInvitesByRe
 	return items, nil
 }
 
-const listOwnedBoardAndUsers = `-- name: ListOwnedBoardAndUsers :many
-SELECT boards.id, boards.name, boards.description, boards.user_id, boards.created_at, boards.updated_at, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified, board_memberships.id, board_memberships.user_id, board_memberships.board_id, board_memberships.role, board_memberships.created_at, board_memberships.updated_at FROM boards
-INNER JOIN board_memberships on board_memberships.board_id = boards.id
-INNER JOIN users on board_memberships.user_id = users.id
-WHERE boards.user_id = $1
-ORDER BY boards.created_at DESC
+const listActionItemsByBoard = `-- name: ListActionItemsByBoard :many
+SELECT id, board_id, post_id, user_id, assignee_id, title, due_date, status, created_at, updated_at FROM action_items
+WHERE action_items.board_id = $1
+ORDER BY action_items.created_at ASC
 `
 
-type ListOwnedBoardAndUsersRow struct {
-	Board           Board
-	User            User
-	BoardMembership BoardMembership
-}
-
+func (q *Queries) ListActionItemsByBoard(ctx context.Context, boardID pgtype.UUID) ([]ActionItem, error) {
+	rows, err := q.db.Query(ctx, listActionItemsByBoard, boardID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActionItem
+	for rows.Next() {
+		var i ActionItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.BoardID,
+			&i.PostID,
+			&i.UserID,
+			&i.AssigneeID,
+			&i.Title,
+			&i.DueDate,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArchivedBoardAndUsers = `-- name: ListArchivedBoardAndUsers :many
+SELECT boards.id, boards.name, boards.description, boards.user_id, boards.created_at, boards.updated_at, boards.allowed_domains, boards.archived_at, boards.deleted_at, boards.phase, boards.vote_limit, boards.hide_votes, boards.anonymous_posts, boards.hide_posts, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified, board_memberships.id, board_memberships.user_id, board_memberships.board_id, board_memberships.role, board_memberships.created_at, board_memberships.updated_at FROM boards
+INNER JOIN board_memberships on board_memberships.board_id = boards.id
+INNER JOIN users on board_memberships.user_id = users.id
+WHERE boards.id IN (
+  SELECT board_id FROM board_memberships WHERE board_memberships.user_id = $1
+)
+AND boards.archived_at IS NOT NULL
+AND boards.deleted_at IS NULL
+ORDER BY boards.archived_at DESC
+`
+
+type ListArchivedBoardAndUsersRow struct {
+	Board           Board
+	User            User
+	BoardMembership BoardMembership
+}
+
+func (q *Queries) ListArchivedBoardAndUsers(ctx context.Context, userID pgtype.UUID) ([]ListArchivedBoardAndUsersRow, error) {
+	rows, err := q.db.Query(ctx, listArchivedBoardAndUsers, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArchivedBoardAndUsersRow
+	for rows.Next() {
+		var i ListArchivedBoardAndUsersRow
+		if err := rows.Scan(
+			&i.Board.ID,
+			&i.Board.Name,
+			&i.Board.Description,
+			&i.Board.UserID,
+			&i.Board.CreatedAt,
+			&i.Board.UpdatedAt,
+			&i.Board.AllowedDomains,
+			&i.Board.ArchivedAt,
+			&i.Board.DeletedAt,
+			&i.Board.Phase,
+			&i.Board.VoteLimit,
+			&i.Board.HideVotes,
+			&i.Board.AnonymousPosts,
+			&i.Board.HidePosts,
+			&i.User.ID,
+			&i.User.Name,
+			&i.User.Email,
+			&i.User.Password,
+			&i.User.IsGuest,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.IsVerified,
+			&i.BoardMembership.ID,
+			&i.BoardMembership.UserID,
+			&i.BoardMembership.BoardID,
+			&i.BoardMembership.Role,
+			&i.BoardMembership.CreatedAt,
+			&i.BoardMembership.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBoardTagsByBoard = `-- name: ListBoardTagsByBoard :many
+SELECT id, board_id, name, color, created_at FROM board_tags
+WHERE board_tags.board_id = $1
+ORDER BY board_tags.created_at ASC
+`
+
+func (q *Queries) ListBoardTagsByBoard(ctx context.Context, boardID pgtype.UUID) ([]BoardTag, error) {
+	rows, err := q.db.Query(ctx, listBoardTagsByBoard, boardID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BoardTag
+	for rows.Next() {
+		var i BoardTag
+		if err := rows.Scan(
+			&i.ID,
+			&i.BoardID,
+			&i.Name,
+			&i.Color,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBoardTemplatesByUser = `-- name: ListBoardTemplatesByUser :many
+SELECT id, user_id, name, description, post_groups, created_at, updated_at FROM board_templates
+WHERE board_templates.user_id = $1
+ORDER BY board_templates.created_at DESC
+`
+
+func (q *Queries) ListBoardTemplatesByUser(ctx context.Context, userID pgtype.UUID) ([]BoardTemplate, error) {
+	rows, err := q.db.Query(ctx, listBoardTemplatesByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BoardTemplate
+	for rows.Next() {
+		var i BoardTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.PostGroups,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEmailInvitesByBoard = `-- name: ListEmailInvitesByBoard :many
+SELECT id, board_id, sender_id, email, status, created_at, updated_at FROM board_email_invites
+WHERE board_email_invites.board_id = $1 AND board_email_invites.status = $2
+ORDER BY created_at DESC
+`
+
+type ListEmailInvitesByBoardParams struct {
+	BoardID pgtype.UUID
+	Status  pgtype.Text
+}
+
+func (q *Queries) ListEmailInvitesByBoard(ctx context.Context, arg ListEmailInvitesByBoardParams) ([]BoardEmailInvite, error) {
+	rows, err := q.db.Query(ctx, listEmailInvitesByBoard, arg.BoardID, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BoardEmailInvite
+	for rows.Next() {
+		var i BoardEmailInvite
+		if err := rows.Scan(
+			&i.ID,
+			&i.BoardID,
+			&i.SenderID,
+			&i.Email,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEmailInvitesByEmail = `-- name: ListEmailInvitesByEmail :many
+SELECT id, board_id, sender_id, email, status, created_at, updated_at FROM board_email_invites
+WHERE board_email_invites.email = $1 AND board_email_invites.status = $2
+`
+
+type ListEmailInvitesByEmailParams struct {
+	Email  string
+	Status pgtype.Text
+}
+
+func (q *Queries) ListEmailInvitesByEmail(ctx context.Context, arg ListEmailInvitesByEmailParams) ([]BoardEmailInvite, error) {
+	rows, err := q.db.Query(ctx, listEmailInvitesByEmail, arg.Email, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BoardEmailInvite
+	for rows.Next() {
+		var i BoardEmailInvite
+		if err := rows.Scan(
+			&i.ID,
+			&i.BoardID,
+			&i.SenderID,
+			&i.Email,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInviteLinksByBoard = `-- name: ListInviteLinksByBoard :many
+SELECT id, board_id, user_id, token, role, max_uses, use_count, expires_at, revoked_at, created_at, updated_at FROM board_invite_links
+WHERE board_invite_links.board_id = $1 AND revoked_at IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListInviteLinksByBoard(ctx context.Context, boardID pgtype.UUID) ([]BoardInviteLink, error) {
+	rows, err := q.db.Query(ctx, listInviteLinksByBoard, boardID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BoardInviteLink
+	for rows.Next() {
+		var i BoardInviteLink
+		if err := rows.Scan(
+			&i.ID,
+			&i.BoardID,
+			&i.UserID,
+			&i.Token,
+			&i.Role,
+			&i.MaxUses,
+			&i.UseCount,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInvitesByBoard = `-- name: ListInvitesByBoard :many
+SELECT board_invites.id, board_invites.board_id, board_invites.sender_id, board_invites.receiver_id, board_invites.status, board_invites.created_at, board_invites.updated_at, board_invites.expires_at, board_invites.reminded_at, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified FROM board_invites
+INNER JOIN users on users.id = board_invites.receiver_id
+WHERE board_invites.board_id = $1 AND
+(status = $2 OR $2 IS NULL)
+ORDER BY board_invites.updated_at DESC
+`
+
+type ListInvitesByBoardParams struct {
+	BoardID pgtype.UUID
+	Status  pgtype.Text
+}
+
+type ListInvitesByBoardRow struct {
+	BoardInvite BoardInvite
+	User        User
+}
+
+func (q *Queries) ListInvitesByBoard(ctx context.Context, arg ListInvitesByBoardParams) ([]ListInvitesByBoardRow, error) {
+	rows, err := q.db.Query(ctx, listInvitesByBoard, arg.BoardID, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListInvitesByBoardRow
+	for rows.Next() {
+		var i ListInvitesByBoardRow
+		if err := rows.Scan(
+			&i.BoardInvite.ID,
+			&i.BoardInvite.BoardID,
+			&i.BoardInvite.SenderID,
+			&i.BoardInvite.ReceiverID,
+			&i.BoardInvite.Status,
+			&i.BoardInvite.CreatedAt,
+			&i.BoardInvite.UpdatedAt,
+			&i.BoardInvite.ExpiresAt,
+			&i.BoardInvite.RemindedAt,
+			&i.User.ID,
+			&i.User.Name,
+			&i.User.Email,
+			&i.User.Password,
+			&i.User.IsGuest,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.IsVerified,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInvitesByReceiver = `-- name: ListInvitesByReceiver :many
+SELECT board_invites.id, board_invites.board_id, board_invites.sender_id, board_invites.receiver_id, board_invites.status, board_invites.created_at, board_invites.updated_at, board_invites.expires_at, board_invites.reminded_at, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified, boards.id, boards.name, boards.description, boards.user_id, boards.created_at, boards.updated_at, boards.allowed_domains, boards.archived_at, boards.deleted_at, boards.phase, boards.vote_limit, boards.hide_votes, boards.anonymous_posts, boards.hide_posts FROM board_invites
+INNER JOIN boards on boards.id = board_invites.board_id
+INNER JOIN users on users.id = board_invites.sender_id 
+WHERE board_invites.receiver_id = $1 AND
+(status = $2 OR $2 IS NULL)
+ORDER BY board_invites.updated_at DESC
+`
+
+type ListInvitesByReceiverParams struct {
+	ReceiverID pgtype.UUID
+	Status     pgtype.Text
+}
+
+type ListInvitesByReceiverRow struct {
+	BoardInvite BoardInvite
+	User        User
+	Board       Board
+}
+
+func (q *Queries) ListInvitesByReceiver(ctx context.Context, arg ListInvitesByReceiverParams) ([]ListInvitesByReceiverRow, error) {
+	rows, err := q.db.Query(ctx, listInvitesByReceiver, arg.ReceiverID, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListInvitesByReceiverRow
+	for rows.Next() {
+		var i ListInvitesByReceiverRow
+		if err := rows.Scan(
+			&i.BoardInvite.ID,
+			&i.BoardInvite.BoardID,
+			&i.BoardInvite.SenderID,
+			&i.BoardInvite.ReceiverID,
+			&i.BoardInvite.Status,
+			&i.BoardInvite.CreatedAt,
+			&i.BoardInvite.UpdatedAt,
+			&i.BoardInvite.ExpiresAt,
+			&i.BoardInvite.RemindedAt,
+			&i.User.ID,
+			&i.User.Name,
+			&i.User.Email,
+			&i.User.Password,
+			&i.User.IsGuest,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.IsVerified,
+			&i.Board.ID,
+			&i.Board.Name,
+			&i.Board.Description,
+			&i.Board.UserID,
+			&i.Board.CreatedAt,
+			&i.Board.UpdatedAt,
+			&i.Board.AllowedDomains,
+			&i.Board.ArchivedAt,
+			&i.Board.DeletedAt,
+			&i.Board.Phase,
+			&i.Board.VoteLimit,
+			&i.Board.HideVotes,
+			&i.Board.AnonymousPosts,
+			&i.Board.HidePosts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOwnedBoardAndUsers = `-- name: ListOwnedBoardAndUsers :many
+SELECT boards.id, boards.name, boards.description, boards.user_id, boards.created_at, boards.updated_at, boards.allowed_domains, boards.archived_at, boards.deleted_at, boards.phase, boards.vote_limit, boards.hide_votes, boards.anonymous_posts, boards.hide_posts, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified, board_memberships.id, board_memberships.user_id, board_memberships.board_id, board_memberships.role, board_memberships.created_at, board_memberships.updated_at FROM boards
+INNER JOIN board_memberships on board_memberships.board_id = boards.id
+INNER JOIN users on board_memberships.user_id = users.id
+WHERE boards.user_id = $1
+AND boards.archived_at IS NULL
+AND boards.deleted_at IS NULL
+ORDER BY boards.created_at DESC
+`
+
+type ListOwnedBoardAndUsersRow struct {
+	Board           Board
+	User            User
+	BoardMembership BoardMembership
+}
+
 func (q *Queries) ListOwnedBoardAndUsers(ctx context.Context, userID pgtype.UUID) ([]ListOwnedBoardAndUsersRow, error) {
 	rows, err := q.db.Query(ctx, listOwnedBoardAndUsers, userID)
 	if err != nil {
@@ -617,6 +1764,14 @@ func (q *Queries) ListOwnedBoardAndUsers(ctx context.Context, userID pgtype.UUID
 			&i.Board.UserID,
 			&i.Board.CreatedAt,
 			&i.Board.UpdatedAt,
+			&i.Board.AllowedDomains,
+			&i.Board.ArchivedAt,
+			&i.Board.DeletedAt,
+			&i.Board.Phase,
+			&i.Board.VoteLimit,
+			&i.Board.HideVotes,
+			&i.Board.AnonymousPosts,
+			&i.Board.HidePosts,
 			&i.User.ID,
 			&i.User.Name,
 			&i.User.Email,
@@ -642,29 +1797,365 @@ func (q *Queries) ListOwnedBoardAndUsers(ctx context.Context, userID pgtype.UUID
 	return items, nil
 }
 
-const listOwnedBoards = `-- name: ListOwnedBoards :many
-SELECT id, name, description, user_id, created_at, updated_at FROM boards
-WHERE boards.user_id = $1
-ORDER BY boards.created_at DESC
+const listOwnedBoards = `-- name: ListOwnedBoards :many
+SELECT id, name, description, user_id, created_at, updated_at, allowed_domains, archived_at, deleted_at, phase, vote_limit, hide_votes, anonymous_posts, hide_posts FROM boards
+WHERE boards.user_id = $1
+ORDER BY boards.created_at DESC
+`
+
+func (q *Queries) ListOwnedBoards(ctx context.Context, userID pgtype.UUID) ([]Board, error) {
+	rows, err := q.db.Query(ctx, listOwnedBoards, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Board
+	for rows.Next() {
+		var i Board
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AllowedDomains,
+			&i.ArchivedAt,
+			&i.DeletedAt,
+			&i.Phase,
+			&i.VoteLimit,
+			&i.HideVotes,
+			&i.AnonymousPosts,
+			&i.HidePosts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostAttachmentsByPost = `-- name: ListPostAttachmentsByPost :many
+SELECT id, post_id, board_id, user_id, file_name, content_type, size, created_at FROM post_attachments
+WHERE post_attachments.post_id = $1
+ORDER BY post_attachments.created_at ASC
+`
+
+func (q *Queries) ListPostAttachmentsByPost(ctx context.Context, postID pgtype.UUID) ([]PostAttachment, error) {
+	rows, err := q.db.Query(ctx, listPostAttachmentsByPost, postID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PostAttachment
+	for rows.Next() {
+		var i PostAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostID,
+			&i.BoardID,
+			&i.UserID,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostAttachmentsByPostGroup = `-- name: ListPostAttachmentsByPostGroup :many
+SELECT post_attachments.id, post_attachments.post_id, post_attachments.board_id, post_attachments.user_id, post_attachments.file_name, post_attachments.content_type, post_attachments.size, post_attachments.created_at FROM post_attachments
+JOIN posts ON posts.id = post_attachments.post_id
+WHERE posts.post_group_id = $1
+`
+
+func (q *Queries) ListPostAttachmentsByPostGroup(ctx context.Context, postGroupID pgtype.UUID) ([]PostAttachment, error) {
+	rows, err := q.db.Query(ctx, listPostAttachmentsByPostGroup, postGroupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PostAttachment
+	for rows.Next() {
+		var i PostAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostID,
+			&i.BoardID,
+			&i.UserID,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostCommentsByPost = `-- name: ListPostCommentsByPost :many
+SELECT id, post_id, board_id, user_id, parent_id, content, created_at, updated_at FROM post_comments
+WHERE post_comments.post_id = $1
+ORDER BY post_comments.created_at ASC
+`
+
+func (q *Queries) ListPostCommentsByPost(ctx context.Context, postID pgtype.UUID) ([]PostComment, error) {
+	rows, err := q.db.Query(ctx, listPostCommentsByPost, postID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PostComment
+	for rows.Next() {
+		var i PostComment
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostID,
+			&i.BoardID,
+			&i.UserID,
+			&i.ParentID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostGroups = `-- name: ListPostGroups :many
+SELECT post_groups.id, post_groups.board_id, post_groups.title, post_groups.pos_x, post_groups.pos_y, post_groups.z_index, post_groups.created_at, post_groups.updated_at, posts.id, posts.user_id, posts.content, posts.color, posts.height, posts.created_at, posts.updated_at, posts.post_order, posts.post_group_id, posts.content_format FROM post_groups
+LEFT JOIN posts on posts.post_group_id = post_groups.id
+WHERE post_groups.board_id = $1
+ORDER BY posts.post_order ASC
+`
+
+type ListPostGroupsRow struct {
+	PostGroup PostGroup
+	Post      Post
+}
+
+func (q *Queries) ListPostGroups(ctx context.Context, boardID pgtype.UUID) ([]ListPostGroupsRow, error) {
+	rows, err := q.db.Query(ctx, listPostGroups, boardID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostGroupsRow
+	for rows.Next() {
+		var i ListPostGroupsRow
+		if err := rows.Scan(
+			&i.PostGroup.ID,
+			&i.PostGroup.BoardID,
+			&i.PostGroup.Title,
+			&i.PostGroup.PosX,
+			&i.PostGroup.PosY,
+			&i.PostGroup.ZIndex,
+			&i.PostGroup.CreatedAt,
+			&i.PostGroup.UpdatedAt,
+			&i.Post.ID,
+			&i.Post.UserID,
+			&i.Post.Content,
+			&i.Post.Color,
+			&i.Post.Height,
+			&i.Post.CreatedAt,
+			&i.Post.UpdatedAt,
+			&i.Post.PostOrder,
+			&i.Post.PostGroupID,
+			&i.Post.ContentFormat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostGroupsByBoard = `-- name: ListPostGroupsByBoard :many
+SELECT id, board_id, title, pos_x, pos_y, z_index, created_at, updated_at FROM post_groups
+WHERE post_groups.board_id = $1
+ORDER BY post_groups.created_at ASC
+`
+
+func (q *Queries) ListPostGroupsByBoard(ctx context.Context, boardID pgtype.UUID) ([]PostGroup, error) {
+	rows, err := q.db.Query(ctx, listPostGroupsByBoard, boardID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PostGroup
+	for rows.Next() {
+		var i PostGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.BoardID,
+			&i.Title,
+			&i.PosX,
+			&i.PosY,
+			&i.ZIndex,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostMentionsByPost = `-- name: ListPostMentionsByPost :many
+SELECT id, post_id, comment_id, board_id, user_id, mentioned_by, created_at FROM post_mentions
+WHERE post_mentions.post_id = $1
+ORDER BY post_mentions.created_at ASC
+`
+
+func (q *Queries) ListPostMentionsByPost(ctx context.Context, postID pgtype.UUID) ([]PostMention, error) {
+	rows, err := q.db.Query(ctx, listPostMentionsByPost, postID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PostMention
+	for rows.Next() {
+		var i PostMention
+		if err := rows.Scan(
+			&i.ID,
+			&i.PostID,
+			&i.CommentID,
+			&i.BoardID,
+			&i.UserID,
+			&i.MentionedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsByBoard = `-- name: ListPostsByBoard :many
+SELECT posts.id, posts.user_id, posts.content, posts.color, posts.height, posts.created_at, posts.updated_at, posts.post_order, posts.post_group_id, posts.content_format FROM posts
+INNER JOIN post_groups on posts.post_group_id = post_groups.id
+WHERE post_groups.board_id = $1
+ORDER BY posts.post_order ASC
+`
+
+func (q *Queries) ListPostsByBoard(ctx context.Context, boardID pgtype.UUID) ([]Post, error) {
+	rows, err := q.db.Query(ctx, listPostsByBoard, boardID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Post
+	for rows.Next() {
+		var i Post
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Content,
+			&i.Color,
+			&i.Height,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PostOrder,
+			&i.PostGroupID,
+			&i.ContentFormat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostReactionsByBoard = `-- name: ListPostReactionsByBoard :many
+SELECT post_reactions.post_id, post_reactions.emoji, ARRAY_AGG(post_reactions.user_id ORDER BY post_reactions.created_at)::uuid[] AS user_ids
+FROM post_reactions
+WHERE post_reactions.board_id = $1
+GROUP BY post_reactions.post_id, post_reactions.emoji
+ORDER BY MIN(post_reactions.created_at) ASC
+`
+
+type ListPostReactionsByBoardRow struct {
+	PostID  pgtype.UUID
+	Emoji   string
+	UserIds []pgtype.UUID
+}
+
+func (q *Queries) ListPostReactionsByBoard(ctx context.Context, boardID pgtype.UUID) ([]ListPostReactionsByBoardRow, error) {
+	rows, err := q.db.Query(ctx, listPostReactionsByBoard, boardID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostReactionsByBoardRow
+	for rows.Next() {
+		var i ListPostReactionsByBoardRow
+		if err := rows.Scan(&i.PostID, &i.Emoji, &i.UserIds); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostTagsByBoard = `-- name: ListPostTagsByBoard :many
+SELECT post_tags.post_id, post_tags.tag_id FROM post_tags
+WHERE post_tags.board_id = $1
+ORDER BY post_tags.created_at ASC
 `
 
-func (q *Queries) ListOwnedBoards(ctx context.Context, userID pgtype.UUID) ([]Board, error) {
-	rows, err := q.db.Query(ctx, listOwnedBoards, userID)
+type ListPostTagsByBoardRow struct {
+	PostID pgtype.UUID
+	TagID  pgtype.UUID
+}
+
+func (q *Queries) ListPostTagsByBoard(ctx context.Context, boardID pgtype.UUID) ([]ListPostTagsByBoardRow, error) {
+	rows, err := q.db.Query(ctx, listPostTagsByBoard, boardID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Board
+	var items []ListPostTagsByBoardRow
 	for rows.Next() {
-		var i Board
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Description,
-			&i.UserID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
+		var i ListPostTagsByBoardRow
+		if err := rows.Scan(&i.PostID, &i.TagID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -675,45 +2166,68 @@ func (q *Queries) ListOwnedBoards(ctx context.Context, userID pgtype.UUID) ([]Bo
 	return items, nil
 }
 
-const listPostGroups = `-- name: ListPostGroups :many
-SELECT post_groups.id, post_groups.board_id, post_groups.title, post_groups.pos_x, post_groups.pos_y, post_groups.z_index, post_groups.created_at, post_groups.updated_at, posts.id, posts.user_id, posts.content, posts.color, posts.height, posts.created_at, posts.updated_at, posts.post_order, posts.post_group_id FROM post_groups
-INNER JOIN posts on posts.post_group_id = post_groups.id
-WHERE post_groups.board_id = $1
-ORDER BY posts.post_order ASC
+const listPostVoteCounts = `-- name: ListPostVoteCounts :many
+SELECT post_votes.post_id, COUNT(*) AS votes, COUNT(*) FILTER (WHERE post_votes.user_id = $2) AS user_votes
+FROM post_votes
+WHERE post_votes.board_id = $1
+GROUP BY post_votes.post_id
 `
 
-type ListPostGroupsRow struct {
-	PostGroup PostGroup
-	Post      Post
+type ListPostVoteCountsParams struct {
+	BoardID pgtype.UUID
+	UserID  pgtype.UUID
 }
 
-func (q *Queries) ListPostGroups(ctx context.Context, boardID pgtype.UUID) ([]ListPostGroupsRow, error) {
-	rows, err := q.db.Query(ctx, listPostGroups, boardID)
+type ListPostVoteCountsRow struct {
+	PostID    pgtype.UUID
+	Votes     int64
+	UserVotes int64
+}
+
+func (q *Queries) ListPostVoteCounts(ctx context.Context, arg ListPostVoteCountsParams) ([]ListPostVoteCountsRow, error) {
+	rows, err := q.db.Query(ctx, listPostVoteCounts, arg.BoardID, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListPostGroupsRow
+	var items []ListPostVoteCountsRow
 	for rows.Next() {
-		var i ListPostGroupsRow
+		var i ListPostVoteCountsRow
+		if err := rows.Scan(&i.PostID, &i.Votes, &i.UserVotes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPurgeablePostAttachments = `-- name: ListPurgeablePostAttachments :many
+SELECT post_attachments.id, post_attachments.post_id, post_attachments.board_id, post_attachments.user_id, post_attachments.file_name, post_attachments.content_type, post_attachments.size, post_attachments.created_at FROM post_attachments
+JOIN boards ON boards.id = post_attachments.board_id
+WHERE boards.deleted_at < $1
+`
+
+func (q *Queries) ListPurgeablePostAttachments(ctx context.Context, deletedAt pgtype.Timestamp) ([]PostAttachment, error) {
+	rows, err := q.db.Query(ctx, listPurgeablePostAttachments, deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PostAttachment
+	for rows.Next() {
+		var i PostAttachment
 		if err := rows.Scan(
-			&i.PostGroup.ID,
-			&i.PostGroup.BoardID,
-			&i.PostGroup.Title,
-			&i.PostGroup.PosX,
-			&i.PostGroup.PosY,
-			&i.PostGroup.ZIndex,
-			&i.PostGroup.CreatedAt,
-			&i.PostGroup.UpdatedAt,
-			&i.Post.ID,
-			&i.Post.UserID,
-			&i.Post.Content,
-			&i.Post.Color,
-			&i.Post.Height,
-			&i.Post.CreatedAt,
-			&i.Post.UpdatedAt,
-			&i.Post.PostOrder,
-			&i.Post.PostGroupID,
+			&i.ID,
+			&i.PostID,
+			&i.BoardID,
+			&i.UserID,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -726,11 +2240,13 @@ func (q *Queries) ListPostGroups(ctx context.Context, boardID pgtype.UUID) ([]Li
 }
 
 const listSharedBoardAndUsers = `-- name: ListSharedBoardAndUsers :many
-SELECT boards.id, boards.name, boards.description, boards.user_id, boards.created_at, boards.updated_at, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified, board_memberships.id, board_memberships.user_id, board_memberships.board_id, board_memberships.role, board_memberships.created_at, board_memberships.updated_at FROM boards
+SELECT boards.id, boards.name, boards.description, boards.user_id, boards.created_at, boards.updated_at, boards.allowed_domains, boards.archived_at, boards.deleted_at, boards.phase, boards.vote_limit, boards.hide_votes, boards.anonymous_posts, boards.hide_posts, users.id, users.name, users.email, users.password, users.is_guest, users.created_at, users.updated_at, users.is_verified, board_memberships.id, board_memberships.user_id, board_memberships.board_id, board_memberships.role, board_memberships.created_at, board_memberships.updated_at FROM boards
 INNER JOIN board_memberships on board_memberships.board_id = boards.id
 INNER JOIN users on board_memberships.user_id = users.id
 WHERE board_memberships.user_id = $1
-AND board_memberships.role = 'MEMBER'
+AND boards.user_id <> $1
+AND boards.archived_at IS NULL
+AND boards.deleted_at IS NULL
 ORDER BY board_memberships.created_at DESC
 `
 
@@ -756,6 +2272,14 @@ func (q *Queries) ListSharedBoardAndUsers(ctx context.Context, userID pgtype.UUI
 			&i.Board.UserID,
 			&i.Board.CreatedAt,
 			&i.Board.UpdatedAt,
+			&i.Board.AllowedDomains,
+			&i.Board.ArchivedAt,
+			&i.Board.DeletedAt,
+			&i.Board.Phase,
+			&i.Board.VoteLimit,
+			&i.Board.HideVotes,
+			&i.Board.AnonymousPosts,
+			&i.Board.HidePosts,
 			&i.User.ID,
 			&i.User.Name,
 			&i.User.Email,
@@ -781,6 +2305,50 @@ func (q *Queries) ListSharedBoardAndUsers(ctx context.Context, userID pgtype.UUI
 	return items, nil
 }
 
+const listTrashedBoards = `-- name: ListTrashedBoards :many
+SELECT boards.id, boards.name, boards.description, boards.user_id, boards.created_at, boards.updated_at, boards.allowed_domains, boards.archived_at, boards.deleted_at, boards.phase, boards.vote_limit, boards.hide_votes, boards.anonymous_posts, boards.hide_posts FROM boards
+INNER JOIN board_memberships on board_memberships.board_id = boards.id
+WHERE board_memberships.user_id = $1
+AND board_memberships.role = 'ADMIN'
+AND boards.deleted_at IS NOT NULL
+ORDER BY boards.deleted_at DESC
+`
+
+func (q *Queries) ListTrashedBoards(ctx context.Context, userID pgtype.UUID) ([]Board, error) {
+	rows, err := q.db.Query(ctx, listTrashedBoards, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Board
+	for rows.Next() {
+		var i Board
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AllowedDomains,
+			&i.ArchivedAt,
+			&i.DeletedAt,
+			&i.Phase,
+			&i.VoteLimit,
+			&i.HideVotes,
+			&i.AnonymousPosts,
+			&i.HidePosts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUsersByFuzzyEmail = `-- name: ListUsersByFuzzyEmail :many
 SELECT id, name, email, password, is_guest, created_at, updated_at, is_verified FROM users
 ORDER BY levenshtein(users.email, $1) LIMIT 10
@@ -815,6 +2383,214 @@ func (q *Queries) ListUsersByFuzzyEmail(ctx context.Context, levenshtein interfa
 	return items, nil
 }
 
+const lockPostVotes = `-- name: LockPostVotes :exec
+SELECT pg_advisory_xact_lock(hashtext($1::uuid::text), hashtext($2::uuid::text))
+`
+
+type LockPostVotesParams struct {
+	BoardID pgtype.UUID
+	UserID  pgtype.UUID
+}
+
+func (q *Queries) LockPostVotes(ctx context.Context, arg LockPostVotesParams) error {
+	_, err := q.db.Exec(ctx, lockPostVotes, arg.BoardID, arg.UserID)
+	return err
+}
+
+const markInvitesReminded = `-- name: MarkInvitesReminded :many
+UPDATE board_invites SET reminded_at = $1
+WHERE status = 'PENDING' AND reminded_at IS NULL AND
+expires_at > $1 AND expires_at <= $2
+RETURNING id
+`
+
+type MarkInvitesRemindedParams struct {
+	Now          pgtype.Timestamp
+	RemindBefore pgtype.Timestamp
+}
+
+func (q *Queries) MarkInvitesReminded(ctx context.Context, arg MarkInvitesRemindedParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, markInvitesReminded, arg.Now, arg.RemindBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeBoards = `-- name: PurgeBoards :execrows
+DELETE FROM boards
+WHERE boards.deleted_at < $1
+`
+
+func (q *Queries) PurgeBoards(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeBoards, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgePostGroups = `-- name: PurgePostGroups :exec
+DELETE FROM post_groups USING boards
+WHERE post_groups.board_id = boards.id
+AND boards.deleted_at < $1
+`
+
+func (q *Queries) PurgePostGroups(ctx context.Context, deletedAt pgtype.Timestamp) error {
+	_, err := q.db.Exec(ctx, purgePostGroups, deletedAt)
+	return err
+}
+
+const purgePosts = `-- name: PurgePosts :exec
+DELETE FROM posts USING post_groups, boards
+WHERE posts.post_group_id = post_groups.id
+AND post_groups.board_id = boards.id
+AND boards.deleted_at < $1
+`
+
+func (q *Queries) PurgePosts(ctx context.Context, deletedAt pgtype.Timestamp) error {
+	_, err := q.db.Exec(ctx, purgePosts, deletedAt)
+	return err
+}
+
+const updateAccessRequest = `-- name: UpdateAccessRequest :exec
+UPDATE board_access_requests SET
+(id, board_id, user_id, status, created_at, updated_at) =
+($1, $2, $3, $4, $5, $6) WHERE id = $1
+`
+
+type UpdateAccessRequestParams struct {
+	ID        pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	Status    pgtype.Text
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpdateAccessRequest(ctx context.Context, arg UpdateAccessRequestParams) error {
+	_, err := q.db.Exec(ctx, updateAccessRequest,
+		arg.ID,
+		arg.BoardID,
+		arg.UserID,
+		arg.Status,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const updateActionItem = `-- name: UpdateActionItem :exec
+UPDATE action_items SET
+(assignee_id, title, due_date, status, updated_at) =
+($2, $3, $4, $5, $6) WHERE id = $1
+`
+
+type UpdateActionItemParams struct {
+	ID         pgtype.UUID
+	AssigneeID pgtype.UUID
+	Title      string
+	DueDate    pgtype.Date
+	Status     string
+	UpdatedAt  pgtype.Timestamp
+}
+
+func (q *Queries) UpdateActionItem(ctx context.Context, arg UpdateActionItemParams) error {
+	_, err := q.db.Exec(ctx, updateActionItem,
+		arg.ID,
+		arg.AssigneeID,
+		arg.Title,
+		arg.DueDate,
+		arg.Status,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const updateBoard = `-- name: UpdateBoard :exec
+UPDATE boards SET
+(id, name, description, user_id, created_at, updated_at, allowed_domains, archived_at, deleted_at, phase, vote_limit, hide_votes, anonymous_posts, hide_posts) =
+($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) WHERE id = $1
+`
+
+type UpdateBoardParams struct {
+	ID             pgtype.UUID
+	Name           pgtype.Text
+	Description    pgtype.Text
+	UserID         pgtype.UUID
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+	AllowedDomains []string
+	ArchivedAt     pgtype.Timestamp
+	DeletedAt      pgtype.Timestamp
+	Phase          string
+	VoteLimit      int32
+	HideVotes      bool
+	AnonymousPosts bool
+	HidePosts      bool
+}
+
+func (q *Queries) UpdateBoard(ctx context.Context, arg UpdateBoardParams) error {
+	_, err := q.db.Exec(ctx, updateBoard,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.UserID,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.AllowedDomains,
+		arg.ArchivedAt,
+		arg.DeletedAt,
+		arg.Phase,
+		arg.VoteLimit,
+		arg.HideVotes,
+		arg.AnonymousPosts,
+		arg.HidePosts,
+	)
+	return err
+}
+
+const updateEmailInvite = `-- name: UpdateEmailInvite :exec
+UPDATE board_email_invites SET
+(id, board_id, sender_id, email, status, created_at, updated_at) =
+($1, $2, $3, $4, $5, $6, $7) WHERE id = $1
+`
+
+type UpdateEmailInviteParams struct {
+	ID        pgtype.UUID
+	BoardID   pgtype.UUID
+	SenderID  pgtype.UUID
+	Email     string
+	Status    pgtype.Text
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpdateEmailInvite(ctx context.Context, arg UpdateEmailInviteParams) error {
+	_, err := q.db.Exec(ctx, updateEmailInvite,
+		arg.ID,
+		arg.BoardID,
+		arg.SenderID,
+		arg.Email,
+		arg.Status,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
 const updateEmailVerification = `-- name: UpdateEmailVerification :exec
 UPDATE email_verifications SET
 (user_id, is_verified) =
@@ -833,8 +2609,8 @@ func (q *Queries) UpdateEmailVerification(ctx context.Context, arg UpdateEmailVe
 
 const updateInvite = `-- name: UpdateInvite :exec
 UPDATE board_invites SET
-(id, board_id, sender_id, receiver_id, status, created_at, updated_at) =
-($1, $2, $3, $4, $5, $6, $7) WHERE id = $1
+(id, board_id, sender_id, receiver_id, status, created_at, updated_at, expires_at, reminded_at) =
+($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE id = $1
 `
 
 type UpdateInviteParams struct {
@@ -845,6 +2621,8 @@ type UpdateInviteParams struct {
 	Status     pgtype.Text
 	CreatedAt  pgtype.Timestamp
 	UpdatedAt  pgtype.Timestamp
+	ExpiresAt  pgtype.Timestamp
+	RemindedAt pgtype.Timestamp
 }
 
 func (q *Queries) UpdateInvite(ctx context.Context, arg UpdateInviteParams) error {
@@ -856,26 +2634,89 @@ func (q *Queries) UpdateInvite(ctx context.Context, arg UpdateInviteParams) erro
 		arg.Status,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.ExpiresAt,
+		arg.RemindedAt,
+	)
+	return err
+}
+
+const updateInviteLink = `-- name: UpdateInviteLink :exec
+UPDATE board_invite_links SET
+(id, board_id, user_id, token, role, max_uses, use_count, expires_at, revoked_at, created_at, updated_at) =
+($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) WHERE id = $1
+`
+
+type UpdateInviteLinkParams struct {
+	ID        pgtype.UUID
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	Token     string
+	Role      string
+	MaxUses   pgtype.Int4
+	UseCount  int32
+	ExpiresAt pgtype.Timestamp
+	RevokedAt pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpdateInviteLink(ctx context.Context, arg UpdateInviteLinkParams) error {
+	_, err := q.db.Exec(ctx, updateInviteLink,
+		arg.ID,
+		arg.BoardID,
+		arg.UserID,
+		arg.Token,
+		arg.Role,
+		arg.MaxUses,
+		arg.UseCount,
+		arg.ExpiresAt,
+		arg.RevokedAt,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const updateMembership = `-- name: UpdateMembership :exec
+UPDATE board_memberships SET
+(role, updated_at) =
+($3, $4) WHERE board_id = $1 AND user_id = $2
+`
+
+type UpdateMembershipParams struct {
+	BoardID   pgtype.UUID
+	UserID    pgtype.UUID
+	Role      pgtype.Text
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpdateMembership(ctx context.Context, arg UpdateMembershipParams) error {
+	_, err := q.db.Exec(ctx, updateMembership,
+		arg.BoardID,
+		arg.UserID,
+		arg.Role,
+		arg.UpdatedAt,
 	)
 	return err
 }
 
 const updatePost = `-- name: UpdatePost :exec
 UPDATE posts SET
-(id, user_id, content, color, height, created_at, updated_at, post_order, post_group_id) =
-($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE id = $1
+(id, user_id, content, color, height, created_at, updated_at, post_order, post_group_id, content_format) =
+($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) WHERE id = $1
 `
 
 type UpdatePostParams struct {
-	ID          pgtype.UUID
-	UserID      pgtype.UUID
-	Content     pgtype.Text
-	Color       pgtype.Text
-	Height      pgtype.Int4
-	CreatedAt   pgtype.Timestamp
-	UpdatedAt   pgtype.Timestamp
-	PostOrder   pgtype.Float8
-	PostGroupID pgtype.UUID
+	ID            pgtype.UUID
+	UserID        pgtype.UUID
+	Content       pgtype.Text
+	Color         pgtype.Text
+	Height        pgtype.Int4
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	PostOrder     pgtype.Float8
+	PostGroupID   pgtype.UUID
+	ContentFormat string
 }
 
 func (q *Queries) UpdatePost(ctx context.Context, arg UpdatePostParams) error {
@@ -889,10 +2730,27 @@ func (q *Queries) UpdatePost(ctx context.Context, arg UpdatePostParams) error {
 		arg.UpdatedAt,
 		arg.PostOrder,
 		arg.PostGroupID,
+		arg.ContentFormat,
 	)
 	return err
 }
 
+const updatePostComment = `-- name: UpdatePostComment :exec
+UPDATE post_comments SET
+(content, updated_at) = ($2, $3) WHERE id = $1
+`
+
+type UpdatePostCommentParams struct {
+	ID        pgtype.UUID
+	Content   string
+	UpdatedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpdatePostComment(ctx context.Context, arg UpdatePostCommentParams) error {
+	_, err := q.db.Exec(ctx, updatePostComment, arg.ID, arg.Content, arg.UpdatedAt)
+	return err
+}
+
 const updatePostGroup = `-- name: UpdatePostGroup :exec
 UPDATE post_groups SET
 (id, board_id, title, pos_x, pos_y, z_index, created_at, updated_at) =
@@ -15,9 +15,19 @@ CREATE TABLE IF NOT EXISTS boards(
     description TEXT,
     user_id UUID REFERENCES users(id),
     created_at TIMESTAMP NOT NULL,
-    updated_at TIMESTAMP NOT NULL
+    updated_at TIMESTAMP NOT NULL,
+    allowed_domains TEXT[] NOT NULL DEFAULT '{}',
+    archived_at TIMESTAMP,
+    deleted_at TIMESTAMP,
+    phase VARCHAR(20) NOT NULL DEFAULT 'BRAINSTORM',
+    vote_limit INTEGER NOT NULL DEFAULT 5,
+    hide_votes BOOLEAN NOT NULL DEFAULT FALSE,
+    anonymous_posts BOOLEAN NOT NULL DEFAULT FALSE,
+    hide_posts BOOLEAN NOT NULL DEFAULT FALSE
 );
 
+CREATE INDEX IF NOT EXISTS idx_boards_deleted_at ON boards (deleted_at);
+
 CREATE TABLE IF NOT EXISTS board_memberships (
   id UUID PRIMARY KEY,
   user_id UUID REFERENCES users(id) ON DELETE CASCADE,
@@ -35,9 +45,56 @@ CREATE TABLE IF NOT EXISTS board_invites (
   receiver_id UUID REFERENCES users(id) ON DELETE CASCADE,
   status VARCHAR(20) DEFAULT 'PENDING',
   created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  expires_at TIMESTAMP,
+  reminded_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS board_invite_links (
+  id UUID PRIMARY KEY,
+  board_id UUID REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+  token VARCHAR(64) NOT NULL UNIQUE,
+  role VARCHAR(20) NOT NULL,
+  max_uses INTEGER,
+  use_count INTEGER NOT NULL DEFAULT 0,
+  expires_at TIMESTAMP,
+  revoked_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS board_email_invites (
+  id UUID PRIMARY KEY,
+  board_id UUID REFERENCES boards(id) ON DELETE CASCADE,
+  sender_id UUID REFERENCES users(id) ON DELETE CASCADE,
+  email VARCHAR(255) NOT NULL,
+  status VARCHAR(20) DEFAULT 'PENDING',
+  created_at TIMESTAMP NOT NULL,
   updated_at TIMESTAMP NOT NULL
 );
 
+CREATE TABLE IF NOT EXISTS board_access_requests (
+  id UUID PRIMARY KEY,
+  board_id UUID REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+  status VARCHAR(20) DEFAULT 'PENDING',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS board_templates (
+  id UUID PRIMARY KEY,
+  user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+  name VARCHAR(255) NOT NULL,
+  description TEXT,
+  post_groups JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_templates_user_id ON board_templates (user_id);
+
 CREATE TABLE IF NOT EXISTS posts (
   id UUID PRIMARY KEY,
   user_id UUID REFERENCES users(id) ON DELETE CASCADE,
@@ -47,7 +104,8 @@ CREATE TABLE IF NOT EXISTS posts (
   created_at TIMESTAMP NOT NULL,
   updated_at TIMESTAMP NOT NULL,
   post_order FLOAT,
-  post_group_id UUID NOT NULL
+  post_group_id UUID NOT NULL,
+  content_format VARCHAR(20) NOT NULL DEFAULT 'PLAIN'
 );
 
 CREATE TABLE IF NOT EXISTS post_groups (
@@ -61,6 +119,102 @@ CREATE TABLE IF NOT EXISTS post_groups (
   updated_at TIMESTAMP NOT NULL
 );
 
+CREATE TABLE IF NOT EXISTS post_votes (
+  id UUID PRIMARY KEY,
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_votes_board_id_user_id ON post_votes (board_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_post_votes_post_id ON post_votes (post_id);
+
+CREATE TABLE IF NOT EXISTS post_reactions (
+  id UUID PRIMARY KEY,
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  emoji VARCHAR(32) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  UNIQUE (post_id, user_id, emoji)
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_reactions_board_id ON post_reactions (board_id);
+
+CREATE TABLE IF NOT EXISTS post_comments (
+  id UUID PRIMARY KEY,
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  parent_id UUID REFERENCES post_comments(id) ON DELETE CASCADE,
+  content TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_comments_post_id_created_at ON post_comments (post_id, created_at);
+
+CREATE TABLE IF NOT EXISTS action_items (
+  id UUID PRIMARY KEY,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  post_id UUID REFERENCES posts(id) ON DELETE SET NULL,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  assignee_id UUID REFERENCES users(id) ON DELETE SET NULL,
+  title VARCHAR(255) NOT NULL,
+  due_date DATE,
+  status VARCHAR(20) NOT NULL DEFAULT 'OPEN',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_action_items_board_id ON action_items (board_id);
+CREATE INDEX IF NOT EXISTS idx_action_items_assignee_id ON action_items (assignee_id);
+
+CREATE TABLE IF NOT EXISTS board_tags (
+  id UUID PRIMARY KEY,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  name VARCHAR(50) NOT NULL,
+  color VARCHAR(7) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  UNIQUE (board_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS post_tags (
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  tag_id UUID NOT NULL REFERENCES board_tags(id) ON DELETE CASCADE,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL,
+  PRIMARY KEY (post_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_tags_board_id ON post_tags (board_id);
+
+CREATE TABLE IF NOT EXISTS post_attachments (
+  id UUID PRIMARY KEY,
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  file_name VARCHAR(255) NOT NULL,
+  content_type VARCHAR(100) NOT NULL,
+  size BIGINT NOT NULL,
+  created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_attachments_post_id_created_at ON post_attachments (post_id, created_at);
+
+CREATE TABLE IF NOT EXISTS post_mentions (
+  id UUID PRIMARY KEY,
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  comment_id UUID REFERENCES post_comments(id) ON DELETE CASCADE,
+  board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  mentioned_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_mentions_post_id_created_at ON post_mentions (post_id, created_at);
+
 CREATE TABLE IF NOT EXISTS email_verifications(
     id UUID PRIMARY KEY,
     code VARCHAR(255) NOT NULL,
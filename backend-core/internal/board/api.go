@@ -1,6 +1,7 @@
 package board
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -22,132 +23,1336 @@ const (
 	ErrMsgBoardNotFound = "Board not found"
 	// ErrMsgInvalidBoardID is an error message for notifying an improper board ID format.
 	ErrMsgInvalidBoardID = "Provided invalid board ID. Please ensure board ID is in UUID format"
+	// ErrMsgBoardJoinable is an error message for notifying a non-member that they can join a board through one
+	// of its allowed domains.
+	ErrMsgBoardJoinable = "Board can be joined with your verified email"
 )
 
+// Broadcaster is an interface that represents the capability to push board events to clients that are
+// connected to a board in real time.
+type Broadcaster interface {
+	BroadcastBoardUpdate(ctx context.Context, board models.Board) error
+	BroadcastBoardDelete(ctx context.Context, boardID string) error
+	BroadcastBoardPhase(ctx context.Context, board models.Board) error
+	BroadcastMemberUpdate(ctx context.Context, boardID string, member MemberDTO) error
+	BroadcastMemberRemove(ctx context.Context, boardID string, userID string) error
+	BroadcastActionItemCreate(ctx context.Context, item models.ActionItem) error
+	BroadcastActionItemUpdate(ctx context.Context, item models.ActionItem) error
+	BroadcastActionItemDelete(ctx context.Context, item models.ActionItem) error
+	BroadcastTagCreate(ctx context.Context, tag models.BoardTag) error
+	BroadcastTagDelete(ctx context.Context, tag models.BoardTag) error
+}
+
 // API encapsulates dependencies needed to perform board related duties.
 type API struct {
 	boardService Service
+	broadcaster  Broadcaster
 	validator    validator.Validate
 }
 
-// NewAPI creates a new intance of the API struct.
-func NewAPI(boardService Service, validator validator.Validate) API {
-	return API{
-		boardService: boardService,
-		validator:    validator,
+// NewAPI creates a new intance of the API struct.
+func NewAPI(boardService Service, broadcaster Broadcaster, validator validator.Validate) API {
+	return API{
+		boardService: boardService,
+		broadcaster:  broadcaster,
+		validator:    validator,
+	}
+}
+
+// HandleCreateBoard is the handler for creating a single board. It requires a user ID
+// from the request context to assign which user owns the board.
+func (api *API) HandleCreateBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Decode input
+	var input CreateBoardInput
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			endpoint.HandleDecodeErr(w, err)
+			return
+		}
+		defer r.Body.Close()
+	}
+
+	// Get userID from context
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input.UserID = userID
+
+	// Create board
+	board, err := api.boardService.CreateBoard(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+			return
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+			return
+		case errors.Is(err, errTemplateNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errTemplateNotFound.Error())
+			return
+		default:
+			logger.Errorf("handler: failed to create board: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+			return
+		}
+	}
+	endpoint.WriteWithStatus(w, http.StatusCreated, board)
+}
+
+// HandleGetBoard returns a single board along with a list of associated members.
+func (api *API) HandleGetBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	boardID := chi.URLParam(r, "boardID")
+	boardWithMembers, err := api.boardService.GetBoardWithMembers(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, errInvalidID) {
+			endpoint.WriteWithError(w, http.StatusBadRequest, ErrMsgInvalidBoardID)
+			return
+		}
+		if errors.Is(err, errBoardNotFound) {
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+			return
+		}
+		logger.Errorf("handler: failed to get board by board ID: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		return
+	}
+
+	// Check if requesting user has permission to view board.
+	userID := middleware.UserIDFromContext(ctx)
+	if userID != boardWithMembers.UserID.String() && !hasUser(boardWithMembers.Members, userID) {
+		// Let users from an allowed domain know that they can join the board.
+		canJoin, err := api.boardService.CanJoinBoard(ctx, boardWithMembers, userID)
+		if err != nil {
+			logger.Errorf("handler: failed to check if user can join board: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+			return
+		}
+		if canJoin {
+			endpoint.WriteWithError(w, http.StatusForbidden, ErrMsgBoardJoinable)
+			return
+		}
+		logger.Infof("handler: user requested for a board they do not have access to : %v", err)
+		endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		return
+	}
+
+	endpoint.WriteWithStatus(w, http.StatusOK, boardWithMembers)
+}
+
+// HandleGetBoards returns a list of owned and shared boards for a given user.
+// The userID from the auth jwt will be used to query the boards. Each board will
+// be hydrated with associated users and invites.
+func (api *API) HandleGetBoards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// get userID from context
+	userID := middleware.UserIDFromContext(ctx)
+
+	// TODO: Make concurrent
+	ownedBoards, err := api.boardService.ListOwnedBoardsWithMembers(ctx, userID)
+	if err != nil {
+		logger.Errorf("handler: failed to get owned boards by user ID: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		return
+	}
+
+	sharedBoards, err := api.boardService.ListSharedBoardsWithMembers(ctx, userID)
+	if err != nil {
+		logger.Errorf("handler: failed to get shared boards by user ID: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Owned  []BoardWithMembersDTO `json:"owned"`
+		Shared []BoardWithMembersDTO `json:"shared"`
+	}{Owned: ownedBoards, Shared: sharedBoards})
+}
+
+// HandleGetArchivedBoards returns a list of archived boards that the user is a member of.
+func (api *API) HandleGetArchivedBoards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	userID := middleware.UserIDFromContext(ctx)
+	boards, err := api.boardService.ListArchivedBoardsWithMembers(ctx, userID)
+	if err != nil {
+		logger.Errorf("handler: failed to get archived boards by user ID: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Result []BoardWithMembersDTO `json:"result"`
+	}{Result: boards})
+}
+
+// HandleGetTrashedBoards returns a list of boards in the trash that the user is an admin of.
+func (api *API) HandleGetTrashedBoards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	userID := middleware.UserIDFromContext(ctx)
+	boards, err := api.boardService.ListTrashedBoards(ctx, userID)
+	if err != nil {
+		logger.Errorf("handler: failed to get trashed boards by user ID: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Result []models.Board `json:"result"`
+	}{Result: boards})
+}
+
+// HandleUpdateBoard is the handler for updating a board's name, description, and settings, which includes
+// archiving the board. Only board admins can update a board. The updated board is broadcasted to all clients connected to the board.
+func (api *API) HandleUpdateBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Decode input
+	var input UpdateBoardInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		endpoint.HandleDecodeErr(w, err)
+		return
+	}
+	defer r.Body.Close()
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input.BoardID = chi.URLParam(r, "boardID")
+	input.UserID = userID
+
+	// Update board
+	board, err := api.boardService.UpdateBoard(ctx, input)
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, ErrMsgInvalidBoardID)
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to update board: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+
+	// Notify connected clients
+	if err := api.broadcaster.BroadcastBoardUpdate(ctx, board); err != nil {
+		logger.Errorf("handler: failed to broadcast board update: %v", err)
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, board)
+}
+
+// HandleTransferOwnership is the handler for transferring board ownership to another board member. The new
+// owner is broadcasted to all clients connected to the board as a board update, followed by a member update
+// for the new owner's upgraded role.
+func (api *API) HandleTransferOwnership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Decode input
+	var input TransferOwnershipInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		endpoint.HandleDecodeErr(w, err)
+		return
+	}
+	defer r.Body.Close()
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input.BoardID = chi.URLParam(r, "boardID")
+	input.UserID = userID
+
+	// Transfer ownership
+	board, newOwner, err := api.boardService.TransferOwnership(ctx, input)
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, ErrMsgInvalidBoardID)
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errMemberNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errMemberNotFound.Error())
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		case errors.Is(err, errAlreadyOwner):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errAlreadyOwner.Error())
+		default:
+			logger.Errorf("handler: failed to transfer board ownership: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+
+	// Notify connected clients
+	if err := api.broadcaster.BroadcastBoardUpdate(ctx, board); err != nil {
+		logger.Errorf("handler: failed to broadcast board update: %v", err)
+	}
+	if err := api.broadcaster.BroadcastMemberUpdate(ctx, input.BoardID, newOwner); err != nil {
+		logger.Errorf("handler: failed to broadcast member update: %v", err)
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, board)
+}
+
+// HandleUpdatePhase is the handler for moving a board to another retro phase. Only board admins can change the
+// phase. The new phase is broadcasted to all clients connected to the board.
+func (api *API) HandleUpdatePhase(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Decode input
+	var input UpdatePhaseInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		endpoint.HandleDecodeErr(w, err)
+		return
+	}
+	defer r.Body.Close()
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input.BoardID = chi.URLParam(r, "boardID")
+	input.UserID = userID
+
+	// Update phase
+	board, err := api.boardService.UpdatePhase(ctx, input)
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, ErrMsgInvalidBoardID)
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		case errors.Is(err, errInvalidPhaseTransition):
+			endpoint.WriteWithError(w, http.StatusConflict, errInvalidPhaseTransition.Error())
+		default:
+			logger.Errorf("handler: failed to update board phase: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+
+	// Notify connected clients
+	if err := api.broadcaster.BroadcastBoardPhase(ctx, board); err != nil {
+		logger.Errorf("handler: failed to broadcast board phase: %v", err)
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, board)
+}
+
+// HandleDeleteBoard is the handler for moving a board to the trash. Only board admins can delete a board. Clients
+// connected to the board are notified of the deletion so they can leave the board.
+func (api *API) HandleDeleteBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	boardID := chi.URLParam(r, "boardID")
+	input := DeleteBoardInput{
+		BoardID: boardID,
+		UserID:  userID,
+	}
+
+	// Delete board
+	if err := api.boardService.DeleteBoard(ctx, input); err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, ErrMsgInvalidBoardID)
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to delete board: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+
+	// Notify connected clients
+	if err := api.broadcaster.BroadcastBoardDelete(ctx, boardID); err != nil {
+		logger.Errorf("handler: failed to broadcast board delete: %v", err)
+	}
+	endpoint.WriteWithStatus(w, http.StatusNoContent, nil)
+}
+
+// HandleRestoreBoard is the handler for taking a board out of the trash. Only board admins can restore a board.
+func (api *API) HandleRestoreBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input := RestoreBoardInput{
+		BoardID: chi.URLParam(r, "boardID"),
+		UserID:  userID,
+	}
+
+	// Restore board
+	board, err := api.boardService.RestoreBoard(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, ErrMsgInvalidBoardID)
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		case errors.Is(err, errBoardNotInTrash):
+			endpoint.WriteWithError(w, http.StatusConflict, errBoardNotInTrash.Error())
+		case errors.Is(err, errTrashRetentionExpired):
+			endpoint.WriteWithError(w, http.StatusGone, errTrashRetentionExpired.Error())
+		default:
+			logger.Errorf("handler: failed to restore board: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, board)
+}
+
+// HandleDuplicateBoard is the handler for copying a board along with its post groups and, optionally, their posts.
+// Any board member can duplicate a board and becomes the owner of the copy.
+func (api *API) HandleDuplicateBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Decode input
+	var input DuplicateBoardInput
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			endpoint.HandleDecodeErr(w, err)
+			return
+		}
+		defer r.Body.Close()
+	}
+
+	// Get userID from context
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input.BoardID = chi.URLParam(r, "boardID")
+	input.UserID = userID
+
+	// Duplicate board
+	board, err := api.boardService.DuplicateBoard(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, ErrMsgInvalidBoardID)
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to duplicate board: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusCreated, board)
+}
+
+// HandleUpdateMember is the handler for changing a board member's role. Only board admins can promote or
+// demote members. The role change is broadcasted to all clients connected to the board.
+func (api *API) HandleUpdateMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Decode input
+	var input UpdateMembershipInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		endpoint.HandleDecodeErr(w, err)
+		return
+	}
+	defer r.Body.Close()
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	boardID := chi.URLParam(r, "boardID")
+	input.BoardID = boardID
+	input.UserID = userID
+	input.MemberID = chi.URLParam(r, "userID")
+
+	// Update membership
+	member, err := api.boardService.UpdateMembership(ctx, input)
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errMemberNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errMemberNotFound.Error())
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		case errors.Is(err, errOwnerMembership):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errOwnerMembership.Error())
+		case errors.Is(err, errLastAdmin):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errLastAdmin.Error())
+		default:
+			logger.Errorf("handler: failed to update board member: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+
+	// Notify connected clients
+	if err := api.broadcaster.BroadcastMemberUpdate(ctx, boardID, member); err != nil {
+		logger.Errorf("handler: failed to broadcast member update: %v", err)
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, member)
+}
+
+// HandleDeleteMember is the handler for removing a member from a board. Board admins can remove any member,
+// and members can remove themselves to leave a board. A removed member is disconnected from the board.
+func (api *API) HandleDeleteMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	boardID := chi.URLParam(r, "boardID")
+	memberID := chi.URLParam(r, "userID")
+	input := DeleteMembershipInput{
+		BoardID:  boardID,
+		UserID:   userID,
+		MemberID: memberID,
+	}
+
+	// Delete membership
+	if err := api.boardService.DeleteMembership(ctx, input); err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errMemberNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errMemberNotFound.Error())
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		case errors.Is(err, errOwnerMembership):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errOwnerMembership.Error())
+		case errors.Is(err, errLastAdmin):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errLastAdmin.Error())
+		default:
+			logger.Errorf("handler: failed to delete board member: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+
+	// Notify connected clients
+	if err := api.broadcaster.BroadcastMemberRemove(ctx, boardID, memberID); err != nil {
+		logger.Errorf("handler: failed to broadcast member removal: %v", err)
+	}
+	endpoint.WriteWithStatus(w, http.StatusNoContent, nil)
+}
+
+// HandleCreateInvites is the handler for creating board invites. It takes an array of
+// receiver_id's or emails and returns a list of created board invites along with the email
+// invites created for emails that do not belong to a user yet.
+func (api *API) HandleCreateInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Decode input
+	var input CreateInvitesInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		endpoint.HandleDecodeErr(w, err)
+		return
+	}
+	defer r.Body.Close()
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	boardID := chi.URLParam(r, "boardID")
+	input.SenderID = userID
+	input.BoardID = boardID
+
+	// Create board invites
+	invites, err := api.boardService.CreateInvites(ctx, input)
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errBoardNotFound.Error())
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		default:
+			logger.Errorf("handler: failed to create board invites: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusCreated, struct {
+		Result       []models.Invite      `json:"result"`
+		EmailInvites []models.EmailInvite `json:"email_invites"`
+	}{Result: invites.Invites, EmailInvites: invites.EmailInvites})
+}
+
+// HandleCreateInviteLink is the handler for creating a shareable board invite link.
+func (api *API) HandleCreateInviteLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Decode input
+	var input CreateInviteLinkInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		endpoint.HandleDecodeErr(w, err)
+		return
+	}
+	defer r.Body.Close()
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input.BoardID = chi.URLParam(r, "boardID")
+	input.UserID = userID
+
+	// Create invite link
+	link, err := api.boardService.CreateInviteLink(ctx, input)
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to create invite link: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusCreated, link)
+}
+
+// HandleListInviteLinks is the handler for returning the active invite links of a board.
+func (api *API) HandleListInviteLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input := ListInviteLinksInput{
+		BoardID: chi.URLParam(r, "boardID"),
+		UserID:  userID,
+	}
+
+	// List invite links
+	links, err := api.boardService.ListInviteLinks(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to list invite links: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Result []models.InviteLink `json:"result"`
+	}{Result: links})
+}
+
+// HandleDeleteInviteLink is the handler for revoking a board invite link.
+func (api *API) HandleDeleteInviteLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input := RevokeInviteLinkInput{
+		BoardID: chi.URLParam(r, "boardID"),
+		UserID:  userID,
+		LinkID:  chi.URLParam(r, "linkID"),
+	}
+
+	// Revoke invite link
+	if err := api.boardService.RevokeInviteLink(ctx, input); err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errInviteLinkNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errInviteLinkNotFound.Error())
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to revoke invite link: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusNoContent, nil)
+}
+
+// HandleListTemplates is the handler for listing the built-in templates along with the templates that the user has
+// saved.
+func (api *API) HandleListTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	userID := middleware.UserIDFromContext(ctx)
+	templates, err := api.boardService.ListTemplates(ctx, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		default:
+			logger.Errorf("handler: failed to list templates: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Result []models.BoardTemplate `json:"result"`
+	}{Result: templates})
+}
+
+// HandleCreateTemplate is the handler for saving the post group layout of a board as a template. Any board member
+// can save a board as a template.
+func (api *API) HandleCreateTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Decode input
+	var input CreateTemplateInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		endpoint.HandleDecodeErr(w, err)
+		return
+	}
+	defer r.Body.Close()
+
+	// Get userID from context
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input.UserID = userID
+
+	// Create template
+	template, err := api.boardService.CreateTemplate(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to create template: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusCreated, template)
+}
+
+// HandleDeleteTemplate is the handler for deleting a template that the user has saved.
+func (api *API) HandleDeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input := DeleteTemplateInput{
+		TemplateID: chi.URLParam(r, "templateID"),
+		UserID:     userID,
+	}
+
+	// Delete template
+	if err := api.boardService.DeleteTemplate(ctx, input); err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errTemplateNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errTemplateNotFound.Error())
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to delete template: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusNoContent, nil)
+}
+
+// HandleCreateActionItem is the handler for creating an action item on a board. Clients connected to the board
+// are notified of the new action item.
+func (api *API) HandleCreateActionItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Decode input
+	var input CreateActionItemInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		endpoint.HandleDecodeErr(w, err)
+		return
+	}
+	defer r.Body.Close()
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input.BoardID = chi.URLParam(r, "boardID")
+	input.UserID = userID
+
+	// Create action item
+	item, err := api.boardService.CreateActionItem(ctx, input)
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errInvalidDueDate):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidDueDate.Error())
+		case errors.Is(err, errAssigneeNotMember):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errAssigneeNotMember.Error())
+		case errors.Is(err, errPostNotOnBoard):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errPostNotOnBoard.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to create action item: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+
+	// Notify connected clients
+	if err := api.broadcaster.BroadcastActionItemCreate(ctx, item); err != nil {
+		logger.Errorf("handler: failed to broadcast action item: %v", err)
+	}
+	endpoint.WriteWithStatus(w, http.StatusCreated, item)
+}
+
+// HandleListActionItems is the handler for listing the action items of a board.
+func (api *API) HandleListActionItems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input := ListActionItemsInput{
+		BoardID: chi.URLParam(r, "boardID"),
+		UserID:  userID,
+	}
+
+	// List action items
+	items, err := api.boardService.ListActionItems(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, ErrMsgInvalidBoardID)
+		case errors.Is(err, errBoardNotFound), errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		default:
+			logger.Errorf("handler: failed to list action items: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Result []models.ActionItem `json:"result"`
+	}{Result: items})
+}
+
+// HandleListAssignedActionItems is the handler for listing the action items assigned to the user across all of
+// their boards.
+func (api *API) HandleListAssignedActionItems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Get userID from context
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+
+	// List action items
+	items, err := api.boardService.ListAssignedActionItems(ctx, userID)
+	if err != nil {
+		logger.Errorf("handler: failed to list assigned action items: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Result []ActionItemWithBoardDTO `json:"result"`
+	}{Result: items})
+}
+
+// HandleUpdateActionItem is the handler for updating an action item. Clients connected to the action item's
+// board are notified of the change.
+func (api *API) HandleUpdateActionItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Decode input
+	var input UpdateActionItemInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		endpoint.HandleDecodeErr(w, err)
+		return
+	}
+	defer r.Body.Close()
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input.ID = chi.URLParam(r, "itemID")
+	input.UserID = userID
+
+	// Update action item
+	item, err := api.boardService.UpdateActionItem(ctx, input)
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errInvalidDueDate):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidDueDate.Error())
+		case errors.Is(err, errAssigneeNotMember):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errAssigneeNotMember.Error())
+		case errors.Is(err, errActionItemNotFound), errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errActionItemNotFound.Error())
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to update action item: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+
+	// Notify connected clients
+	if err := api.broadcaster.BroadcastActionItemUpdate(ctx, item); err != nil {
+		logger.Errorf("handler: failed to broadcast action item: %v", err)
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, item)
+}
+
+// HandleDeleteActionItem is the handler for deleting an action item. Clients connected to the action item's
+// board are notified of the deletion.
+func (api *API) HandleDeleteActionItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input := DeleteActionItemInput{
+		ID:     chi.URLParam(r, "itemID"),
+		UserID: userID,
+	}
+
+	// Delete action item
+	item, err := api.boardService.DeleteActionItem(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errActionItemNotFound), errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errActionItemNotFound.Error())
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to delete action item: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+
+	// Notify connected clients
+	if err := api.broadcaster.BroadcastActionItemDelete(ctx, item); err != nil {
+		logger.Errorf("handler: failed to broadcast action item deletion: %v", err)
 	}
+	endpoint.WriteWithStatus(w, http.StatusNoContent, nil)
 }
 
-// HandleCreateBoard is the handler for creating a single board. It requires a user ID
-// from the request context to assign which user owns the board.
-func (api *API) HandleCreateBoard(w http.ResponseWriter, r *http.Request) {
+// HandleCreateTag is the handler for adding a tag to the tag catalog of a board. Clients connected to the board
+// are notified of the new tag.
+func (api *API) HandleCreateTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := logger.FromContext(ctx)
 
 	// Decode input
-	var input CreateBoardInput
-	if r.ContentLength != 0 {
-		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
-			endpoint.HandleDecodeErr(w, err)
-			return
-		}
-		defer r.Body.Close()
+	var input CreateTagInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		endpoint.HandleDecodeErr(w, err)
+		return
 	}
+	defer r.Body.Close()
 
-	// Get userID from context
+	// Prepare input
 	userID := middleware.UserIDFromContext(ctx)
 	if userID == "" {
 		logger.Error("handler: failed to parse user ID from request context")
 		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
 		return
 	}
+	input.BoardID = chi.URLParam(r, "boardID")
 	input.UserID = userID
 
-	// Create board
-	board, err := api.boardService.CreateBoard(ctx, input)
+	// Create tag
+	tag, err := api.boardService.CreateTag(ctx, input)
 	if err != nil {
 		switch {
-		case errors.Is(err, errInvalidID):
-			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
-			return
 		case validator.IsValidationError(err):
 			endpoint.WriteValidationErr(w, input, err)
-			return
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		case errors.Is(err, errDuplicateTag):
+			endpoint.WriteWithError(w, http.StatusConflict, errDuplicateTag.Error())
 		default:
-			logger.Errorf("handler: failed to create board: %v", err)
+			logger.Errorf("handler: failed to create tag: %v", err)
 			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
-			return
 		}
+		return
 	}
-	endpoint.WriteWithStatus(w, http.StatusCreated, board)
+
+	// Notify connected clients
+	if err := api.broadcaster.BroadcastTagCreate(ctx, tag); err != nil {
+		logger.Errorf("handler: failed to broadcast tag creation: %v", err)
+	}
+	endpoint.WriteWithStatus(w, http.StatusCreated, tag)
 }
 
-// HandleGetBoard returns a single board along with a list of associated members.
-func (api *API) HandleGetBoard(w http.ResponseWriter, r *http.Request) {
+// HandleListTags is the handler for listing the tag catalog of a board.
+func (api *API) HandleListTags(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := logger.FromContext(ctx)
 
-	boardID := chi.URLParam(r, "boardID")
-	boardWithMembers, err := api.boardService.GetBoardWithMembers(ctx, boardID)
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input := ListTagsInput{
+		BoardID: chi.URLParam(r, "boardID"),
+		UserID:  userID,
+	}
+
+	// List tags
+	tags, err := api.boardService.ListTags(ctx, input)
 	if err != nil {
-		if errors.Is(err, errInvalidID) {
+		switch {
+		case errors.Is(err, errInvalidID):
 			endpoint.WriteWithError(w, http.StatusBadRequest, ErrMsgInvalidBoardID)
-			return
+		case errors.Is(err, errBoardNotFound), errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		default:
+			logger.Errorf("handler: failed to list tags: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
 		}
-		logger.Errorf("handler: failed to get board by board ID: %v", err)
-		endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
 		return
 	}
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Result []models.BoardTag `json:"result"`
+	}{Result: tags})
+}
 
-	// Check if requesting user has permission to view board.
+// HandleDeleteTag is the handler for removing a tag from the tag catalog of a board. Clients connected to the
+// board are notified so that they can remove the tag from their posts.
+func (api *API) HandleDeleteTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
 	userID := middleware.UserIDFromContext(ctx)
-	if userID != boardWithMembers.UserID.String() && !hasUser(boardWithMembers.Members, userID) {
-		logger.Infof("handler: user requested for a board they do not have access to : %v", err)
-		endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
 		return
 	}
+	input := DeleteTagInput{
+		BoardID: chi.URLParam(r, "boardID"),
+		UserID:  userID,
+		TagID:   chi.URLParam(r, "tagID"),
+	}
 
-	endpoint.WriteWithStatus(w, http.StatusOK, boardWithMembers)
+	// Delete tag
+	tag, err := api.boardService.DeleteTag(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errTagNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errTagNotFound.Error())
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to delete tag: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+
+	// Notify connected clients
+	if err := api.broadcaster.BroadcastTagDelete(ctx, tag); err != nil {
+		logger.Errorf("handler: failed to broadcast tag deletion: %v", err)
+	}
+	endpoint.WriteWithStatus(w, http.StatusNoContent, nil)
 }
 
-// HandleGetBoards returns a list of owned and shared boards for a given user.
-// The userID from the auth jwt will be used to query the boards. Each board will
-// be hydrated with associated users and invites.
-func (api *API) HandleGetBoards(w http.ResponseWriter, r *http.Request) {
+// HandleRedeemInviteLink is the handler for joining a board through an invite link. Both registered and
+// guest users can redeem invite links.
+func (api *API) HandleRedeemInviteLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := logger.FromContext(ctx)
 
-	// get userID from context
+	// Prepare input
 	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input := RedeemInviteLinkInput{
+		Token:  chi.URLParam(r, "token"),
+		UserID: userID,
+	}
 
-	// TODO: Make concurrent
-	ownedBoards, err := api.boardService.ListOwnedBoardsWithMembers(ctx, userID)
+	// Redeem invite link
+	board, err := api.boardService.RedeemInviteLink(ctx, input)
 	if err != nil {
-		logger.Errorf("handler: failed to get owned boards by user ID: %v", err)
-		endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errInviteLinkNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errInviteLinkNotFound.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errInviteLinkInactive):
+			endpoint.WriteWithError(w, http.StatusGone, errInviteLinkInactive.Error())
+		default:
+			logger.Errorf("handler: failed to redeem invite link: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
 		return
 	}
+	endpoint.WriteWithStatus(w, http.StatusOK, board)
+}
 
-	sharedBoards, err := api.boardService.ListSharedBoardsWithMembers(ctx, userID)
+// HandleCreateAccessRequest is the handler for requesting access to a board the user is not a member of.
+func (api *API) HandleCreateAccessRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input := CreateAccessRequestInput{
+		BoardID: chi.URLParam(r, "boardID"),
+		UserID:  userID,
+	}
+
+	// Create access request
+	request, err := api.boardService.CreateAccessRequest(ctx, input)
 	if err != nil {
-		logger.Errorf("handler: failed to get shared boards by user ID: %v", err)
-		endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errAlreadyMember):
+			endpoint.WriteWithError(w, http.StatusConflict, errAlreadyMember.Error())
+		default:
+			logger.Errorf("handler: failed to create access request: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusCreated, request)
+}
+
+// HandleListAccessRequests is the handler for returning the access requests of a board. The handler can filter
+// for access requests using an optional status query parameter.
+func (api *API) HandleListAccessRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input := ListAccessRequestsInput{
+		BoardID: chi.URLParam(r, "boardID"),
+		UserID:  userID,
+		Status:  r.URL.Query().Get("status"),
+	}
+
+	// List access requests
+	requests, err := api.boardService.ListAccessRequests(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errInvalidStatusFilter):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidStatusFilter.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errUnauthorized):
+			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to list access requests: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
 		return
 	}
 	endpoint.WriteWithStatus(w, http.StatusOK, struct {
-		Owned  []BoardWithMembersDTO `json:"owned"`
-		Shared []BoardWithMembersDTO `json:"shared"`
-	}{Owned: ownedBoards, Shared: sharedBoards})
+		Result []AccessRequestWithUserDTO `json:"result"`
+	}{Result: requests})
 }
 
-// HandleCreateInvites is the handler for creating board invites. It takes an array of
-// receiver_id's and returns a list of created board invites.
-func (api *API) HandleCreateInvites(w http.ResponseWriter, r *http.Request) {
+// HandleUpdateAccessRequest is the handler for approving or denying an access request.
+func (api *API) HandleUpdateAccessRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := logger.FromContext(ctx)
 
 	// Decode input
-	var input CreateInvitesInput
+	var input UpdateAccessRequestInput
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		endpoint.HandleDecodeErr(w, err)
 		return
@@ -161,27 +1366,69 @@ func (api *API) HandleCreateInvites(w http.ResponseWriter, r *http.Request) {
 		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
 		return
 	}
-	boardID := chi.URLParam(r, "boardID")
-	input.SenderID = userID
-	input.BoardID = boardID
+	input.ID = chi.URLParam(r, "requestID")
+	input.BoardID = chi.URLParam(r, "boardID")
+	input.UserID = userID
 
-	// Create board invites
-	invites, err := api.boardService.CreateInvites(ctx, input)
+	// Update access request
+	request, err := api.boardService.UpdateAccessRequest(ctx, input)
 	if err != nil {
 		switch {
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, errInvalidID):
+			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errAccessRequestNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errAccessRequestNotFound.Error())
+		case errors.Is(err, errAccessRequestResolved):
+			endpoint.WriteWithError(w, http.StatusConflict, errAccessRequestResolved.Error())
 		case errors.Is(err, errUnauthorized):
 			endpoint.WriteWithError(w, http.StatusForbidden, errUnauthorized.Error())
+		default:
+			logger.Errorf("handler: failed to update access request: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		}
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, request)
+}
+
+// HandleJoinBoard is the handler for joining a board through one of its allowed email domains.
+func (api *API) HandleJoinBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	// Prepare input
+	userID := middleware.UserIDFromContext(ctx)
+	if userID == "" {
+		logger.Error("handler: failed to parse user ID from request context")
+		endpoint.WriteWithError(w, http.StatusUnauthorized, ErrMsgInvalidToken)
+		return
+	}
+	input := JoinBoardInput{
+		BoardID: chi.URLParam(r, "boardID"),
+		UserID:  userID,
+	}
+
+	// Join board
+	board, err := api.boardService.JoinBoard(ctx, input)
+	if err != nil {
+		switch {
 		case errors.Is(err, errInvalidID):
 			endpoint.WriteWithError(w, http.StatusBadRequest, errInvalidID.Error())
+		case errors.Is(err, errBoardNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, ErrMsgBoardNotFound)
+		case errors.Is(err, errDomainNotAllowed):
+			endpoint.WriteWithError(w, http.StatusForbidden, errDomainNotAllowed.Error())
 		default:
-			logger.Errorf("handler: failed to create board invites: %v", err)
+			logger.Errorf("handler: failed to join board: %v", err)
 			endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
 		}
 		return
 	}
-	endpoint.WriteWithStatus(w, http.StatusCreated, struct {
-		Result []models.Invite `json:"result"`
-	}{Result: invites})
+	endpoint.WriteWithStatus(w, http.StatusOK, board)
 }
 
 // HandleGetInvite is the handler for getting a board invite
@@ -208,6 +1455,40 @@ func (api *API) HandleGetInvite(w http.ResponseWriter, r *http.Request) {
 	endpoint.WriteWithStatus(w, http.StatusOK, invite)
 }
 
+// HandleRemindInvites is the handler for sending a reminder email for pending invites that are about to
+// expire. It is called periodically by the notification service and returns the number of reminders sent.
+func (api *API) HandleRemindInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	count, err := api.boardService.RemindInvites(ctx)
+	if err != nil {
+		logger.Errorf("handler: failed to remind board invites: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Count int `json:"count"`
+	}{Count: count})
+}
+
+// HandleExpireInvites is the handler for expiring pending invites that are past their expiry. It is called
+// periodically by the notification service and returns the number of invites expired.
+func (api *API) HandleExpireInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	count, err := api.boardService.ExpireInvites(ctx)
+	if err != nil {
+		logger.Errorf("handler: failed to expire board invites: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, ErrMsgInternalServer)
+		return
+	}
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Count int64 `json:"count"`
+	}{Count: count})
+}
+
 // HandleListInvitesByBoard is the handler for returning a list of invites belonging to a board. The handler
 // can filter for invites using an optional status query parameter.
 func (api *API) HandleListInvitesByBoard(w http.ResponseWriter, r *http.Request) {
@@ -317,6 +1598,8 @@ func (api *API) HandleUpdateInvite(w http.ResponseWriter, r *http.Request) {
 			endpoint.WriteWithError(w, http.StatusBadRequest, errUnsupportedInviteUpdate.Error())
 		case errors.Is(err, errInviteCancelled):
 			endpoint.WriteWithError(w, http.StatusBadRequest, errInviteCancelled.Error())
+		case errors.Is(err, errInviteExpired):
+			endpoint.WriteWithError(w, http.StatusGone, errInviteExpired.Error())
 		case errors.Is(err, errInviteDoesNotExist):
 			endpoint.WriteWithError(w, http.StatusNotFound, errInviteDoesNotExist.Error())
 		case errors.Is(err, errUnauthorized):
@@ -359,18 +1642,60 @@ func UserIsAdmin(board BoardWithMembersDTO, userID string) bool {
 	return false
 }
 
+// UserCanWrite checks if a certain user ID has write access to the posts and post groups of a board.
+func UserCanWrite(board BoardWithMembersDTO, userID string) bool {
+	for _, member := range board.Members {
+		if member.ID.String() == userID {
+			return models.BoardMembershipRole(member.Membership.Role).CanWrite()
+		}
+	}
+	return false
+}
+
+// UserCanComment checks if a certain user ID is allowed to comment on the posts of a board.
+func UserCanComment(board BoardWithMembersDTO, userID string) bool {
+	for _, member := range board.Members {
+		if member.ID.String() == userID {
+			return models.BoardMembershipRole(member.Membership.Role).CanComment()
+		}
+	}
+	return false
+}
+
 // RegisterHandlers registers the API's request handlers.
-func (api *API) RegisterHandlers(r chi.Router, authHandler func(http.Handler) http.Handler) {
+func (api *API) RegisterHandlers(r chi.Router, authHandler, serviceHandler func(http.Handler) http.Handler) {
 	r.Route("/boards", func(r chi.Router) {
 		r.Group(func(r chi.Router) {
 			r.Use(authHandler)
 			r.Get("/", api.HandleGetBoards)
 			r.Post("/", api.HandleCreateBoard)
+			r.Get("/archive", api.HandleGetArchivedBoards)
+			r.Get("/trash", api.HandleGetTrashedBoards)
 
 			r.Route("/{boardID}", func(r chi.Router) {
 				r.Get("/", api.HandleGetBoard)
+				r.Patch("/", api.HandleUpdateBoard)
+				r.Delete("/", api.HandleDeleteBoard)
+				r.Put("/owner", api.HandleTransferOwnership)
+				r.Put("/phase", api.HandleUpdatePhase)
+				r.Post("/restore", api.HandleRestoreBoard)
+				r.Post("/duplicate", api.HandleDuplicateBoard)
+				r.Post("/join", api.HandleJoinBoard)
 				r.Post("/invites", api.HandleCreateInvites)
 				r.Get("/invites", api.HandleListInvitesByBoard)
+				r.Post("/invite-links", api.HandleCreateInviteLink)
+				r.Get("/invite-links", api.HandleListInviteLinks)
+				r.Delete("/invite-links/{linkID}", api.HandleDeleteInviteLink)
+				r.Post("/access-requests", api.HandleCreateAccessRequest)
+				r.Get("/access-requests", api.HandleListAccessRequests)
+				r.Patch("/access-requests/{requestID}", api.HandleUpdateAccessRequest)
+				r.Post("/action-items", api.HandleCreateActionItem)
+				r.Get("/action-items", api.HandleListActionItems)
+				r.Post("/tags", api.HandleCreateTag)
+				r.Get("/tags", api.HandleListTags)
+				r.Delete("/tags/{tagID}", api.HandleDeleteTag)
+				r.Patch("/members/{userID}", api.HandleUpdateMember)
+				r.Delete("/members/{userID}", api.HandleDeleteMember)
 			})
 		})
 	})
@@ -378,10 +1703,35 @@ func (api *API) RegisterHandlers(r chi.Router, authHandler func(http.Handler) ht
 	r.Route("/invites", func(r chi.Router) {
 		r.Get("/{inviteID}", api.HandleGetInvite)
 
+		r.Group(func(r chi.Router) {
+			r.Use(serviceHandler)
+			r.Post("/reminders", api.HandleRemindInvites)
+			r.Post("/expirations", api.HandleExpireInvites)
+		})
+
 		r.Group(func(r chi.Router) {
 			r.Use(authHandler)
 			r.Get("/", api.HandleListInvitesByReceiver)
 			r.Patch("/{inviteID}", api.HandleUpdateInvite)
 		})
 	})
+
+	r.Route("/invite-links", func(r chi.Router) {
+		r.Use(authHandler)
+		r.Post("/{token}/redeem", api.HandleRedeemInviteLink)
+	})
+
+	r.Route("/templates", func(r chi.Router) {
+		r.Use(authHandler)
+		r.Get("/", api.HandleListTemplates)
+		r.Post("/", api.HandleCreateTemplate)
+		r.Delete("/{templateID}", api.HandleDeleteTemplate)
+	})
+
+	r.Route("/action-items", func(r chi.Router) {
+		r.Use(authHandler)
+		r.Get("/", api.HandleListAssignedActionItems)
+		r.Patch("/{itemID}", api.HandleUpdateActionItem)
+		r.Delete("/{itemID}", api.HandleDeleteActionItem)
+	})
 }
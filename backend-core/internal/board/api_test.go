@@ -4,12 +4,16 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/Wave-95/boards/backend-core/internal/middleware"
+	"github.com/Wave-95/boards/backend-core/internal/models"
+	"github.com/Wave-95/boards/backend-core/internal/storage"
 	"github.com/Wave-95/boards/backend-core/internal/test"
 	"github.com/Wave-95/boards/backend-core/pkg/validator"
 	"github.com/Wave-95/boards/wrappers/amqp"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,12 +23,15 @@ func TestAPI(t *testing.T) {
 	user := test.NewUser()
 	validator := validator.New()
 	amqpMock := amqp.NewMock()
-	boardService := NewService(boardRepo, amqpMock, validator)
-	boardAPI := NewAPI(boardService, validator)
+	boardService := NewService(boardRepo, storage.NewLocal(t.TempDir()), amqpMock, validator, time.Hour)
+	broadcaster := NewMockBroadcaster()
+	boardAPI := NewAPI(boardService, broadcaster, validator)
 	r := chi.NewRouter()
 	jwtService := test.NewJWTService()
 	authHandler := middleware.Auth(jwtService)
-	boardAPI.RegisterHandlers(r, authHandler)
+	serviceToken := "verysecretiveservicetoken"
+	serviceHandler := middleware.ServiceAuth(serviceToken)
+	boardAPI.RegisterHandlers(r, authHandler, serviceHandler)
 
 	// Setup data
 	boardRepo.AddUser(user)
@@ -35,6 +42,18 @@ func TestAPI(t *testing.T) {
 	}
 	receiver1 := test.NewUser()
 	receiver2 := test.NewUser()
+	boardRepo.AddUser(receiver1)
+	member := test.NewUser()
+	boardRepo.AddUser(member)
+	err = boardRepo.CreateMembership(context.Background(), models.BoardMembership{
+		ID:      uuid.New(),
+		BoardID: board.ID,
+		UserID:  member.ID,
+		Role:    models.RoleMember,
+	})
+	if err != nil {
+		assert.FailNow(t, "Failed to generate test membership needed for managing board members")
+	}
 
 	// Setup table tests
 	token, err := jwtService.GenerateToken(user.ID.String())
@@ -42,6 +61,21 @@ func TestAPI(t *testing.T) {
 		assert.FailNow(t, "Failed to generate test token needed for sending authenticated requests")
 	}
 	authHeader := test.AuthHeader(token)
+	nonMemberToken, err := jwtService.GenerateToken(receiver1.ID.String())
+	if err != nil {
+		assert.FailNow(t, "Failed to generate test token needed for sending authenticated requests")
+	}
+	nonMemberAuthHeader := test.AuthHeader(nonMemberToken)
+	joiner := test.NewUser(test.WithEmail("joiner@example.com"))
+	joiner.IsVerified = true
+	boardRepo.AddUser(joiner)
+	joinerToken, err := jwtService.GenerateToken(joiner.ID.String())
+	if err != nil {
+		assert.FailNow(t, "Failed to generate test token needed for sending authenticated requests")
+	}
+	joinerAuthHeader := test.AuthHeader(joinerToken)
+	serviceHeader := http.Header{}
+	serviceHeader.Add(middleware.HeaderServiceToken, serviceToken)
 	tt := []test.APITestCase{
 		{
 			Name:         "create board",
@@ -61,9 +95,449 @@ func TestAPI(t *testing.T) {
 			WantStatus:   http.StatusCreated,
 			WantResponse: `*"status":"PENDING"*`,
 		},
+		{
+			Name:         "remind invites",
+			Method:       http.MethodPost,
+			URL:          "/invites/reminders",
+			Header:       serviceHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"count":*`,
+		},
+		{
+			Name:       "remind invites without service token",
+			Method:     http.MethodPost,
+			URL:        "/invites/reminders",
+			WantStatus: http.StatusUnauthorized,
+		},
+		{
+			Name:       "remind invites with user token",
+			Method:     http.MethodPost,
+			URL:        "/invites/reminders",
+			Header:     authHeader,
+			WantStatus: http.StatusUnauthorized,
+		},
+		{
+			Name:         "expire invites",
+			Method:       http.MethodPost,
+			URL:          "/invites/expirations",
+			Header:       serviceHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"count":*`,
+		},
+		{
+			Name:       "expire invites without service token",
+			Method:     http.MethodPost,
+			URL:        "/invites/expirations",
+			WantStatus: http.StatusUnauthorized,
+		},
+		{
+			Name:         "create email invites",
+			Method:       http.MethodPost,
+			URL:          `/boards/` + board.ID.String() + `/invites`,
+			Body:         `{"invites":[{"email":"new.colleague@example.com"}]}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusCreated,
+			WantResponse: `*"email":"new.colleague@example.com"*`,
+		},
+		{
+			Name:       "create invites without receiver",
+			Method:     http.MethodPost,
+			URL:        `/boards/` + board.ID.String() + `/invites`,
+			Body:       `{"invites":[{}]}`,
+			Header:     authHeader,
+			WantStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "update board",
+			Method:       http.MethodPatch,
+			URL:          `/boards/` + board.ID.String(),
+			Body:         `{"name":"Renamed board"}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: "*Renamed board*",
+		},
+		{
+			Name:       "get board as non-member",
+			Method:     http.MethodGet,
+			URL:        `/boards/` + board.ID.String(),
+			Header:     joinerAuthHeader,
+			WantStatus: http.StatusNotFound,
+		},
+		{
+			Name:         "update board allowed domains",
+			Method:       http.MethodPatch,
+			URL:          `/boards/` + board.ID.String(),
+			Body:         `{"allowed_domains":["example.com"]}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"allowed_domains":["example.com"]*`,
+		},
+		{
+			Name:       "update board with invalid allowed domain",
+			Method:     http.MethodPatch,
+			URL:        `/boards/` + board.ID.String(),
+			Body:       `{"allowed_domains":["@example"]}`,
+			Header:     authHeader,
+			WantStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "get board as user from allowed domain",
+			Method:       http.MethodGet,
+			URL:          `/boards/` + board.ID.String(),
+			Header:       joinerAuthHeader,
+			WantStatus:   http.StatusForbidden,
+			WantResponse: "*" + ErrMsgBoardJoinable + "*",
+		},
+		{
+			Name:       "join board as unverified user",
+			Method:     http.MethodPost,
+			URL:        `/boards/` + board.ID.String() + `/join`,
+			Header:     nonMemberAuthHeader,
+			WantStatus: http.StatusForbidden,
+		},
+		{
+			Name:         "join board as user from allowed domain",
+			Method:       http.MethodPost,
+			URL:          `/boards/` + board.ID.String() + `/join`,
+			Header:       joinerAuthHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"id":"` + joiner.ID.String() + `"*`,
+		},
+		{
+			Name:       "update board with invalid vote limit",
+			Method:     http.MethodPatch,
+			URL:        `/boards/` + board.ID.String(),
+			Body:       `{"vote_limit":0}`,
+			Header:     authHeader,
+			WantStatus: http.StatusBadRequest,
+		},
+		{
+			Name:       "update board with invalid name",
+			Method:     http.MethodPatch,
+			URL:        `/boards/` + board.ID.String(),
+			Body:       `{"name":"ab"}`,
+			Header:     authHeader,
+			WantStatus: http.StatusBadRequest,
+		},
+		{
+			Name:       "update board as non-member",
+			Method:     http.MethodPatch,
+			URL:        `/boards/` + board.ID.String(),
+			Body:       `{"name":"Hijacked board"}`,
+			Header:     nonMemberAuthHeader,
+			WantStatus: http.StatusForbidden,
+		},
+		{
+			Name:       "transfer ownership to non-member",
+			Method:     http.MethodPut,
+			URL:        `/boards/` + board.ID.String() + `/owner`,
+			Body:       `{"user_id":"` + receiver1.ID.String() + `"}`,
+			Header:     authHeader,
+			WantStatus: http.StatusNotFound,
+		},
+		{
+			Name:         "create invite link",
+			Method:       http.MethodPost,
+			URL:          `/boards/` + board.ID.String() + `/invite-links`,
+			Body:         `{"role":"VIEWER","max_uses":5}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusCreated,
+			WantResponse: `*"role":"VIEWER"*`,
+		},
+		{
+			Name:       "create invite link granting admin",
+			Method:     http.MethodPost,
+			URL:        `/boards/` + board.ID.String() + `/invite-links`,
+			Body:       `{"role":"ADMIN"}`,
+			Header:     authHeader,
+			WantStatus: http.StatusBadRequest,
+		},
+		{
+			Name:       "list invite links as non-member",
+			Method:     http.MethodGet,
+			URL:        `/boards/` + board.ID.String() + `/invite-links`,
+			Header:     nonMemberAuthHeader,
+			WantStatus: http.StatusForbidden,
+		},
+		{
+			Name:       "redeem unknown invite link",
+			Method:     http.MethodPost,
+			URL:        `/invite-links/unknown/redeem`,
+			Header:     authHeader,
+			WantStatus: http.StatusNotFound,
+		},
+		{
+			Name:         "request access",
+			Method:       http.MethodPost,
+			URL:          `/boards/` + board.ID.String() + `/access-requests`,
+			Header:       nonMemberAuthHeader,
+			WantStatus:   http.StatusCreated,
+			WantResponse: `*"status":"PENDING"*`,
+		},
+		{
+			Name:       "request access as member",
+			Method:     http.MethodPost,
+			URL:        `/boards/` + board.ID.String() + `/access-requests`,
+			Header:     authHeader,
+			WantStatus: http.StatusConflict,
+		},
+		{
+			Name:         "list access requests",
+			Method:       http.MethodGet,
+			URL:          `/boards/` + board.ID.String() + `/access-requests?status=PENDING`,
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"id":"` + receiver1.ID.String() + `"*`,
+		},
+		{
+			Name:       "list access requests as non-member",
+			Method:     http.MethodGet,
+			URL:        `/boards/` + board.ID.String() + `/access-requests`,
+			Header:     nonMemberAuthHeader,
+			WantStatus: http.StatusForbidden,
+		},
+		{
+			Name:       "update access request with unsupported status",
+			Method:     http.MethodPatch,
+			URL:        `/boards/` + board.ID.String() + `/access-requests/` + uuid.New().String(),
+			Body:       `{"status":"PENDING"}`,
+			Header:     authHeader,
+			WantStatus: http.StatusBadRequest,
+		},
+		{
+			Name:       "update unknown access request",
+			Method:     http.MethodPatch,
+			URL:        `/boards/` + board.ID.String() + `/access-requests/` + uuid.New().String(),
+			Body:       `{"status":"DENIED"}`,
+			Header:     authHeader,
+			WantStatus: http.StatusNotFound,
+		},
+		{
+			Name:         "promote member",
+			Method:       http.MethodPatch,
+			URL:          `/boards/` + board.ID.String() + `/members/` + member.ID.String(),
+			Body:         `{"role":"ADMIN"}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"role":"ADMIN"*`,
+		},
+		{
+			Name:       "update member with unsupported role",
+			Method:     http.MethodPatch,
+			URL:        `/boards/` + board.ID.String() + `/members/` + member.ID.String(),
+			Body:       `{"role":"OWNER"}`,
+			Header:     authHeader,
+			WantStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "demote member to viewer",
+			Method:       http.MethodPatch,
+			URL:          `/boards/` + board.ID.String() + `/members/` + member.ID.String(),
+			Body:         `{"role":"VIEWER"}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"role":"VIEWER"*`,
+		},
+		{
+			Name:       "remove board owner",
+			Method:     http.MethodDelete,
+			URL:        `/boards/` + board.ID.String() + `/members/` + user.ID.String(),
+			Header:     authHeader,
+			WantStatus: http.StatusBadRequest,
+		},
+		{
+			Name:       "remove member as non-member",
+			Method:     http.MethodDelete,
+			URL:        `/boards/` + board.ID.String() + `/members/` + member.ID.String(),
+			Header:     nonMemberAuthHeader,
+			WantStatus: http.StatusForbidden,
+		},
+		{
+			Name:       "remove member",
+			Method:     http.MethodDelete,
+			URL:        `/boards/` + board.ID.String() + `/members/` + member.ID.String(),
+			Header:     authHeader,
+			WantStatus: http.StatusNoContent,
+		},
+		{
+			Name:         "archive board",
+			Method:       http.MethodPatch,
+			URL:          `/boards/` + board.ID.String(),
+			Body:         `{"archived":true}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"archived_at":"*`,
+		},
+		{
+			Name:         "list archived boards",
+			Method:       http.MethodGet,
+			URL:          "/boards/archive",
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"id":"` + board.ID.String() + `"*`,
+		},
+		{
+			Name:       "delete board as non-member",
+			Method:     http.MethodDelete,
+			URL:        `/boards/` + board.ID.String(),
+			Header:     nonMemberAuthHeader,
+			WantStatus: http.StatusForbidden,
+		},
+		{
+			Name:       "delete board",
+			Method:     http.MethodDelete,
+			URL:        `/boards/` + board.ID.String(),
+			Header:     authHeader,
+			WantStatus: http.StatusNoContent,
+		},
+		{
+			Name:         "list trashed boards",
+			Method:       http.MethodGet,
+			URL:          "/boards/trash",
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"id":"` + board.ID.String() + `"*`,
+		},
+		{
+			Name:       "get board in trash",
+			Method:     http.MethodGet,
+			URL:        `/boards/` + board.ID.String(),
+			Header:     authHeader,
+			WantStatus: http.StatusNotFound,
+		},
+		{
+			Name:       "restore board as non-member",
+			Method:     http.MethodPost,
+			URL:        `/boards/` + board.ID.String() + `/restore`,
+			Header:     nonMemberAuthHeader,
+			WantStatus: http.StatusForbidden,
+		},
+		{
+			Name:         "restore board",
+			Method:       http.MethodPost,
+			URL:          `/boards/` + board.ID.String() + `/restore`,
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"id":"` + board.ID.String() + `"*`,
+		},
+		{
+			Name:       "restore board that is not in the trash",
+			Method:     http.MethodPost,
+			URL:        `/boards/` + board.ID.String() + `/restore`,
+			Header:     authHeader,
+			WantStatus: http.StatusConflict,
+		},
+		{
+			Name:       "duplicate board as non-member",
+			Method:     http.MethodPost,
+			URL:        `/boards/` + board.ID.String() + `/duplicate`,
+			Header:     nonMemberAuthHeader,
+			WantStatus: http.StatusForbidden,
+		},
+		{
+			Name:       "duplicate board with invalid name",
+			Method:     http.MethodPost,
+			URL:        `/boards/` + board.ID.String() + `/duplicate`,
+			Body:       `{"name":"a"}`,
+			Header:     authHeader,
+			WantStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "duplicate board",
+			Method:       http.MethodPost,
+			URL:          `/boards/` + board.ID.String() + `/duplicate`,
+			Body:         `{"name":"Sprint 2 retro","include_posts":true}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusCreated,
+			WantResponse: `*"name":"Sprint 2 retro"*`,
+		},
+		{
+			Name:         "list templates",
+			Method:       http.MethodGet,
+			URL:          "/templates",
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"name":"Mad, Sad, Glad"*`,
+		},
+		{
+			Name:         "save board as template",
+			Method:       http.MethodPost,
+			URL:          "/templates",
+			Body:         `{"board_id":"` + board.ID.String() + `","name":"Team retro"}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusCreated,
+			WantResponse: `*"name":"Team retro"*`,
+		},
+		{
+			Name:       "save board as template as non-member",
+			Method:     http.MethodPost,
+			URL:        "/templates",
+			Body:       `{"board_id":"` + board.ID.String() + `","name":"Team retro"}`,
+			Header:     nonMemberAuthHeader,
+			WantStatus: http.StatusForbidden,
+		},
+		{
+			Name:         "create board from template",
+			Method:       http.MethodPost,
+			URL:          "/boards",
+			Body:         `{"template_id":"` + builtInTemplates[0].ID.String() + `"}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusCreated,
+			WantResponse: `*"description":"` + builtInTemplates[0].Description + `"*`,
+		},
+		{
+			Name:       "create board from unknown template",
+			Method:     http.MethodPost,
+			URL:        "/boards",
+			Body:       `{"template_id":"` + uuid.New().String() + `"}`,
+			Header:     authHeader,
+			WantStatus: http.StatusNotFound,
+		},
+		{
+			Name:       "delete built-in template",
+			Method:     http.MethodDelete,
+			URL:        "/templates/" + builtInTemplates[0].ID.String(),
+			Header:     authHeader,
+			WantStatus: http.StatusForbidden,
+		},
+		{
+			Name:       "skip a board phase",
+			Method:     http.MethodPut,
+			URL:        `/boards/` + board.ID.String() + `/phase`,
+			Body:       `{"phase":"VOTE"}`,
+			Header:     authHeader,
+			WantStatus: http.StatusConflict,
+		},
+		{
+			Name:       "update board phase with invalid phase",
+			Method:     http.MethodPut,
+			URL:        `/boards/` + board.ID.String() + `/phase`,
+			Body:       `{"phase":"RETRO"}`,
+			Header:     authHeader,
+			WantStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "update board phase",
+			Method:       http.MethodPut,
+			URL:          `/boards/` + board.ID.String() + `/phase`,
+			Body:         `{"phase":"GROUP"}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"phase":"GROUP"*`,
+		},
+		{
+			Name:         "transfer ownership",
+			Method:       http.MethodPut,
+			URL:          `/boards/` + board.ID.String() + `/owner`,
+			Body:         `{"user_id":"` + joiner.ID.String() + `"}`,
+			Header:       authHeader,
+			WantStatus:   http.StatusOK,
+			WantResponse: `*"user_id":"` + joiner.ID.String() + `"*`,
+		},
 	}
 
 	for _, tc := range tt {
 		test.Endpoint(t, r, tc)
 	}
+	assert.Equal(t, []string{"board.update", "board.update", "board.member_update", "board.member_update", "board.member_remove", "board.update", "board.delete", "board.phase", "board.update", "board.member_update"}, broadcaster.events)
 }
@@ -0,0 +1,76 @@
+package board
+
+import (
+	"context"
+
+	"github.com/Wave-95/boards/backend-core/internal/models"
+)
+
+type mockBroadcaster struct {
+	events []string
+}
+
+// NewMockBroadcaster returns a mock broadcaster that records the events it is asked to broadcast.
+func NewMockBroadcaster() *mockBroadcaster {
+	return &mockBroadcaster{events: []string{}}
+}
+
+// BroadcastBoardUpdate records a mock board update event.
+func (b *mockBroadcaster) BroadcastBoardUpdate(ctx context.Context, board models.Board) error {
+	b.events = append(b.events, "board.update")
+	return nil
+}
+
+// BroadcastBoardDelete records a mock board delete event.
+func (b *mockBroadcaster) BroadcastBoardDelete(ctx context.Context, boardID string) error {
+	b.events = append(b.events, "board.delete")
+	return nil
+}
+
+// BroadcastBoardPhase records a mock board phase event.
+func (b *mockBroadcaster) BroadcastBoardPhase(ctx context.Context, board models.Board) error {
+	b.events = append(b.events, "board.phase")
+	return nil
+}
+
+// BroadcastMemberUpdate records a mock member update event.
+func (b *mockBroadcaster) BroadcastMemberUpdate(ctx context.Context, boardID string, member MemberDTO) error {
+	b.events = append(b.events, "board.member_update")
+	return nil
+}
+
+// BroadcastMemberRemove records a mock member removal event.
+func (b *mockBroadcaster) BroadcastMemberRemove(ctx context.Context, boardID string, userID string) error {
+	b.events = append(b.events, "board.member_remove")
+	return nil
+}
+
+// BroadcastActionItemCreate records a mock action item create event.
+func (b *mockBroadcaster) BroadcastActionItemCreate(ctx context.Context, item models.ActionItem) error {
+	b.events = append(b.events, "action_item.create")
+	return nil
+}
+
+// BroadcastActionItemUpdate records a mock action item update event.
+func (b *mockBroadcaster) BroadcastActionItemUpdate(ctx context.Context, item models.ActionItem) error {
+	b.events = append(b.events, "action_item.update")
+	return nil
+}
+
+// BroadcastActionItemDelete records a mock action item delete event.
+func (b *mockBroadcaster) BroadcastActionItemDelete(ctx context.Context, item models.ActionItem) error {
+	b.events = append(b.events, "action_item.delete")
+	return nil
+}
+
+// BroadcastTagCreate records a mock tag create event.
+func (b *mockBroadcaster) BroadcastTagCreate(ctx context.Context, tag models.BoardTag) error {
+	b.events = append(b.events, "tag.create")
+	return nil
+}
+
+// BroadcastTagDelete records a mock tag delete event.
+func (b *mockBroadcaster) BroadcastTagDelete(ctx context.Context, tag models.BoardTag) error {
+	b.events = append(b.events, "tag.delete")
+	return nil
+}
@@ -2,9 +2,11 @@ package board
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Wave-95/boards/backend-core/db"
 	"github.com/Wave-95/boards/backend-core/internal/models"
@@ -16,27 +18,79 @@ import (
 var (
 	errBoardDoesNotExist  = errors.New("Board does not exist")
 	errInviteDoesNotExist = errors.New("Invite does not exist")
+	errUserDoesNotExist   = errors.New("User does not exist")
+
+	errInviteLinkDoesNotExist = errors.New("Invite link does not exist")
+	errInviteLinkUsedUp       = errors.New("Invite link has been revoked or used up")
+
+	errAccessRequestDoesNotExist = errors.New("Access request does not exist")
+	errTemplateDoesNotExist      = errors.New("Template does not exist")
+	errActionItemDoesNotExist    = errors.New("Action item does not exist")
+	errTagDoesNotExist           = errors.New("Tag does not exist")
+	errTagNameExists             = errors.New("Tag name already exists on the board")
 )
 
 // Repository is an interface that represesnts all the capabilities for interacting with the database.
 type Repository interface {
 	CreateBoard(ctx context.Context, board models.Board) error
+	CreateBoardWithPostGroups(ctx context.Context, board models.Board, membership models.BoardMembership, postGroups []models.PostGroup) error
 	CreateMembership(ctx context.Context, membership models.BoardMembership) error
 	CreateInvites(ctx context.Context, invites []models.Invite) error
+	CreateInviteLink(ctx context.Context, link models.InviteLink) error
+	CreateEmailInvites(ctx context.Context, invites []models.EmailInvite) error
+	CreateAccessRequest(ctx context.Context, request models.AccessRequest) error
+	CreateTemplate(ctx context.Context, template models.BoardTemplate) error
+	CreateActionItem(ctx context.Context, item models.ActionItem) error
+	CreateTag(ctx context.Context, tag models.BoardTag) error
 
 	GetBoard(ctx context.Context, boardID uuid.UUID) (models.Board, error)
 	GetBoardAndUsers(ctx context.Context, boardID uuid.UUID) ([]BoardMembershipUser, error)
 	GetInvite(ctx context.Context, inviteID uuid.UUID) (InviteSenderReceiver, error)
+	GetInviteLink(ctx context.Context, linkID uuid.UUID) (models.InviteLink, error)
+	GetInviteLinkByToken(ctx context.Context, token string) (models.InviteLink, error)
+	GetUser(ctx context.Context, userID uuid.UUID) (models.User, error)
+	GetUserByEmail(ctx context.Context, email string) (models.User, error)
+	GetAccessRequest(ctx context.Context, requestID uuid.UUID) (models.AccessRequest, error)
+	GetTemplate(ctx context.Context, templateID uuid.UUID) (models.BoardTemplate, error)
+	GetActionItem(ctx context.Context, itemID uuid.UUID) (models.ActionItem, error)
+	GetTag(ctx context.Context, tagID uuid.UUID) (models.BoardTag, error)
 
 	ListOwnedBoards(ctx context.Context, userID uuid.UUID) ([]models.Board, error)
 	ListOwnedBoardAndUsers(ctx context.Context, userID uuid.UUID) ([]BoardMembershipUser, error)
 	ListSharedBoardAndUsers(ctx context.Context, userID uuid.UUID) ([]BoardMembershipUser, error)
+	ListArchivedBoardAndUsers(ctx context.Context, userID uuid.UUID) ([]BoardMembershipUser, error)
+	ListTrashedBoards(ctx context.Context, userID uuid.UUID) ([]models.Board, error)
 	ListInvitesByBoard(ctx context.Context, boardID uuid.UUID, status string) ([]InviteReceiver, error)
 	ListInvitesByReceiver(ctx context.Context, receiverID uuid.UUID, status string) ([]InviteBoardSender, error)
+	ListInviteLinksByBoard(ctx context.Context, boardID uuid.UUID) ([]models.InviteLink, error)
+	ListEmailInvitesByBoard(ctx context.Context, boardID uuid.UUID, status string) ([]models.EmailInvite, error)
+	ListAccessRequestsByBoard(ctx context.Context, boardID uuid.UUID, status string) ([]AccessRequestUser, error)
+	ListPostGroups(ctx context.Context, boardID uuid.UUID) ([]models.PostGroup, error)
+	ListPosts(ctx context.Context, boardID uuid.UUID) ([]models.Post, error)
+	ListTemplatesByUser(ctx context.Context, userID uuid.UUID) ([]models.BoardTemplate, error)
+	ListActionItemsByBoard(ctx context.Context, boardID uuid.UUID) ([]models.ActionItem, error)
+	ListActionItemsByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]ActionItemBoard, error)
+	ListTagsByBoard(ctx context.Context, boardID uuid.UUID) ([]models.BoardTag, error)
+	ListPurgeableAttachments(ctx context.Context, deletedBefore time.Time) ([]models.PostAttachment, error)
 
+	UpdateBoard(ctx context.Context, board models.Board) error
+	UpdateMembership(ctx context.Context, membership models.BoardMembership) error
 	UpdateInvite(ctx context.Context, invite models.Invite) error
+	UpdateInviteLink(ctx context.Context, link models.InviteLink) error
+	MarkInvitesReminded(ctx context.Context, now time.Time, remindBefore time.Time) ([]uuid.UUID, error)
+	ExpireInvites(ctx context.Context, now time.Time) (int64, error)
+	UpdateAccessRequest(ctx context.Context, request models.AccessRequest) error
+	TransferOwnership(ctx context.Context, board models.Board, membership models.BoardMembership) error
+	RedeemInviteLink(ctx context.Context, link models.InviteLink, membership models.BoardMembership) error
+	DuplicateBoard(ctx context.Context, board models.Board, membership models.BoardMembership, postGroups []models.PostGroup, posts []models.Post) error
+	UpdateActionItem(ctx context.Context, item models.ActionItem) error
 
 	DeleteBoard(ctx context.Context, boardID uuid.UUID) error
+	DeleteMembership(ctx context.Context, boardID uuid.UUID, userID uuid.UUID) error
+	PurgeBoards(ctx context.Context, deletedBefore time.Time) (int64, error)
+	DeleteTemplate(ctx context.Context, templateID uuid.UUID) error
+	DeleteActionItem(ctx context.Context, itemID uuid.UUID) error
+	DeleteTag(ctx context.Context, tagID uuid.UUID) error
 }
 
 type repository struct {
@@ -54,12 +108,13 @@ func NewRepository(conn *db.DB) *repository {
 func (r *repository) CreateBoard(ctx context.Context, board models.Board) error {
 	// Prepare board for insert
 	arg := db.CreateBoardParams{
-		ID:          pgtype.UUID{Bytes: board.ID, Valid: true},
-		Name:        pgtype.Text{String: *board.Name, Valid: true},
-		Description: pgtype.Text{String: *board.Description, Valid: true},
-		UserID:      pgtype.UUID{Bytes: board.UserID, Valid: true},
-		CreatedAt:   pgtype.Timestamp{Time: board.CreatedAt, Valid: true},
-		UpdatedAt:   pgtype.Timestamp{Time: board.UpdatedAt, Valid: true},
+		ID:             pgtype.UUID{Bytes: board.ID, Valid: true},
+		Name:           pgtype.Text{String: *board.Name, Valid: true},
+		Description:    pgtype.Text{String: *board.Description, Valid: true},
+		UserID:         pgtype.UUID{Bytes: board.UserID, Valid: true},
+		CreatedAt:      pgtype.Timestamp{Time: board.CreatedAt, Valid: true},
+		UpdatedAt:      pgtype.Timestamp{Time: board.UpdatedAt, Valid: true},
+		AllowedDomains: toAllowedDomainsDB(board.AllowedDomains),
 	}
 	err := r.q.CreateBoard(ctx, arg)
 	if err != nil {
@@ -113,6 +168,62 @@ func (r *repository) CreateInvites(ctx context.Context, invites []models.Invite)
 	return tx.Commit(ctx)
 }
 
+// CreateEmailInvites uses a db tx to insert a list of email invites. Email invites that already exist
+// have their sender and updated_at timestamp refreshed instead.
+func (r *repository) CreateEmailInvites(ctx context.Context, invites []models.EmailInvite) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = tx.Rollback(ctx)
+			if err != nil {
+				log.Printf("repository: failed to rollback tx: %v", err)
+			}
+		}
+	}()
+	qtx := r.q.WithTx(tx)
+	for _, invite := range invites {
+		arg := db.CreateEmailInviteParams(toEmailInviteDB(invite))
+		err = qtx.CreateEmailInvite(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("repository: failed to create email invite: %w", err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// CreateAccessRequest creates a single access request.
+func (r *repository) CreateAccessRequest(ctx context.Context, request models.AccessRequest) error {
+	arg := db.CreateAccessRequestParams(toAccessRequestDB(request))
+	if err := r.q.CreateAccessRequest(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to create access request: %w", err)
+	}
+	return nil
+}
+
+// CreateInviteLink creates a single invite link.
+func (r *repository) CreateInviteLink(ctx context.Context, link models.InviteLink) error {
+	arg := db.CreateInviteLinkParams(toInviteLinkDB(link))
+	if err := r.q.CreateInviteLink(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to create invite link: %w", err)
+	}
+	return nil
+}
+
+// CreateTemplate creates a user board template.
+func (r *repository) CreateTemplate(ctx context.Context, template models.BoardTemplate) error {
+	arg, err := toBoardTemplateDB(template)
+	if err != nil {
+		return fmt.Errorf("repository: failed to encode template post groups: %w", err)
+	}
+	if err := r.q.CreateBoardTemplate(ctx, db.CreateBoardTemplateParams(arg)); err != nil {
+		return fmt.Errorf("repository: failed to create template: %w", err)
+	}
+	return nil
+}
+
 // GetBoard returns a single board for a given board ID.
 func (r *repository) GetBoard(ctx context.Context, boardID uuid.UUID) (models.Board, error) {
 	row, err := r.q.GetBoard(ctx, pgtype.UUID{Bytes: boardID, Valid: true})
@@ -123,15 +234,7 @@ func (r *repository) GetBoard(ctx context.Context, boardID uuid.UUID) (models.Bo
 		return models.Board{}, fmt.Errorf("repository: failed to get board by id: %w", err)
 	}
 	// Convert storage type to domain type.
-	board := models.Board{
-		ID:          row.ID.Bytes,
-		Name:        &row.Name.String,
-		Description: &row.Description.String,
-		UserID:      row.UserID.Bytes,
-		CreatedAt:   row.CreatedAt.Time,
-		UpdatedAt:   row.UpdatedAt.Time,
-	}
-	return board, nil
+	return toBoard(row), nil
 }
 
 // GetBoardAndUsers returns a flat structure list of board and users. A BoardAndUser encapsulates Board,
@@ -190,15 +293,7 @@ func (r *repository) ListOwnedBoards(ctx context.Context, boardID uuid.UUID) ([]
 	// convert storage type to domain type.
 	list := []models.Board{}
 	for _, row := range rows {
-		board := models.Board{
-			ID:          row.ID.Bytes,
-			Name:        &row.Name.String,
-			Description: &row.Description.String,
-			UserID:      row.UserID.Bytes,
-			CreatedAt:   row.CreatedAt.Time,
-			UpdatedAt:   row.UpdatedAt.Time,
-		}
-		list = append(list, board)
+		list = append(list, toBoard(row))
 	}
 	return list, nil
 }
@@ -251,6 +346,38 @@ func (r *repository) ListSharedBoardAndUsers(ctx context.Context, userID uuid.UU
 	return list, nil
 }
 
+// ListArchivedBoardAndUsers returns the archived boards that a user is a member of along with all board members.
+func (r *repository) ListArchivedBoardAndUsers(ctx context.Context, userID uuid.UUID) ([]BoardMembershipUser, error) {
+	rows, err := r.q.ListArchivedBoardAndUsers(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list archived boards by user ID: %w", err)
+	}
+
+	// Convert database types into domain types
+	list := []BoardMembershipUser{}
+	for _, row := range rows {
+		list = append(list, BoardMembershipUser{
+			Board:      toBoard(row.Board),
+			User:       toUser(row.User),
+			Membership: toBoardMembership(row.BoardMembership),
+		})
+	}
+	return list, nil
+}
+
+// ListTrashedBoards returns the boards in the trash that a user is an admin of.
+func (r *repository) ListTrashedBoards(ctx context.Context, userID uuid.UUID) ([]models.Board, error) {
+	rows, err := r.q.ListTrashedBoards(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list trashed boards by user ID: %w", err)
+	}
+	list := []models.Board{}
+	for _, row := range rows {
+		list = append(list, toBoard(row))
+	}
+	return list, nil
+}
+
 // ListInvitesByBoard returns a list of board invites for a given board.
 func (r *repository) ListInvitesByBoard(ctx context.Context, boardID uuid.UUID, status string) ([]InviteReceiver, error) {
 	arg := db.ListInvitesByBoardParams{
@@ -292,6 +419,28 @@ func (r *repository) ListInvitesByReceiver(ctx context.Context, receiverID uuid.
 	return inviteBoardSenders, nil
 }
 
+// UpdateBoard updates a single board.
+func (r *repository) UpdateBoard(ctx context.Context, board models.Board) error {
+	if err := r.q.UpdateBoard(ctx, toUpdateBoardParams(board)); err != nil {
+		return fmt.Errorf("repository: failed to update board: %w", err)
+	}
+	return nil
+}
+
+// UpdateMembership updates the role of a user's membership to a board.
+func (r *repository) UpdateMembership(ctx context.Context, membership models.BoardMembership) error {
+	arg := db.UpdateMembershipParams{
+		BoardID:   pgtype.UUID{Bytes: membership.BoardID, Valid: true},
+		UserID:    pgtype.UUID{Bytes: membership.UserID, Valid: true},
+		Role:      pgtype.Text{String: string(membership.Role), Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: membership.UpdatedAt, Valid: true},
+	}
+	if err := r.q.UpdateMembership(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to update membership: %w", err)
+	}
+	return nil
+}
+
 // UpdateInvite updates an invite.
 func (r *repository) UpdateInvite(ctx context.Context, invite models.Invite) error {
 	arg := db.UpdateInviteParams(toInviteDB(invite))
@@ -305,6 +454,353 @@ func (r *repository) UpdateInvite(ctx context.Context, invite models.Invite) err
 	return nil
 }
 
+// GetInviteLink returns a single invite link for a given ID.
+func (r *repository) GetInviteLink(ctx context.Context, linkID uuid.UUID) (models.InviteLink, error) {
+	row, err := r.q.GetInviteLink(ctx, pgtype.UUID{Bytes: linkID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.InviteLink{}, errInviteLinkDoesNotExist
+		}
+		return models.InviteLink{}, fmt.Errorf("repository: failed to get invite link by id: %w", err)
+	}
+	return toInviteLink(row), nil
+}
+
+// GetInviteLinkByToken returns a single invite link for a given token.
+func (r *repository) GetInviteLinkByToken(ctx context.Context, token string) (models.InviteLink, error) {
+	row, err := r.q.GetInviteLinkByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.InviteLink{}, errInviteLinkDoesNotExist
+		}
+		return models.InviteLink{}, fmt.Errorf("repository: failed to get invite link by token: %w", err)
+	}
+	return toInviteLink(row), nil
+}
+
+// GetUser returns a single user.
+func (r *repository) GetUser(ctx context.Context, userID uuid.UUID) (models.User, error) {
+	row, err := r.q.GetUser(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.User{}, errUserDoesNotExist
+		}
+		return models.User{}, fmt.Errorf("repository: failed to get user: %w", err)
+	}
+	return toUser(row), nil
+}
+
+// GetUserByEmail returns the user registered with the given email.
+func (r *repository) GetUserByEmail(ctx context.Context, email string) (models.User, error) {
+	row, err := r.q.GetUserByEmail(ctx, pgtype.Text{String: email, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.User{}, errUserDoesNotExist
+		}
+		return models.User{}, fmt.Errorf("repository: failed to get user by email: %w", err)
+	}
+	return toUser(row), nil
+}
+
+// GetAccessRequest returns a single access request.
+func (r *repository) GetAccessRequest(ctx context.Context, requestID uuid.UUID) (models.AccessRequest, error) {
+	row, err := r.q.GetAccessRequest(ctx, pgtype.UUID{Bytes: requestID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.AccessRequest{}, errAccessRequestDoesNotExist
+		}
+		return models.AccessRequest{}, fmt.Errorf("repository: failed to get access request: %w", err)
+	}
+	return toAccessRequest(row), nil
+}
+
+// GetTemplate returns a single user board template.
+func (r *repository) GetTemplate(ctx context.Context, templateID uuid.UUID) (models.BoardTemplate, error) {
+	row, err := r.q.GetBoardTemplate(ctx, pgtype.UUID{Bytes: templateID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.BoardTemplate{}, errTemplateDoesNotExist
+		}
+		return models.BoardTemplate{}, fmt.Errorf("repository: failed to get template: %w", err)
+	}
+	return toBoardTemplate(row)
+}
+
+// ListTemplatesByUser returns the board templates that a user has saved.
+func (r *repository) ListTemplatesByUser(ctx context.Context, userID uuid.UUID) ([]models.BoardTemplate, error) {
+	rows, err := r.q.ListBoardTemplatesByUser(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err != nil {
+		return []models.BoardTemplate{}, fmt.Errorf("repository: failed to list templates: %w", err)
+	}
+	templates := make([]models.BoardTemplate, len(rows))
+	for i, row := range rows {
+		if templates[i], err = toBoardTemplate(row); err != nil {
+			return []models.BoardTemplate{}, err
+		}
+	}
+	return templates, nil
+}
+
+// ListAccessRequestsByBoard returns a list of access requests for a board along with the requesting users.
+func (r *repository) ListAccessRequestsByBoard(ctx context.Context, boardID uuid.UUID, status string) ([]AccessRequestUser, error) {
+	arg := db.ListAccessRequestsByBoardParams{
+		BoardID: pgtype.UUID{Bytes: boardID, Valid: true},
+	}
+	if status != "" {
+		arg.Status = pgtype.Text{String: status, Valid: true}
+	}
+	rows, err := r.q.ListAccessRequestsByBoard(ctx, arg)
+	if err != nil {
+		return []AccessRequestUser{}, fmt.Errorf("repository: failed to list access requests: %w", err)
+	}
+	requestUsers := []AccessRequestUser{}
+	for _, row := range rows {
+		request := toAccessRequest(row.BoardAccessRequest)
+		user := toUser(row.User)
+		requestUsers = append(requestUsers, AccessRequestUser{request, user})
+	}
+	return requestUsers, nil
+}
+
+// ListEmailInvitesByBoard returns the email invites of a board with the given status.
+func (r *repository) ListEmailInvitesByBoard(ctx context.Context, boardID uuid.UUID, status string) ([]models.EmailInvite, error) {
+	arg := db.ListEmailInvitesByBoardParams{
+		BoardID: pgtype.UUID{Bytes: boardID, Valid: true},
+		Status:  pgtype.Text{String: status, Valid: true},
+	}
+	rows, err := r.q.ListEmailInvitesByBoard(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list email invites by board: %w", err)
+	}
+	invites := []models.EmailInvite{}
+	for _, row := range rows {
+		invites = append(invites, toEmailInvite(row))
+	}
+	return invites, nil
+}
+
+// ListInviteLinksByBoard returns the invite links of a board that have not been revoked.
+func (r *repository) ListInviteLinksByBoard(ctx context.Context, boardID uuid.UUID) ([]models.InviteLink, error) {
+	rows, err := r.q.ListInviteLinksByBoard(ctx, pgtype.UUID{Bytes: boardID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list invite links by board: %w", err)
+	}
+	links := []models.InviteLink{}
+	for _, row := range rows {
+		links = append(links, toInviteLink(row))
+	}
+	return links, nil
+}
+
+// ListPostGroups returns all of a board's post groups, including the ones without any posts.
+func (r *repository) ListPostGroups(ctx context.Context, boardID uuid.UUID) ([]models.PostGroup, error) {
+	rows, err := r.q.ListPostGroupsByBoard(ctx, pgtype.UUID{Bytes: boardID, Valid: true})
+	if err != nil {
+		return []models.PostGroup{}, fmt.Errorf("repository: failed to list post groups: %w", err)
+	}
+	postGroups := make([]models.PostGroup, len(rows))
+	for i, row := range rows {
+		postGroups[i] = toPostGroup(row)
+	}
+	return postGroups, nil
+}
+
+// ListPosts returns all of the posts that belong to a board's post groups.
+func (r *repository) ListPosts(ctx context.Context, boardID uuid.UUID) ([]models.Post, error) {
+	rows, err := r.q.ListPostsByBoard(ctx, pgtype.UUID{Bytes: boardID, Valid: true})
+	if err != nil {
+		return []models.Post{}, fmt.Errorf("repository: failed to list posts: %w", err)
+	}
+	posts := make([]models.Post, len(rows))
+	for i, row := range rows {
+		posts[i] = toPost(row)
+	}
+	return posts, nil
+}
+
+// MarkInvitesReminded marks the pending invites that expire before remindBefore and have not been reminded yet
+// as reminded, and returns their IDs. Each invite is only returned once.
+func (r *repository) MarkInvitesReminded(ctx context.Context, now time.Time, remindBefore time.Time) ([]uuid.UUID, error) {
+	arg := db.MarkInvitesRemindedParams{
+		Now:          pgtype.Timestamp{Time: now, Valid: true},
+		RemindBefore: pgtype.Timestamp{Time: remindBefore, Valid: true},
+	}
+	rows, err := r.q.MarkInvitesReminded(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to mark invites as reminded: %w", err)
+	}
+	inviteIDs := []uuid.UUID{}
+	for _, row := range rows {
+		inviteIDs = append(inviteIDs, row.Bytes)
+	}
+	return inviteIDs, nil
+}
+
+// ExpireInvites flips the pending invites that are past their expiry to EXPIRED and returns how many were expired.
+func (r *repository) ExpireInvites(ctx context.Context, now time.Time) (int64, error) {
+	count, err := r.q.ExpireInvites(ctx, pgtype.Timestamp{Time: now, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("repository: failed to expire invites: %w", err)
+	}
+	return count, nil
+}
+
+// UpdateAccessRequest updates an access request.
+func (r *repository) UpdateAccessRequest(ctx context.Context, request models.AccessRequest) error {
+	arg := db.UpdateAccessRequestParams(toAccessRequestDB(request))
+	if err := r.q.UpdateAccessRequest(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to update access request: %w", err)
+	}
+	return nil
+}
+
+// UpdateInviteLink updates an invite link.
+func (r *repository) UpdateInviteLink(ctx context.Context, link models.InviteLink) error {
+	arg := db.UpdateInviteLinkParams(toInviteLinkDB(link))
+	if err := r.q.UpdateInviteLink(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to update invite link: %w", err)
+	}
+	return nil
+}
+
+// RedeemInviteLink uses a db tx to consume a use of an invite link and create the membership it grants. The
+// use is only consumed if the link has not been revoked or used up, otherwise the tx is rolled back.
+func (r *repository) RedeemInviteLink(ctx context.Context, link models.InviteLink, membership models.BoardMembership) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("repository: failed to rollback tx: %v", rbErr)
+			}
+		}
+	}()
+	qtx := r.q.WithTx(tx)
+	rowsAffected, err := qtx.IncrementInviteLinkUseCount(ctx, db.IncrementInviteLinkUseCountParams{
+		ID:        pgtype.UUID{Bytes: link.ID, Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: membership.CreatedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("repository: failed to increment invite link use count: %w", err)
+	}
+	if rowsAffected == 0 {
+		err = errInviteLinkUsedUp
+		return err
+	}
+	err = qtx.CreateMembership(ctx, db.CreateMembershipParams{
+		ID:        pgtype.UUID{Bytes: membership.ID, Valid: true},
+		UserID:    pgtype.UUID{Bytes: membership.UserID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: membership.BoardID, Valid: true},
+		Role:      pgtype.Text{String: string(membership.Role), Valid: true},
+		CreatedAt: pgtype.Timestamp{Time: membership.CreatedAt, Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: membership.UpdatedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("repository: failed to create membership from invite link: %w", err)
+	}
+	return tx.Commit(ctx)
+}
+
+// TransferOwnership uses a db tx to reassign the owner of a board and update the new owner's membership. It
+// will rollback the tx if either update fails.
+func (r *repository) TransferOwnership(ctx context.Context, board models.Board, membership models.BoardMembership) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("repository: failed to rollback tx: %v", rbErr)
+			}
+		}
+	}()
+	qtx := r.q.WithTx(tx)
+	err = qtx.UpdateBoard(ctx, toUpdateBoardParams(board))
+	if err != nil {
+		return fmt.Errorf("repository: failed to update board owner: %w", err)
+	}
+	err = qtx.UpdateMembership(ctx, db.UpdateMembershipParams{
+		BoardID:   pgtype.UUID{Bytes: membership.BoardID, Valid: true},
+		UserID:    pgtype.UUID{Bytes: membership.UserID, Valid: true},
+		Role:      pgtype.Text{String: string(membership.Role), Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: membership.UpdatedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("repository: failed to update new owner membership: %w", err)
+	}
+	return tx.Commit(ctx)
+}
+
+// DuplicateBoard uses a db tx to create a board together with its owner membership, post groups, and posts.
+// It will rollback the tx if any of the inserts fail, so a board is never left partially copied.
+func (r *repository) DuplicateBoard(ctx context.Context, board models.Board, membership models.BoardMembership, postGroups []models.PostGroup, posts []models.Post) error {
+	return r.createBoardWithContent(ctx, board, membership, postGroups, posts)
+}
+
+// CreateBoardWithPostGroups uses a db tx to create a board together with its owner membership and post groups.
+// It will rollback the tx if any of the inserts fail, so a board is never left without its membership or
+// template post groups.
+func (r *repository) CreateBoardWithPostGroups(ctx context.Context, board models.Board, membership models.BoardMembership, postGroups []models.PostGroup) error {
+	return r.createBoardWithContent(ctx, board, membership, postGroups, nil)
+}
+
+// createBoardWithContent uses a db tx to create a board, its owner membership, post groups, and posts.
+func (r *repository) createBoardWithContent(ctx context.Context, board models.Board, membership models.BoardMembership, postGroups []models.PostGroup, posts []models.Post) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("repository: failed to rollback tx: %v", rbErr)
+			}
+		}
+	}()
+	qtx := r.q.WithTx(tx)
+	err = qtx.CreateBoard(ctx, db.CreateBoardParams{
+		ID:             pgtype.UUID{Bytes: board.ID, Valid: true},
+		Name:           pgtype.Text{String: *board.Name, Valid: true},
+		Description:    pgtype.Text{String: *board.Description, Valid: true},
+		UserID:         pgtype.UUID{Bytes: board.UserID, Valid: true},
+		CreatedAt:      pgtype.Timestamp{Time: board.CreatedAt, Valid: true},
+		UpdatedAt:      pgtype.Timestamp{Time: board.UpdatedAt, Valid: true},
+		AllowedDomains: toAllowedDomainsDB(board.AllowedDomains),
+	})
+	if err != nil {
+		return fmt.Errorf("repository: failed to create board: %w", err)
+	}
+	// Board settings such as anonymous and hidden posts are not part of a new board and are set separately
+	if err = qtx.UpdateBoard(ctx, toUpdateBoardParams(board)); err != nil {
+		return fmt.Errorf("repository: failed to update board: %w", err)
+	}
+	err = qtx.CreateMembership(ctx, db.CreateMembershipParams{
+		ID:        pgtype.UUID{Bytes: membership.ID, Valid: true},
+		UserID:    pgtype.UUID{Bytes: membership.UserID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: membership.BoardID, Valid: true},
+		Role:      pgtype.Text{String: string(membership.Role), Valid: true},
+		CreatedAt: pgtype.Timestamp{Time: membership.CreatedAt, Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: membership.UpdatedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("repository: failed to create membership: %w", err)
+	}
+	for _, postGroup := range postGroups {
+		if err = qtx.CreatePostGroup(ctx, db.CreatePostGroupParams(toPostGroupDB(postGroup))); err != nil {
+			return fmt.Errorf("repository: failed to create post group: %w", err)
+		}
+	}
+	for _, post := range posts {
+		if err = qtx.CreatePost(ctx, db.CreatePostParams(toPostDB(post))); err != nil {
+			return fmt.Errorf("repository: failed to create post: %w", err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
 // DeleteBoard deletes a single board.
 func (r *repository) DeleteBoard(ctx context.Context, boardID uuid.UUID) error {
 	err := r.q.DeleteBoard(ctx, pgtype.UUID{Bytes: boardID, Valid: true})
@@ -314,6 +810,210 @@ func (r *repository) DeleteBoard(ctx context.Context, boardID uuid.UUID) error {
 	return nil
 }
 
+// ListPurgeableAttachments returns the post attachments of the boards that were moved to the trash before
+// deletedBefore.
+func (r *repository) ListPurgeableAttachments(ctx context.Context, deletedBefore time.Time) ([]models.PostAttachment, error) {
+	attachmentsDB, err := r.q.ListPurgeablePostAttachments(ctx, pgtype.Timestamp{Time: deletedBefore, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list purgeable attachments: %w", err)
+	}
+	attachments := make([]models.PostAttachment, len(attachmentsDB))
+	for i, attachmentDB := range attachmentsDB {
+		attachments[i] = toAttachment(attachmentDB)
+	}
+	return attachments, nil
+}
+
+// PurgeBoards uses a db tx to permanently delete the boards that were moved to the trash before deletedBefore,
+// along with their post groups and posts. It returns the number of purged boards.
+func (r *repository) PurgeBoards(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("repository: failed to rollback tx: %v", rbErr)
+			}
+		}
+	}()
+	qtx := r.q.WithTx(tx)
+	arg := pgtype.Timestamp{Time: deletedBefore, Valid: true}
+	// Posts are not tied to boards by a foreign key, so they are deleted before their post groups.
+	if err = qtx.PurgePosts(ctx, arg); err != nil {
+		return 0, fmt.Errorf("repository: failed to purge posts: %w", err)
+	}
+	if err = qtx.PurgePostGroups(ctx, arg); err != nil {
+		return 0, fmt.Errorf("repository: failed to purge post groups: %w", err)
+	}
+	count, err := qtx.PurgeBoards(ctx, arg)
+	if err != nil {
+		return 0, fmt.Errorf("repository: failed to purge boards: %w", err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DeleteTemplate deletes a single user board template.
+func (r *repository) DeleteTemplate(ctx context.Context, templateID uuid.UUID) error {
+	if err := r.q.DeleteBoardTemplate(ctx, pgtype.UUID{Bytes: templateID, Valid: true}); err != nil {
+		return fmt.Errorf("repository: failed to delete template: %w", err)
+	}
+	return nil
+}
+
+// CreateActionItem creates a single action item.
+func (r *repository) CreateActionItem(ctx context.Context, item models.ActionItem) error {
+	arg := db.CreateActionItemParams(toActionItemDB(item))
+	if err := r.q.CreateActionItem(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to create action item: %w", err)
+	}
+	return nil
+}
+
+// GetActionItem returns a single action item.
+func (r *repository) GetActionItem(ctx context.Context, itemID uuid.UUID) (models.ActionItem, error) {
+	row, err := r.q.GetActionItem(ctx, pgtype.UUID{Bytes: itemID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ActionItem{}, errActionItemDoesNotExist
+		}
+		return models.ActionItem{}, fmt.Errorf("repository: failed to get action item: %w", err)
+	}
+	return toActionItem(row), nil
+}
+
+// ListActionItemsByBoard returns the action items of a board, oldest first.
+func (r *repository) ListActionItemsByBoard(ctx context.Context, boardID uuid.UUID) ([]models.ActionItem, error) {
+	rows, err := r.q.ListActionItemsByBoard(ctx, pgtype.UUID{Bytes: boardID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list action items by board: %w", err)
+	}
+	items := []models.ActionItem{}
+	for _, row := range rows {
+		items = append(items, toActionItem(row))
+	}
+	return items, nil
+}
+
+// ListActionItemsByAssignee returns the action items assigned to a user along with the names of their boards.
+// Only boards that the user is still a member of and that are not in the trash are included. Open action items
+// come first, ordered by due date.
+func (r *repository) ListActionItemsByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]ActionItemBoard, error) {
+	rows, err := r.q.ListActionItemsByAssignee(ctx, pgtype.UUID{Bytes: assigneeID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list action items by assignee: %w", err)
+	}
+	items := []ActionItemBoard{}
+	for _, row := range rows {
+		item := ActionItemBoard{ActionItem: toActionItem(row.ActionItem)}
+		if row.BoardName.Valid {
+			item.BoardName = &row.BoardName.String
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// UpdateActionItem updates the assignee, title, due date, and status of an action item.
+func (r *repository) UpdateActionItem(ctx context.Context, item models.ActionItem) error {
+	row := toActionItemDB(item)
+	arg := db.UpdateActionItemParams{
+		ID:         row.ID,
+		AssigneeID: row.AssigneeID,
+		Title:      row.Title,
+		DueDate:    row.DueDate,
+		Status:     row.Status,
+		UpdatedAt:  row.UpdatedAt,
+	}
+	if err := r.q.UpdateActionItem(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to update action item: %w", err)
+	}
+	return nil
+}
+
+// DeleteActionItem deletes a single action item.
+func (r *repository) DeleteActionItem(ctx context.Context, itemID uuid.UUID) error {
+	if err := r.q.DeleteActionItem(ctx, pgtype.UUID{Bytes: itemID, Valid: true}); err != nil {
+		return fmt.Errorf("repository: failed to delete action item: %w", err)
+	}
+	return nil
+}
+
+// CreateTag adds a tag to the tag catalog of a board. errTagNameExists is returned if the board already has a
+// tag with the same name.
+func (r *repository) CreateTag(ctx context.Context, tag models.BoardTag) error {
+	arg := db.CreateBoardTagParams{
+		ID:        pgtype.UUID{Bytes: tag.ID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: tag.BoardID, Valid: true},
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: pgtype.Timestamp{Time: tag.CreatedAt, Valid: true},
+	}
+	count, err := r.q.CreateBoardTag(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("repository: failed to create tag: %w", err)
+	}
+	if count == 0 {
+		return errTagNameExists
+	}
+	return nil
+}
+
+// GetTag returns a single tag.
+func (r *repository) GetTag(ctx context.Context, tagID uuid.UUID) (models.BoardTag, error) {
+	row, err := r.q.GetBoardTag(ctx, pgtype.UUID{Bytes: tagID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.BoardTag{}, errTagDoesNotExist
+		}
+		return models.BoardTag{}, fmt.Errorf("repository: failed to get tag: %w", err)
+	}
+	return toBoardTag(row), nil
+}
+
+// ListTagsByBoard returns the tag catalog of a board, oldest first.
+func (r *repository) ListTagsByBoard(ctx context.Context, boardID uuid.UUID) ([]models.BoardTag, error) {
+	rows, err := r.q.ListBoardTagsByBoard(ctx, pgtype.UUID{Bytes: boardID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list tags by board: %w", err)
+	}
+	tags := []models.BoardTag{}
+	for _, row := range rows {
+		tags = append(tags, toBoardTag(row))
+	}
+	return tags, nil
+}
+
+// DeleteTag deletes a tag from the tag catalog of a board. The tag is removed from every post along with it.
+func (r *repository) DeleteTag(ctx context.Context, tagID uuid.UUID) error {
+	if err := r.q.DeleteBoardTag(ctx, pgtype.UUID{Bytes: tagID, Valid: true}); err != nil {
+		return fmt.Errorf("repository: failed to delete tag: %w", err)
+	}
+	return nil
+}
+
+// DeleteMembership deletes a user's membership to a board--this is effectively removing a user from a board.
+func (r *repository) DeleteMembership(ctx context.Context, boardID uuid.UUID, userID uuid.UUID) error {
+	arg := db.DeleteMembershipParams{
+		BoardID: pgtype.UUID{Bytes: boardID, Valid: true},
+		UserID:  pgtype.UUID{Bytes: userID, Valid: true},
+	}
+	if err := r.q.DeleteMembership(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to delete membership: %w", err)
+	}
+	return nil
+}
+
+// ActionItemBoard encapsulates an action item along with the name of its board.
+type ActionItemBoard struct {
+	ActionItem models.ActionItem
+	BoardName  *string
+}
+
 // BoardMembershipUser encapsulates the domain models for board, board membership, and user.
 type BoardMembershipUser struct {
 	Board      models.Board
@@ -322,14 +1022,65 @@ type BoardMembershipUser struct {
 }
 
 func toBoard(dbBoard db.Board) models.Board {
-	return models.Board{
-		ID:          dbBoard.ID.Bytes,
-		Name:        &dbBoard.Name.String,
-		Description: &dbBoard.Description.String,
-		UserID:      dbBoard.UserID.Bytes,
-		CreatedAt:   dbBoard.CreatedAt.Time,
-		UpdatedAt:   dbBoard.UpdatedAt.Time,
+	board := models.Board{
+		ID:             dbBoard.ID.Bytes,
+		Name:           &dbBoard.Name.String,
+		Description:    &dbBoard.Description.String,
+		UserID:         dbBoard.UserID.Bytes,
+		CreatedAt:      dbBoard.CreatedAt.Time,
+		UpdatedAt:      dbBoard.UpdatedAt.Time,
+		AllowedDomains: dbBoard.AllowedDomains,
+		Phase:          models.BoardPhase(dbBoard.Phase),
+		VoteLimit:      int(dbBoard.VoteLimit),
+		HideVotes:      dbBoard.HideVotes,
+		AnonymousPosts: dbBoard.AnonymousPosts,
+		HidePosts:      dbBoard.HidePosts,
+	}
+	if dbBoard.ArchivedAt.Valid {
+		board.ArchivedAt = &dbBoard.ArchivedAt.Time
+	}
+	if dbBoard.DeletedAt.Valid {
+		board.DeletedAt = &dbBoard.DeletedAt.Time
+	}
+	return board
+}
+
+func toUpdateBoardParams(board models.Board) db.UpdateBoardParams {
+	arg := db.UpdateBoardParams{
+		ID:             pgtype.UUID{Bytes: board.ID, Valid: true},
+		Name:           pgtype.Text{String: *board.Name, Valid: true},
+		Description:    pgtype.Text{String: *board.Description, Valid: true},
+		UserID:         pgtype.UUID{Bytes: board.UserID, Valid: true},
+		CreatedAt:      pgtype.Timestamp{Time: board.CreatedAt, Valid: true},
+		UpdatedAt:      pgtype.Timestamp{Time: board.UpdatedAt, Valid: true},
+		AllowedDomains: toAllowedDomainsDB(board.AllowedDomains),
+		Phase:          string(models.PhaseBrainstorm),
+		VoteLimit:      int32(models.DefaultVoteLimit),
+		HideVotes:      board.HideVotes,
+		AnonymousPosts: board.AnonymousPosts,
+		HidePosts:      board.HidePosts,
+	}
+	if board.Phase != "" {
+		arg.Phase = string(board.Phase)
+	}
+	if board.VoteLimit != 0 {
+		arg.VoteLimit = int32(board.VoteLimit)
 	}
+	if board.ArchivedAt != nil {
+		arg.ArchivedAt = pgtype.Timestamp{Time: *board.ArchivedAt, Valid: true}
+	}
+	if board.DeletedAt != nil {
+		arg.DeletedAt = pgtype.Timestamp{Time: *board.DeletedAt, Valid: true}
+	}
+	return arg
+}
+
+// toAllowedDomainsDB guards against writing a nil slice, which would be stored as NULL.
+func toAllowedDomainsDB(domains []string) []string {
+	if domains == nil {
+		return []string{}
+	}
+	return domains
 }
 
 func toUser(dbUser db.User) models.User {
@@ -348,7 +1099,7 @@ func toUser(dbUser db.User) models.User {
 func toBoardMembership(dbBoardMembership db.BoardMembership) models.BoardMembership {
 	return models.BoardMembership{
 		ID:        dbBoardMembership.ID.Bytes,
-		BoardID:   dbBoardMembership.UserID.Bytes,
+		BoardID:   dbBoardMembership.BoardID.Bytes,
 		UserID:    dbBoardMembership.UserID.Bytes,
 		Role:      models.BoardMembershipRole(dbBoardMembership.Role.String),
 		CreatedAt: dbBoardMembership.CreatedAt.Time,
@@ -356,6 +1107,125 @@ func toBoardMembership(dbBoardMembership db.BoardMembership) models.BoardMembers
 	}
 }
 
+// toPostGroup maps a db post group to a domain post group.
+func toPostGroup(postGroupDB db.PostGroup) models.PostGroup {
+	return models.PostGroup{
+		ID:        postGroupDB.ID.Bytes,
+		BoardID:   postGroupDB.BoardID.Bytes,
+		Title:     postGroupDB.Title.String,
+		PosX:      int(postGroupDB.PosX.Int32),
+		PosY:      int(postGroupDB.PosY.Int32),
+		ZIndex:    int(postGroupDB.ZIndex.Int32),
+		CreatedAt: postGroupDB.CreatedAt.Time,
+		UpdatedAt: postGroupDB.UpdatedAt.Time,
+	}
+}
+
+// toPostGroupDB maps a domain post group to a db post group.
+func toPostGroupDB(postGroup models.PostGroup) db.PostGroup {
+	return db.PostGroup{
+		ID:        pgtype.UUID{Bytes: postGroup.ID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: postGroup.BoardID, Valid: true},
+		Title:     pgtype.Text{String: postGroup.Title, Valid: true},
+		PosX:      pgtype.Int4{Int32: int32(postGroup.PosX), Valid: true},
+		PosY:      pgtype.Int4{Int32: int32(postGroup.PosY), Valid: true},
+		ZIndex:    pgtype.Int4{Int32: int32(postGroup.ZIndex), Valid: true},
+		CreatedAt: pgtype.Timestamp{Time: postGroup.CreatedAt, Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: postGroup.UpdatedAt, Valid: true},
+	}
+}
+
+// toPost maps a db post to a domain post.
+func toPost(postDB db.Post) models.Post {
+	return models.Post{
+		ID:            postDB.ID.Bytes,
+		UserID:        postDB.UserID.Bytes,
+		Content:       postDB.Content.String,
+		Color:         postDB.Color.String,
+		Height:        int(postDB.Height.Int32),
+		CreatedAt:     postDB.CreatedAt.Time,
+		UpdatedAt:     postDB.UpdatedAt.Time,
+		PostOrder:     postDB.PostOrder.Float64,
+		PostGroupID:   postDB.PostGroupID.Bytes,
+		ContentFormat: models.PostContentFormat(postDB.ContentFormat),
+	}
+}
+
+// toAttachment maps a db post attachment to a domain post attachment.
+func toAttachment(attachment db.PostAttachment) models.PostAttachment {
+	return models.PostAttachment{
+		ID:          attachment.ID.Bytes,
+		PostID:      attachment.PostID.Bytes,
+		BoardID:     attachment.BoardID.Bytes,
+		UserID:      attachment.UserID.Bytes,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt.Time,
+	}
+}
+
+// toPostDB maps a domain post to a db post.
+func toPostDB(post models.Post) db.Post {
+	return db.Post{
+		ID:            pgtype.UUID{Bytes: post.ID, Valid: true},
+		UserID:        pgtype.UUID{Bytes: post.UserID, Valid: true},
+		Content:       pgtype.Text{String: post.Content, Valid: true},
+		Color:         pgtype.Text{String: post.Color, Valid: true},
+		Height:        pgtype.Int4{Int32: int32(post.Height), Valid: true},
+		CreatedAt:     pgtype.Timestamp{Time: post.CreatedAt, Valid: true},
+		UpdatedAt:     pgtype.Timestamp{Time: post.UpdatedAt, Valid: true},
+		PostOrder:     pgtype.Float8{Float64: post.PostOrder, Valid: true},
+		PostGroupID:   pgtype.UUID{Bytes: post.PostGroupID, Valid: true},
+		ContentFormat: string(post.ContentFormat),
+	}
+}
+
+// toBoardTemplate maps a db board template to a domain board template. The post groups of a template are stored
+// as a JSON array.
+func toBoardTemplate(row db.BoardTemplate) (models.BoardTemplate, error) {
+	template := models.BoardTemplate{
+		ID:          row.ID.Bytes,
+		Name:        row.Name,
+		Description: row.Description.String,
+		PostGroups:  []models.TemplatePostGroup{},
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+	if row.UserID.Valid {
+		userID := uuid.UUID(row.UserID.Bytes)
+		template.UserID = &userID
+	}
+	if err := json.Unmarshal(row.PostGroups, &template.PostGroups); err != nil {
+		return models.BoardTemplate{}, fmt.Errorf("repository: failed to decode template post groups: %w", err)
+	}
+	return template, nil
+}
+
+// toBoardTemplateDB maps a domain board template to a db board template.
+func toBoardTemplateDB(template models.BoardTemplate) (db.BoardTemplate, error) {
+	postGroups := template.PostGroups
+	if postGroups == nil {
+		postGroups = []models.TemplatePostGroup{}
+	}
+	postGroupsJSON, err := json.Marshal(postGroups)
+	if err != nil {
+		return db.BoardTemplate{}, err
+	}
+	row := db.BoardTemplate{
+		ID:          pgtype.UUID{Bytes: template.ID, Valid: true},
+		Name:        template.Name,
+		Description: pgtype.Text{String: template.Description, Valid: true},
+		PostGroups:  postGroupsJSON,
+		CreatedAt:   pgtype.Timestamp{Time: template.CreatedAt, Valid: true},
+		UpdatedAt:   pgtype.Timestamp{Time: template.UpdatedAt, Valid: true},
+	}
+	if template.UserID != nil {
+		row.UserID = pgtype.UUID{Bytes: *template.UserID, Valid: true}
+	}
+	return row, nil
+}
+
 // InviteSenderReceiver is a struct that encapsulates the invite, sender, and receiver domain models.
 type InviteSenderReceiver struct {
 	Invite   models.Invite
@@ -376,8 +1246,14 @@ type InviteReceiver struct {
 	Receiver models.User
 }
 
+// AccessRequestUser is a struct that encapsulates domain models.
+type AccessRequestUser struct {
+	AccessRequest models.AccessRequest
+	User          models.User
+}
+
 func toInvite(row db.BoardInvite) models.Invite {
-	return models.Invite{
+	invite := models.Invite{
 		ID:         row.ID.Bytes,
 		BoardID:    row.BoardID.Bytes,
 		SenderID:   row.SenderID.Bytes,
@@ -386,10 +1262,17 @@ func toInvite(row db.BoardInvite) models.Invite {
 		CreatedAt:  row.CreatedAt.Time,
 		UpdatedAt:  row.UpdatedAt.Time,
 	}
+	if row.ExpiresAt.Valid {
+		invite.ExpiresAt = &row.ExpiresAt.Time
+	}
+	if row.RemindedAt.Valid {
+		invite.RemindedAt = &row.RemindedAt.Time
+	}
+	return invite
 }
 
 func toInviteDB(invite models.Invite) db.BoardInvite {
-	return db.BoardInvite{
+	row := db.BoardInvite{
 		ID:         pgtype.UUID{Bytes: invite.ID, Valid: true},
 		BoardID:    pgtype.UUID{Bytes: invite.BoardID, Valid: true},
 		SenderID:   pgtype.UUID{Bytes: invite.SenderID, Valid: true},
@@ -398,4 +1281,160 @@ func toInviteDB(invite models.Invite) db.BoardInvite {
 		CreatedAt:  pgtype.Timestamp{Time: invite.CreatedAt, Valid: true},
 		UpdatedAt:  pgtype.Timestamp{Time: invite.UpdatedAt, Valid: true},
 	}
+	if invite.ExpiresAt != nil {
+		row.ExpiresAt = pgtype.Timestamp{Time: *invite.ExpiresAt, Valid: true}
+	}
+	if invite.RemindedAt != nil {
+		row.RemindedAt = pgtype.Timestamp{Time: *invite.RemindedAt, Valid: true}
+	}
+	return row
+}
+
+func toEmailInvite(row db.BoardEmailInvite) models.EmailInvite {
+	return models.EmailInvite{
+		ID:        row.ID.Bytes,
+		BoardID:   row.BoardID.Bytes,
+		SenderID:  row.SenderID.Bytes,
+		Email:     row.Email,
+		Status:    models.InviteStatus(row.Status.String),
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+}
+
+func toEmailInviteDB(invite models.EmailInvite) db.BoardEmailInvite {
+	return db.BoardEmailInvite{
+		ID:        pgtype.UUID{Bytes: invite.ID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: invite.BoardID, Valid: true},
+		SenderID:  pgtype.UUID{Bytes: invite.SenderID, Valid: true},
+		Email:     invite.Email,
+		Status:    pgtype.Text{String: string(invite.Status), Valid: true},
+		CreatedAt: pgtype.Timestamp{Time: invite.CreatedAt, Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: invite.UpdatedAt, Valid: true},
+	}
+}
+
+func toAccessRequest(row db.BoardAccessRequest) models.AccessRequest {
+	return models.AccessRequest{
+		ID:        row.ID.Bytes,
+		BoardID:   row.BoardID.Bytes,
+		UserID:    row.UserID.Bytes,
+		Status:    models.AccessRequestStatus(row.Status.String),
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+}
+
+func toAccessRequestDB(request models.AccessRequest) db.BoardAccessRequest {
+	return db.BoardAccessRequest{
+		ID:        pgtype.UUID{Bytes: request.ID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: request.BoardID, Valid: true},
+		UserID:    pgtype.UUID{Bytes: request.UserID, Valid: true},
+		Status:    pgtype.Text{String: string(request.Status), Valid: true},
+		CreatedAt: pgtype.Timestamp{Time: request.CreatedAt, Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: request.UpdatedAt, Valid: true},
+	}
+}
+
+func toInviteLink(row db.BoardInviteLink) models.InviteLink {
+	link := models.InviteLink{
+		ID:        row.ID.Bytes,
+		BoardID:   row.BoardID.Bytes,
+		UserID:    row.UserID.Bytes,
+		Token:     row.Token,
+		Role:      models.BoardMembershipRole(row.Role),
+		UseCount:  int(row.UseCount),
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+	if row.MaxUses.Valid {
+		maxUses := int(row.MaxUses.Int32)
+		link.MaxUses = &maxUses
+	}
+	if row.ExpiresAt.Valid {
+		link.ExpiresAt = &row.ExpiresAt.Time
+	}
+	if row.RevokedAt.Valid {
+		link.RevokedAt = &row.RevokedAt.Time
+	}
+	return link
+}
+
+func toInviteLinkDB(link models.InviteLink) db.BoardInviteLink {
+	row := db.BoardInviteLink{
+		ID:        pgtype.UUID{Bytes: link.ID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: link.BoardID, Valid: true},
+		UserID:    pgtype.UUID{Bytes: link.UserID, Valid: true},
+		Token:     link.Token,
+		Role:      string(link.Role),
+		UseCount:  int32(link.UseCount),
+		CreatedAt: pgtype.Timestamp{Time: link.CreatedAt, Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: link.UpdatedAt, Valid: true},
+	}
+	if link.MaxUses != nil {
+		row.MaxUses = pgtype.Int4{Int32: int32(*link.MaxUses), Valid: true}
+	}
+	if link.ExpiresAt != nil {
+		row.ExpiresAt = pgtype.Timestamp{Time: *link.ExpiresAt, Valid: true}
+	}
+	if link.RevokedAt != nil {
+		row.RevokedAt = pgtype.Timestamp{Time: *link.RevokedAt, Valid: true}
+	}
+	return row
+}
+
+func toActionItem(row db.ActionItem) models.ActionItem {
+	item := models.ActionItem{
+		ID:        row.ID.Bytes,
+		BoardID:   row.BoardID.Bytes,
+		UserID:    row.UserID.Bytes,
+		Title:     row.Title,
+		Status:    models.ActionItemStatus(row.Status),
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+	if row.PostID.Valid {
+		postID := uuid.UUID(row.PostID.Bytes)
+		item.PostID = &postID
+	}
+	if row.AssigneeID.Valid {
+		assigneeID := uuid.UUID(row.AssigneeID.Bytes)
+		item.AssigneeID = &assigneeID
+	}
+	if row.DueDate.Valid {
+		item.DueDate = &row.DueDate.Time
+	}
+	return item
+}
+
+func toActionItemDB(item models.ActionItem) db.ActionItem {
+	row := db.ActionItem{
+		ID:        pgtype.UUID{Bytes: item.ID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: item.BoardID, Valid: true},
+		UserID:    pgtype.UUID{Bytes: item.UserID, Valid: true},
+		Title:     item.Title,
+		Status:    string(item.Status),
+		CreatedAt: pgtype.Timestamp{Time: item.CreatedAt, Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: item.UpdatedAt, Valid: true},
+	}
+	if item.PostID != nil {
+		row.PostID = pgtype.UUID{Bytes: *item.PostID, Valid: true}
+	}
+	if item.AssigneeID != nil {
+		row.AssigneeID = pgtype.UUID{Bytes: *item.AssigneeID, Valid: true}
+	}
+	if item.DueDate != nil {
+		row.DueDate = pgtype.Date{Time: *item.DueDate, Valid: true}
+	}
+	return row
+}
+
+func toBoardTag(row db.BoardTag) models.BoardTag {
+	return models.BoardTag{
+		ID:        row.ID.Bytes,
+		BoardID:   row.BoardID.Bytes,
+		Name:      row.Name,
+		Color:     row.Color,
+		CreatedAt: row.CreatedAt.Time,
+	}
 }
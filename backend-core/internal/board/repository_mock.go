@@ -2,6 +2,7 @@ package board
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/Wave-95/boards/backend-core/internal/models"
@@ -13,6 +14,15 @@ type mockRepository struct {
 	boardMemberships map[uuid.UUID]models.BoardMembership
 	users            map[uuid.UUID]models.User
 	invites          map[uuid.UUID]models.Invite
+	inviteLinks      map[uuid.UUID]models.InviteLink
+	emailInvites     map[uuid.UUID]models.EmailInvite
+	accessRequests   map[uuid.UUID]models.AccessRequest
+	postGroups       map[uuid.UUID]models.PostGroup
+	posts            map[uuid.UUID]models.Post
+	templates        map[uuid.UUID]models.BoardTemplate
+	actionItems      map[uuid.UUID]models.ActionItem
+	tags             map[uuid.UUID]models.BoardTag
+	attachments      map[uuid.UUID]models.PostAttachment
 }
 
 // NewMockRepository returns a mock board repository that implements the Repository interface.
@@ -21,11 +31,29 @@ func NewMockRepository() *mockRepository {
 	boardMemberships := make(map[uuid.UUID]models.BoardMembership)
 	users := make(map[uuid.UUID]models.User)
 	invites := make(map[uuid.UUID]models.Invite)
+	inviteLinks := make(map[uuid.UUID]models.InviteLink)
+	emailInvites := make(map[uuid.UUID]models.EmailInvite)
+	accessRequests := make(map[uuid.UUID]models.AccessRequest)
+	postGroups := make(map[uuid.UUID]models.PostGroup)
+	posts := make(map[uuid.UUID]models.Post)
+	templates := make(map[uuid.UUID]models.BoardTemplate)
+	actionItems := make(map[uuid.UUID]models.ActionItem)
+	tags := make(map[uuid.UUID]models.BoardTag)
+	attachments := make(map[uuid.UUID]models.PostAttachment)
 	return &mockRepository{
 		boards,
 		boardMemberships,
 		users,
 		invites,
+		inviteLinks,
+		emailInvites,
+		accessRequests,
+		postGroups,
+		posts,
+		templates,
+		actionItems,
+		tags,
+		attachments,
 	}
 }
 
@@ -52,6 +80,20 @@ func (r *mockRepository) CreateBoard(ctx context.Context, board models.Board) er
 	return nil
 }
 
+// CreateBoardWithPostGroups creates a mock board together with its owner membership and post groups.
+func (r *mockRepository) CreateBoardWithPostGroups(ctx context.Context, board models.Board, membership models.BoardMembership, postGroups []models.PostGroup) error {
+	if err := r.CreateBoard(ctx, board); err != nil {
+		return err
+	}
+	if err := r.CreateMembership(ctx, membership); err != nil {
+		return err
+	}
+	for _, postGroup := range postGroups {
+		r.AddPostGroup(postGroup)
+	}
+	return nil
+}
+
 // GetBoard returns a single mock board.
 func (r *mockRepository) GetBoard(ctx context.Context, boardID uuid.UUID) (models.Board, error) {
 	if board, ok := r.boards[boardID]; ok {
@@ -80,6 +122,9 @@ func (r *mockRepository) GetBoardAndUsers(ctx context.Context, boardID uuid.UUID
 
 // GetInvite returns a single invite.
 func (r *mockRepository) GetInvite(ctx context.Context, inviteID uuid.UUID) (InviteSenderReceiver, error) {
+	if invite, ok := r.invites[inviteID]; ok {
+		return InviteSenderReceiver{invite, r.users[invite.SenderID], r.users[invite.ReceiverID]}, nil
+	}
 	return InviteSenderReceiver{}, errInviteDoesNotExist
 }
 
@@ -99,7 +144,7 @@ func (r *mockRepository) ListOwnedBoardAndUsers(ctx context.Context, userID uuid
 	ownedBoardIDs := []uuid.UUID{}
 	boardAndUsers := []BoardMembershipUser{}
 	for _, board := range r.boards {
-		if board.UserID == userID {
+		if board.UserID == userID && board.ArchivedAt == nil && board.DeletedAt == nil {
 			ownedBoardIDs = append(ownedBoardIDs, board.ID)
 		}
 	}
@@ -123,7 +168,8 @@ func (r *mockRepository) ListSharedBoardAndUsers(ctx context.Context, userID uui
 	sharedBoardIDs := []uuid.UUID{}
 	boardAndUsers := []BoardMembershipUser{}
 	for _, boardMembership := range r.boardMemberships {
-		if boardMembership.UserID == userID && boardMembership.Role == models.RoleMember {
+		board := r.boards[boardMembership.BoardID]
+		if boardMembership.UserID == userID && board.UserID != userID && board.ArchivedAt == nil && board.DeletedAt == nil {
 			sharedBoardIDs = append(sharedBoardIDs, boardMembership.BoardID)
 		}
 	}
@@ -142,18 +188,265 @@ func (r *mockRepository) ListSharedBoardAndUsers(ctx context.Context, userID uui
 	return boardAndUsers, nil
 }
 
+// ListArchivedBoardAndUsers returns a list of mock archived boards and associated mock members for a given mock user.
+func (r *mockRepository) ListArchivedBoardAndUsers(ctx context.Context, userID uuid.UUID) ([]BoardMembershipUser, error) {
+	archivedBoardIDs := []uuid.UUID{}
+	boardAndUsers := []BoardMembershipUser{}
+	for _, boardMembership := range r.boardMemberships {
+		board := r.boards[boardMembership.BoardID]
+		if boardMembership.UserID == userID && board.ArchivedAt != nil && board.DeletedAt == nil {
+			archivedBoardIDs = append(archivedBoardIDs, boardMembership.BoardID)
+		}
+	}
+	for _, boardMembership := range r.boardMemberships {
+		if boardInList(boardMembership.BoardID, archivedBoardIDs) {
+			boardAndUsers = append(boardAndUsers, BoardMembershipUser{
+				Board:      r.boards[boardMembership.BoardID],
+				Membership: boardMembership,
+				User:       r.users[boardMembership.UserID],
+			})
+		}
+	}
+	return boardAndUsers, nil
+}
+
+// ListTrashedBoards returns a list of mock boards in the trash that a mock user is an admin of.
+func (r *mockRepository) ListTrashedBoards(ctx context.Context, userID uuid.UUID) ([]models.Board, error) {
+	list := []models.Board{}
+	seen := make(map[uuid.UUID]bool)
+	for _, boardMembership := range r.boardMemberships {
+		board, ok := r.boards[boardMembership.BoardID]
+		if !ok || seen[board.ID] || board.DeletedAt == nil {
+			continue
+		}
+		if boardMembership.UserID == userID && boardMembership.Role == models.RoleAdmin {
+			seen[board.ID] = true
+			list = append(list, board)
+		}
+	}
+	return list, nil
+}
+
 // CreateMembership creates a mock membership.
 func (r *mockRepository) CreateMembership(ctx context.Context, membership models.BoardMembership) error {
 	r.boardMemberships[membership.ID] = membership
 	return nil
 }
 
+// UpdateBoard updates a mock board.
+func (r *mockRepository) UpdateBoard(ctx context.Context, board models.Board) error {
+	if _, ok := r.boards[board.ID]; ok {
+		r.boards[board.ID] = board
+		return nil
+	}
+	return errBoardDoesNotExist
+}
+
+// UpdateMembership updates the role of a mock membership.
+func (r *mockRepository) UpdateMembership(ctx context.Context, membership models.BoardMembership) error {
+	for ID, boardMembership := range r.boardMemberships {
+		if boardMembership.BoardID == membership.BoardID && boardMembership.UserID == membership.UserID {
+			boardMembership.Role = membership.Role
+			boardMembership.UpdatedAt = membership.UpdatedAt
+			r.boardMemberships[ID] = boardMembership
+		}
+	}
+	return nil
+}
+
+// DeleteMembership deletes a mock membership.
+func (r *mockRepository) DeleteMembership(ctx context.Context, boardID uuid.UUID, userID uuid.UUID) error {
+	for ID, boardMembership := range r.boardMemberships {
+		if boardMembership.BoardID == boardID && boardMembership.UserID == userID {
+			delete(r.boardMemberships, ID)
+		}
+	}
+	return nil
+}
+
+// TransferOwnership reassigns the owner of a mock board and updates the new owner's mock membership.
+func (r *mockRepository) TransferOwnership(ctx context.Context, board models.Board, membership models.BoardMembership) error {
+	if err := r.UpdateBoard(ctx, board); err != nil {
+		return err
+	}
+	return r.UpdateMembership(ctx, membership)
+}
+
+// CreateInviteLink creates a mock invite link.
+func (r *mockRepository) CreateInviteLink(ctx context.Context, link models.InviteLink) error {
+	r.inviteLinks[link.ID] = link
+	return nil
+}
+
+// GetInviteLink returns a single mock invite link.
+func (r *mockRepository) GetInviteLink(ctx context.Context, linkID uuid.UUID) (models.InviteLink, error) {
+	if link, ok := r.inviteLinks[linkID]; ok {
+		return link, nil
+	}
+	return models.InviteLink{}, errInviteLinkDoesNotExist
+}
+
+// GetInviteLinkByToken returns a single mock invite link for a given token.
+func (r *mockRepository) GetInviteLinkByToken(ctx context.Context, token string) (models.InviteLink, error) {
+	for _, link := range r.inviteLinks {
+		if link.Token == token {
+			return link, nil
+		}
+	}
+	return models.InviteLink{}, errInviteLinkDoesNotExist
+}
+
+// ListInviteLinksByBoard returns the mock invite links of a board that have not been revoked.
+func (r *mockRepository) ListInviteLinksByBoard(ctx context.Context, boardID uuid.UUID) ([]models.InviteLink, error) {
+	links := []models.InviteLink{}
+	for _, link := range r.inviteLinks {
+		if link.BoardID == boardID && link.RevokedAt == nil {
+			links = append(links, link)
+		}
+	}
+	return links, nil
+}
+
+// UpdateInviteLink updates a mock invite link.
+func (r *mockRepository) UpdateInviteLink(ctx context.Context, link models.InviteLink) error {
+	if _, ok := r.inviteLinks[link.ID]; ok {
+		r.inviteLinks[link.ID] = link
+		return nil
+	}
+	return errInviteLinkDoesNotExist
+}
+
+// RedeemInviteLink consumes a use of a mock invite link and creates a mock membership.
+func (r *mockRepository) RedeemInviteLink(ctx context.Context, link models.InviteLink, membership models.BoardMembership) error {
+	storedLink, ok := r.inviteLinks[link.ID]
+	if !ok || storedLink.RevokedAt != nil || (storedLink.MaxUses != nil && storedLink.UseCount >= *storedLink.MaxUses) {
+		return errInviteLinkUsedUp
+	}
+	storedLink.UseCount++
+	storedLink.UpdatedAt = membership.CreatedAt
+	r.inviteLinks[link.ID] = storedLink
+	return r.CreateMembership(ctx, membership)
+}
+
+// AddPostGroup is a mock specific function to seed a board with a post group.
+func (r *mockRepository) AddPostGroup(postGroup models.PostGroup) {
+	r.postGroups[postGroup.ID] = postGroup
+}
+
+// AddPost is a mock specific function to seed a post group with a post.
+func (r *mockRepository) AddPost(post models.Post) {
+	r.posts[post.ID] = post
+}
+
+// AddAttachment is a mock specific function to seed a post with an attachment.
+func (r *mockRepository) AddAttachment(attachment models.PostAttachment) {
+	r.attachments[attachment.ID] = attachment
+}
+
+// ListPurgeableAttachments returns the mock attachments of the boards that were moved to the trash before
+// deletedBefore.
+func (r *mockRepository) ListPurgeableAttachments(ctx context.Context, deletedBefore time.Time) ([]models.PostAttachment, error) {
+	attachments := []models.PostAttachment{}
+	for _, attachment := range r.attachments {
+		board, ok := r.boards[attachment.BoardID]
+		if ok && board.DeletedAt != nil && board.DeletedAt.Before(deletedBefore) {
+			attachments = append(attachments, attachment)
+		}
+	}
+	return attachments, nil
+}
+
+// ListPostGroups returns the mock post groups that belong to a board.
+func (r *mockRepository) ListPostGroups(ctx context.Context, boardID uuid.UUID) ([]models.PostGroup, error) {
+	postGroups := []models.PostGroup{}
+	for _, postGroup := range r.postGroups {
+		if postGroup.BoardID == boardID {
+			postGroups = append(postGroups, postGroup)
+		}
+	}
+	return postGroups, nil
+}
+
+// ListPosts returns the mock posts that belong to a board's post groups.
+func (r *mockRepository) ListPosts(ctx context.Context, boardID uuid.UUID) ([]models.Post, error) {
+	posts := []models.Post{}
+	for _, post := range r.posts {
+		if postGroup, ok := r.postGroups[post.PostGroupID]; ok && postGroup.BoardID == boardID {
+			posts = append(posts, post)
+		}
+	}
+	return posts, nil
+}
+
+// DuplicateBoard creates a mock board together with its owner membership, post groups, and posts.
+func (r *mockRepository) DuplicateBoard(ctx context.Context, board models.Board, membership models.BoardMembership, postGroups []models.PostGroup, posts []models.Post) error {
+	r.boards[board.ID] = board
+	if err := r.CreateMembership(ctx, membership); err != nil {
+		return err
+	}
+	for _, postGroup := range postGroups {
+		r.AddPostGroup(postGroup)
+	}
+	for _, post := range posts {
+		r.AddPost(post)
+	}
+	return nil
+}
+
+// CreateTemplate creates a mock board template.
+func (r *mockRepository) CreateTemplate(ctx context.Context, template models.BoardTemplate) error {
+	r.templates[template.ID] = template
+	return nil
+}
+
+// GetTemplate returns a single mock board template.
+func (r *mockRepository) GetTemplate(ctx context.Context, templateID uuid.UUID) (models.BoardTemplate, error) {
+	if template, ok := r.templates[templateID]; ok {
+		return template, nil
+	}
+	return models.BoardTemplate{}, errTemplateDoesNotExist
+}
+
+// ListTemplatesByUser returns the mock board templates saved by a user.
+func (r *mockRepository) ListTemplatesByUser(ctx context.Context, userID uuid.UUID) ([]models.BoardTemplate, error) {
+	templates := []models.BoardTemplate{}
+	for _, template := range r.templates {
+		if template.UserID != nil && *template.UserID == userID {
+			templates = append(templates, template)
+		}
+	}
+	return templates, nil
+}
+
+// DeleteTemplate deletes a mock board template.
+func (r *mockRepository) DeleteTemplate(ctx context.Context, templateID uuid.UUID) error {
+	delete(r.templates, templateID)
+	return nil
+}
+
 // DeleteBoard deletes a mock board.
 func (r *mockRepository) DeleteBoard(ctx context.Context, boardID uuid.UUID) error {
 	delete(r.boards, boardID)
 	return nil
 }
 
+// PurgeBoards deletes the mock boards that were moved to the trash before deletedBefore.
+func (r *mockRepository) PurgeBoards(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var count int64
+	for id, board := range r.boards {
+		if board.DeletedAt != nil && board.DeletedAt.Before(deletedBefore) {
+			delete(r.boards, id)
+			count++
+			for attachmentID, attachment := range r.attachments {
+				if attachment.BoardID == id {
+					delete(r.attachments, attachmentID)
+				}
+			}
+		}
+	}
+	return count, nil
+}
+
 // CreateInvites create mock invites.
 func (r *mockRepository) CreateInvites(ctx context.Context, invites []models.Invite) error {
 	for _, invite := range invites {
@@ -171,6 +464,36 @@ func (r *mockRepository) UpdateInvite(ctx context.Context, invite models.Invite)
 	return errInviteDoesNotExist
 }
 
+// MarkInvitesReminded marks the mock pending invites that expire before remindBefore as reminded.
+func (r *mockRepository) MarkInvitesReminded(ctx context.Context, now time.Time, remindBefore time.Time) ([]uuid.UUID, error) {
+	inviteIDs := []uuid.UUID{}
+	for id, invite := range r.invites {
+		if invite.Status != models.InviteStatusPending || invite.RemindedAt != nil || invite.ExpiresAt == nil {
+			continue
+		}
+		if invite.ExpiresAt.After(now) && !invite.ExpiresAt.After(remindBefore) {
+			invite.RemindedAt = &now
+			r.invites[id] = invite
+			inviteIDs = append(inviteIDs, id)
+		}
+	}
+	return inviteIDs, nil
+}
+
+// ExpireInvites flips the mock pending invites that are past their expiry to EXPIRED.
+func (r *mockRepository) ExpireInvites(ctx context.Context, now time.Time) (int64, error) {
+	var count int64
+	for id, invite := range r.invites {
+		if invite.Status == models.InviteStatusPending && invite.ExpiresAt != nil && !invite.ExpiresAt.After(now) {
+			invite.Status = models.InviteStatusExpired
+			invite.UpdatedAt = now
+			r.invites[id] = invite
+			count++
+		}
+	}
+	return count, nil
+}
+
 // ListInvitesByBoard returns a list of mock board invites for a given board ID and status.
 func (r *mockRepository) ListInvitesByBoard(ctx context.Context, boardID uuid.UUID, status string) ([]InviteReceiver, error) {
 	inviteReceivers := []InviteReceiver{}
@@ -210,6 +533,78 @@ func (r *mockRepository) ListInvitesByReceiver(ctx context.Context, receiverID u
 	return inviteBoardSender, nil
 }
 
+// CreateEmailInvites creates mock email invites.
+func (r *mockRepository) CreateEmailInvites(ctx context.Context, invites []models.EmailInvite) error {
+	for _, invite := range invites {
+		r.emailInvites[invite.ID] = invite
+	}
+	return nil
+}
+
+// GetUser returns a single mock user.
+func (r *mockRepository) GetUser(ctx context.Context, userID uuid.UUID) (models.User, error) {
+	if user, ok := r.users[userID]; ok {
+		return user, nil
+	}
+	return models.User{}, errUserDoesNotExist
+}
+
+// GetUserByEmail returns a mock user with the given email.
+func (r *mockRepository) GetUserByEmail(ctx context.Context, email string) (models.User, error) {
+	for _, user := range r.users {
+		if user.Email != nil && *user.Email == email {
+			return user, nil
+		}
+	}
+	return models.User{}, errUserDoesNotExist
+}
+
+// ListEmailInvitesByBoard returns a list of mock email invites for a given board ID and status.
+func (r *mockRepository) ListEmailInvitesByBoard(ctx context.Context, boardID uuid.UUID, status string) ([]models.EmailInvite, error) {
+	invites := []models.EmailInvite{}
+	for _, invite := range r.emailInvites {
+		if invite.BoardID == boardID && string(invite.Status) == status {
+			invites = append(invites, invite)
+		}
+	}
+	return invites, nil
+}
+
+// CreateAccessRequest creates a mock access request.
+func (r *mockRepository) CreateAccessRequest(ctx context.Context, request models.AccessRequest) error {
+	r.accessRequests[request.ID] = request
+	return nil
+}
+
+// GetAccessRequest returns a single mock access request.
+func (r *mockRepository) GetAccessRequest(ctx context.Context, requestID uuid.UUID) (models.AccessRequest, error) {
+	if request, ok := r.accessRequests[requestID]; ok {
+		return request, nil
+	}
+	return models.AccessRequest{}, errAccessRequestDoesNotExist
+}
+
+// ListAccessRequestsByBoard returns a list of mock access requests for a given board ID and status.
+func (r *mockRepository) ListAccessRequestsByBoard(ctx context.Context, boardID uuid.UUID, status string) ([]AccessRequestUser, error) {
+	requestUsers := []AccessRequestUser{}
+	for _, request := range r.accessRequests {
+		if request.BoardID != boardID || (status != "" && string(request.Status) != status) {
+			continue
+		}
+		requestUsers = append(requestUsers, AccessRequestUser{request, r.users[request.UserID]})
+	}
+	return requestUsers, nil
+}
+
+// UpdateAccessRequest updates a mock access request.
+func (r *mockRepository) UpdateAccessRequest(ctx context.Context, request models.AccessRequest) error {
+	if _, ok := r.accessRequests[request.ID]; ok {
+		r.accessRequests[request.ID] = request
+		return nil
+	}
+	return errAccessRequestDoesNotExist
+}
+
 func boardInList(boardID uuid.UUID, list []uuid.UUID) bool {
 	for _, ID := range list {
 		if ID == boardID {
@@ -218,3 +613,105 @@ func boardInList(boardID uuid.UUID, list []uuid.UUID) bool {
 	}
 	return false
 }
+
+// CreateActionItem creates a mock action item.
+func (r *mockRepository) CreateActionItem(ctx context.Context, item models.ActionItem) error {
+	r.actionItems[item.ID] = item
+	return nil
+}
+
+// GetActionItem returns a mock action item.
+func (r *mockRepository) GetActionItem(ctx context.Context, itemID uuid.UUID) (models.ActionItem, error) {
+	if item, ok := r.actionItems[itemID]; ok {
+		return item, nil
+	}
+	return models.ActionItem{}, errActionItemDoesNotExist
+}
+
+// ListActionItemsByBoard returns the mock action items of a board, oldest first.
+func (r *mockRepository) ListActionItemsByBoard(ctx context.Context, boardID uuid.UUID) ([]models.ActionItem, error) {
+	items := []models.ActionItem{}
+	for _, item := range r.actionItems {
+		if item.BoardID == boardID {
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].CreatedAt.Before(items[j].CreatedAt) })
+	return items, nil
+}
+
+// ListActionItemsByAssignee returns the mock action items assigned to a user on boards that the user is a
+// member of.
+func (r *mockRepository) ListActionItemsByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]ActionItemBoard, error) {
+	items := []ActionItemBoard{}
+	for _, item := range r.actionItems {
+		if item.AssigneeID == nil || *item.AssigneeID != assigneeID {
+			continue
+		}
+		board, ok := r.boards[item.BoardID]
+		if !ok || board.DeletedAt != nil || !r.isMember(item.BoardID, assigneeID) {
+			continue
+		}
+		items = append(items, ActionItemBoard{ActionItem: item, BoardName: board.Name})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ActionItem.CreatedAt.Before(items[j].ActionItem.CreatedAt) })
+	return items, nil
+}
+
+// UpdateActionItem updates a mock action item.
+func (r *mockRepository) UpdateActionItem(ctx context.Context, item models.ActionItem) error {
+	r.actionItems[item.ID] = item
+	return nil
+}
+
+// DeleteActionItem deletes a mock action item.
+func (r *mockRepository) DeleteActionItem(ctx context.Context, itemID uuid.UUID) error {
+	delete(r.actionItems, itemID)
+	return nil
+}
+
+// CreateTag adds a tag to the mock tag catalog of a board.
+func (r *mockRepository) CreateTag(ctx context.Context, tag models.BoardTag) error {
+	for _, existing := range r.tags {
+		if existing.BoardID == tag.BoardID && existing.Name == tag.Name {
+			return errTagNameExists
+		}
+	}
+	r.tags[tag.ID] = tag
+	return nil
+}
+
+// GetTag returns a mock tag.
+func (r *mockRepository) GetTag(ctx context.Context, tagID uuid.UUID) (models.BoardTag, error) {
+	if tag, ok := r.tags[tagID]; ok {
+		return tag, nil
+	}
+	return models.BoardTag{}, errTagDoesNotExist
+}
+
+// ListTagsByBoard returns the mock tag catalog of a board, oldest first.
+func (r *mockRepository) ListTagsByBoard(ctx context.Context, boardID uuid.UUID) ([]models.BoardTag, error) {
+	tags := []models.BoardTag{}
+	for _, tag := range r.tags {
+		if tag.BoardID == boardID {
+			tags = append(tags, tag)
+		}
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].CreatedAt.Before(tags[j].CreatedAt) })
+	return tags, nil
+}
+
+// DeleteTag deletes a mock tag.
+func (r *mockRepository) DeleteTag(ctx context.Context, tagID uuid.UUID) error {
+	delete(r.tags, tagID)
+	return nil
+}
+
+func (r *mockRepository) isMember(boardID uuid.UUID, userID uuid.UUID) bool {
+	for _, membership := range r.boardMemberships {
+		if membership.BoardID == boardID && membership.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
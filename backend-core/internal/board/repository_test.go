@@ -79,6 +79,142 @@ func TestRepository(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Redeem an invite link until it is used up", func(t *testing.T) {
+		owner := setupUser(t, userRepo)
+		defer cleanUpTestUser(t, userRepo, owner.ID)
+		board := test.NewBoard(owner.ID)
+		err := boardRepo.CreateBoard(context.Background(), board)
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board", err)
+		}
+		maxUses := 1
+		now := time.Now()
+		link := models.InviteLink{
+			ID:        uuid.New(),
+			BoardID:   board.ID,
+			UserID:    owner.ID,
+			Token:     uuid.New().String(),
+			Role:      models.RoleViewer,
+			MaxUses:   &maxUses,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		err = boardRepo.CreateInviteLink(context.Background(), link)
+		assert.NoError(t, err)
+
+		membership := models.BoardMembership{
+			ID:        uuid.New(),
+			BoardID:   board.ID,
+			UserID:    user.ID,
+			Role:      link.Role,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		err = boardRepo.RedeemInviteLink(context.Background(), link, membership)
+		assert.NoError(t, err)
+		redeemedLink, err := boardRepo.GetInviteLinkByToken(context.Background(), link.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, redeemedLink.UseCount)
+
+		membership.ID = uuid.New()
+		err = boardRepo.RedeemInviteLink(context.Background(), link, membership)
+		assert.ErrorIs(t, err, errInviteLinkUsedUp)
+
+		err = boardRepo.DeleteBoard(context.Background(), board.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Purge boards in the trash", func(t *testing.T) {
+		board := test.NewBoard(user.ID)
+		err := boardRepo.CreateBoard(context.Background(), board)
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board", err)
+		}
+		deletedAt := time.Now().Add(-time.Hour)
+		board.DeletedAt = &deletedAt
+		err = boardRepo.UpdateBoard(context.Background(), board)
+		assert.NoError(t, err)
+
+		count, err := boardRepo.PurgeBoards(context.Background(), time.Now())
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, count, int64(1))
+		_, err = boardRepo.GetBoard(context.Background(), board.ID)
+		assert.ErrorIs(t, err, errBoardDoesNotExist)
+	})
+
+	t.Run("Duplicate board", func(t *testing.T) {
+		board := test.NewBoard(user.ID)
+		board.AnonymousPosts = true
+		board.HidePosts = true
+		membership := models.BoardMembership{
+			ID:        uuid.New(),
+			BoardID:   board.ID,
+			UserID:    user.ID,
+			Role:      models.RoleAdmin,
+			CreatedAt: board.CreatedAt,
+			UpdatedAt: board.UpdatedAt,
+		}
+		postGroup := models.PostGroup{ID: uuid.New(), BoardID: board.ID, Title: "Went well", PosX: 10, PosY: 20, ZIndex: 1}
+		emptyPostGroup := models.PostGroup{ID: uuid.New(), BoardID: board.ID, Title: "To improve", PosX: 300, PosY: 20, ZIndex: 2}
+		post := models.Post{ID: uuid.New(), UserID: user.ID, Content: "Shipped on time", PostOrder: 1, PostGroupID: postGroup.ID}
+		err := boardRepo.DuplicateBoard(context.Background(), board, membership, []models.PostGroup{postGroup, emptyPostGroup}, []models.Post{post})
+		if err != nil {
+			assert.FailNow(t, "Failed to duplicate test board", err)
+		}
+
+		rows, err := boardRepo.GetBoardAndUsers(context.Background(), board.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(rows))
+		copied, err := boardRepo.GetBoard(context.Background(), board.ID)
+		assert.NoError(t, err)
+		assert.True(t, copied.AnonymousPosts)
+		assert.True(t, copied.HidePosts)
+		postGroups, err := boardRepo.ListPostGroups(context.Background(), board.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(postGroups))
+		posts, err := boardRepo.ListPosts(context.Background(), board.ID)
+		assert.NoError(t, err)
+		if assert.Equal(t, 1, len(posts)) {
+			assert.Equal(t, post.ID, posts[0].ID)
+		}
+
+		// Clean up the copied board along with its post groups and posts
+		deletedAt := time.Now().Add(-time.Hour)
+		board.DeletedAt = &deletedAt
+		err = boardRepo.UpdateBoard(context.Background(), board)
+		assert.NoError(t, err)
+		_, err = boardRepo.PurgeBoards(context.Background(), time.Now())
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create, get, list, and delete template", func(t *testing.T) {
+		now := time.Now()
+		template := models.BoardTemplate{
+			ID:          uuid.New(),
+			UserID:      &user.ID,
+			Name:        "Team retro",
+			Description: "test template description",
+			PostGroups:  []models.TemplatePostGroup{{Title: "Kudos", PosX: 50, PosY: 50, ZIndex: 1}},
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		err := boardRepo.CreateTemplate(context.Background(), template)
+		if err != nil {
+			assert.FailNow(t, "Failed to create test template", err)
+		}
+		savedTemplate, err := boardRepo.GetTemplate(context.Background(), template.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, template.PostGroups, savedTemplate.PostGroups)
+		templates, err := boardRepo.ListTemplatesByUser(context.Background(), user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(templates))
+
+		err = boardRepo.DeleteTemplate(context.Background(), template.ID)
+		assert.NoError(t, err)
+		_, err = boardRepo.GetTemplate(context.Background(), template.ID)
+		assert.ErrorIs(t, err, errTemplateDoesNotExist)
+	})
+
 	t.Run("List boards by user", func(t *testing.T) {
 		t.Run("owned boards", func(t *testing.T) {
 			board := test.NewBoard(user.ID)
@@ -2,13 +2,18 @@ package board
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Wave-95/boards/backend-core/internal/models"
+	"github.com/Wave-95/boards/backend-core/internal/storage"
 	"github.com/Wave-95/boards/backend-core/pkg/logger"
 	"github.com/Wave-95/boards/backend-core/pkg/validator"
+	"github.com/Wave-95/boards/backend-notification/constants/payloads"
 	"github.com/Wave-95/boards/backend-notification/constants/queues"
 	"github.com/Wave-95/boards/backend-notification/constants/tasks"
 	"github.com/Wave-95/boards/wrappers/amqp"
@@ -23,14 +28,42 @@ var (
 	errUnauthorized            = errors.New("User is not authorized")
 	errUnsupportedInviteUpdate = errors.New("Invite update status is not supported")
 	errInviteCancelled         = errors.New("Invite has been cancelled")
+	errInviteExpired           = errors.New("Invite has expired")
 	errInvalidStatusFilter     = errors.New("Invalid status filter")
+	errMemberNotFound          = errors.New("Member not found")
+	errLastAdmin               = errors.New("Board must have at least one admin")
+	errOwnerMembership         = errors.New("Board owner cannot be removed or demoted")
+	errAlreadyOwner            = errors.New("User already owns the board")
+	errInviteLinkNotFound      = errors.New("Invite link not found")
+	errInviteLinkInactive      = errors.New("Invite link has expired, been revoked, or reached its usage limit")
+	errAccessRequestNotFound   = errors.New("Access request not found")
+	errAccessRequestResolved   = errors.New("Access request has already been resolved")
+	errAlreadyMember           = errors.New("User is already a member of the board")
+	errDomainNotAllowed        = errors.New("User does not have a verified email in an allowed domain")
+	errBoardNotInTrash         = errors.New("Board is not in the trash")
+	errTrashRetentionExpired   = errors.New("Board has been in the trash for longer than the retention period")
+	errTemplateNotFound        = errors.New("Template not found")
+	errInvalidPhaseTransition  = errors.New("Board can only move to the phase right before or after its current phase")
+	errActionItemNotFound      = errors.New("Action item not found")
+	errAssigneeNotMember       = errors.New("Action items can only be assigned to board members")
+	errPostNotOnBoard          = errors.New("Post does not belong to the board")
+	errInvalidDueDate          = errors.New("Due date must be a date in YYYY-MM-DD format")
+	errTagNotFound             = errors.New("Tag not found")
+	errDuplicateTag            = errors.New("Board already has a tag with this name")
 	defaultBoardDescription    = "My default board description"
+	// inviteReminderWindow is how long before its expiry a pending invite gets a reminder email.
+	inviteReminderWindow = 48 * time.Hour
 )
 
 // Service is an interface that represents all the capabilities of a board service.
 type Service interface {
 	CreateBoard(ctx context.Context, input CreateBoardInput) (models.Board, error)
-	CreateInvites(ctx context.Context, input CreateInvitesInput) ([]models.Invite, error)
+	CreateInvites(ctx context.Context, input CreateInvitesInput) (CreateInvitesDTO, error)
+	CreateInviteLink(ctx context.Context, input CreateInviteLinkInput) (models.InviteLink, error)
+	CreateAccessRequest(ctx context.Context, input CreateAccessRequestInput) (models.AccessRequest, error)
+	CreateTemplate(ctx context.Context, input CreateTemplateInput) (models.BoardTemplate, error)
+	CreateActionItem(ctx context.Context, input CreateActionItemInput) (models.ActionItem, error)
+	CreateTag(ctx context.Context, input CreateTagInput) (models.BoardTag, error)
 
 	GetBoard(ctx context.Context, boardID string) (models.Board, error)
 	GetBoardWithMembers(ctx context.Context, boardID string) (BoardWithMembersDTO, error)
@@ -38,29 +71,65 @@ type Service interface {
 
 	ListOwnedBoardsWithMembers(ctx context.Context, userID string) ([]BoardWithMembersDTO, error)
 	ListSharedBoardsWithMembers(ctx context.Context, userID string) ([]BoardWithMembersDTO, error)
+	ListArchivedBoardsWithMembers(ctx context.Context, userID string) ([]BoardWithMembersDTO, error)
+	ListTrashedBoards(ctx context.Context, userID string) ([]models.Board, error)
 	ListInvitesByBoard(ctx context.Context, input ListInvitesByBoardInput) ([]InviteWithReceiverDTO, error)
 	ListInvitesByReceiver(ctx context.Context, input ListInvitesByReceiverInput) ([]InviteWithBoardAndSenderDTO, error)
+	ListInviteLinks(ctx context.Context, input ListInviteLinksInput) ([]models.InviteLink, error)
+	ListAccessRequests(ctx context.Context, input ListAccessRequestsInput) ([]AccessRequestWithUserDTO, error)
+	ListTemplates(ctx context.Context, userID string) ([]models.BoardTemplate, error)
+	ListActionItems(ctx context.Context, input ListActionItemsInput) ([]models.ActionItem, error)
+	ListAssignedActionItems(ctx context.Context, userID string) ([]ActionItemWithBoardDTO, error)
+	ListTags(ctx context.Context, input ListTagsInput) ([]models.BoardTag, error)
 
+	UpdateBoard(ctx context.Context, input UpdateBoardInput) (models.Board, error)
+	UpdateMembership(ctx context.Context, input UpdateMembershipInput) (MemberDTO, error)
 	UpdateInvite(ctx context.Context, input UpdateInviteInput) error
+	UpdateAccessRequest(ctx context.Context, input UpdateAccessRequestInput) (models.AccessRequest, error)
+	TransferOwnership(ctx context.Context, input TransferOwnershipInput) (models.Board, MemberDTO, error)
+	UpdatePhase(ctx context.Context, input UpdatePhaseInput) (models.Board, error)
+	RevealPosts(ctx context.Context, input RevealPostsInput) (models.Board, error)
+	RemindInvites(ctx context.Context) (int, error)
+	ExpireInvites(ctx context.Context) (int64, error)
+	RedeemInviteLink(ctx context.Context, input RedeemInviteLinkInput) (BoardWithMembersDTO, error)
+	JoinBoard(ctx context.Context, input JoinBoardInput) (BoardWithMembersDTO, error)
+	CanJoinBoard(ctx context.Context, board BoardWithMembersDTO, userID string) (bool, error)
+	RestoreBoard(ctx context.Context, input RestoreBoardInput) (BoardWithMembersDTO, error)
+	DuplicateBoard(ctx context.Context, input DuplicateBoardInput) (models.Board, error)
+	UpdateActionItem(ctx context.Context, input UpdateActionItemInput) (models.ActionItem, error)
+
+	DeleteBoard(ctx context.Context, input DeleteBoardInput) error
+	PurgeBoards(ctx context.Context, retention time.Duration) (int64, error)
+	DeleteMembership(ctx context.Context, input DeleteMembershipInput) error
+	RevokeInviteLink(ctx context.Context, input RevokeInviteLinkInput) error
+	DeleteTemplate(ctx context.Context, input DeleteTemplateInput) error
+	DeleteActionItem(ctx context.Context, input DeleteActionItemInput) (models.ActionItem, error)
+	DeleteTag(ctx context.Context, input DeleteTagInput) (models.BoardTag, error)
 }
 
 type service struct {
-	repo      Repository
-	amqp      amqp.Amqp
-	validator validator.Validate
+	repo           Repository
+	storage        storage.Storage
+	amqp           amqp.Amqp
+	validator      validator.Validate
+	trashRetention time.Duration
 }
 
-// NewService returns a service struct that implements the board service interface.
-func NewService(repo Repository, amqp amqp.Amqp, validator validator.Validate) *service {
+// NewService returns a service struct that implements the board service interface. Boards that have been in
+// the trash for longer than the trash retention can no longer be restored.
+func NewService(repo Repository, storage storage.Storage, amqp amqp.Amqp, validator validator.Validate, trashRetention time.Duration) *service {
 	return &service{
-		repo:      repo,
-		amqp:      amqp,
-		validator: validator,
+		repo:           repo,
+		storage:        storage,
+		amqp:           amqp,
+		validator:      validator,
+		trashRetention: trashRetention,
 	}
 }
 
 // CreateBoard creates a new board and inserts the owner as the first member to that board. It will
-// set the provided name and description or use defaults if none are provided.
+// set the provided name and description or use defaults if none are provided. If a template is provided, the
+// post groups of the template are created on the new board.
 func (s *service) CreateBoard(ctx context.Context, input CreateBoardInput) (models.Board, error) {
 	// Validate input
 	if err := s.validator.Struct(input); err != nil {
@@ -71,6 +140,16 @@ func (s *service) CreateBoard(ctx context.Context, input CreateBoardInput) (mode
 		return models.Board{}, errInvalidID
 	}
 
+	// Look up the template before anything is created
+	var template *models.BoardTemplate
+	if input.TemplateID != nil {
+		found, err := s.getTemplate(ctx, *input.TemplateID, userID)
+		if err != nil {
+			return models.Board{}, err
+		}
+		template = &found
+	}
+
 	// Create board name if none provided
 	if input.Name == nil {
 		boards, err := s.repo.ListOwnedBoards(ctx, userID)
@@ -82,7 +161,10 @@ func (s *service) CreateBoard(ctx context.Context, input CreateBoardInput) (mode
 		input.Name = &boardName
 	}
 
-	// Use default board description if none provided
+	// Use the template description or the default board description if none provided
+	if input.Description == nil && template != nil && template.Description != "" {
+		input.Description = &template.Description
+	}
 	if input.Description == nil {
 		input.Description = &defaultBoardDescription
 	}
@@ -91,17 +173,16 @@ func (s *service) CreateBoard(ctx context.Context, input CreateBoardInput) (mode
 	boardID := uuid.New()
 	now := time.Now()
 	board := models.Board{
-		ID:          boardID,
-		Name:        input.Name,
-		Description: input.Description,
-		UserID:      userID,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-	}
-	if err := s.repo.CreateBoard(ctx, board); err != nil {
-		return models.Board{}, fmt.Errorf("service: failed to create board: %w", err)
+		ID:             boardID,
+		Name:           input.Name,
+		Description:    input.Description,
+		UserID:         userID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		AllowedDomains: []string{},
+		Phase:          models.PhaseBrainstorm,
+		VoteLimit:      models.DefaultVoteLimit,
 	}
-
 	// Create membership for owner
 	membershipID := uuid.New()
 	membership := models.BoardMembership{
@@ -111,31 +192,61 @@ func (s *service) CreateBoard(ctx context.Context, input CreateBoardInput) (mode
 		Role:      models.RoleAdmin,
 		CreatedAt: now,
 		UpdatedAt: now}
-	if err := s.repo.CreateMembership(ctx, membership); err != nil {
-		return models.Board{}, fmt.Errorf("service: failed to create board membership during create board: %w", err)
+
+	// Lay out the post groups of the template
+	postGroups := []models.PostGroup{}
+	if template != nil {
+		for _, templatePostGroup := range template.PostGroups {
+			postGroups = append(postGroups, models.PostGroup{
+				ID:        uuid.New(),
+				BoardID:   boardID,
+				Title:     templatePostGroup.Title,
+				PosX:      templatePostGroup.PosX,
+				PosY:      templatePostGroup.PosY,
+				ZIndex:    templatePostGroup.ZIndex,
+				CreatedAt: now,
+				UpdatedAt: now,
+			})
+		}
+	}
+
+	// Create the board, its owner membership, and its post groups together so that no partial board is left behind
+	if err := s.repo.CreateBoardWithPostGroups(ctx, board, membership, postGroups); err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to create board: %w", err)
 	}
 	return board, nil
 }
 
-// CreateInvites creates board invites
-func (s *service) CreateInvites(ctx context.Context, input CreateInvitesInput) ([]models.Invite, error) {
+// CreateInvites creates board invites for a list of recipients. Recipients can be addressed by user ID or by
+// email. Emails that belong to a user are invited like any other user, while the remaining emails are stored
+// as email invites and sent a signup link. Email invites are converted into board invites once a user signs
+// up with that email.
+func (s *service) CreateInvites(ctx context.Context, input CreateInvitesInput) (CreateInvitesDTO, error) {
+	// Validate input
+	if err := s.validator.Struct(input); err != nil {
+		return CreateInvitesDTO{}, err
+	}
 	boardID := input.BoardID
 	senderID := input.SenderID
-	invites := input.Invites
 	// Parse IDs into UUIDs
 	boardUUID, err := uuid.Parse(boardID)
 	if err != nil {
-		return nil, errInvalidID
+		return CreateInvitesDTO{}, errInvalidID
 	}
 	senderUUID, err := uuid.Parse(senderID)
 	if err != nil {
-		return nil, errInvalidID
+		return CreateInvitesDTO{}, errInvalidID
 	}
 	receiverUUIDs := []uuid.UUID{}
-	for _, invite := range invites {
+	emails := []string{}
+	for _, invite := range input.Invites {
+		if invite.ReceiverID == "" {
+			emails = append(emails, invite.Email)
+			continue
+		}
 		receiverUUID, err := uuid.Parse(invite.ReceiverID)
 		if err != nil {
-			return nil, errInvalidID
+			return CreateInvitesDTO{}, errInvalidID
 		}
 		receiverUUIDs = append(receiverUUIDs, receiverUUID)
 	}
@@ -143,26 +254,45 @@ func (s *service) CreateInvites(ctx context.Context, input CreateInvitesInput) (
 	// Check if user is authorized
 	boardWithMembers, err := s.GetBoardWithMembers(ctx, boardID)
 	if err != nil {
-		return nil, fmt.Errorf("service: failed to get board when creating board invites: %w", err)
+		return CreateInvitesDTO{}, fmt.Errorf("service: failed to get board when creating board invites: %w", err)
 	}
 	if !UserIsAdmin(boardWithMembers, senderID) {
-		return nil, errUnauthorized
+		return CreateInvitesDTO{}, errUnauthorized
+	}
+
+	// Invite emails that already belong to a user by their user ID
+	unregisteredEmails := []string{}
+	for _, email := range emails {
+		user, err := s.repo.GetUserByEmail(ctx, email)
+		if err != nil {
+			if errors.Is(err, errUserDoesNotExist) {
+				unregisteredEmails = append(unregisteredEmails, email)
+				continue
+			}
+			return CreateInvitesDTO{}, fmt.Errorf("service: failed to get user by email: %w", err)
+		}
+		receiverUUIDs = append(receiverUUIDs, user.ID)
 	}
 
 	// List existing pending invites
 	listInvitesByBoardInput := ListInvitesByBoardInput{BoardID: boardID, UserID: senderID, Status: string(models.InviteStatusPending)}
 	pendingInvites, err := s.ListInvitesByBoard(ctx, listInvitesByBoardInput)
 	if err != nil {
-		return nil, fmt.Errorf("service: failed to get pending invites: %w", err)
+		return CreateInvitesDTO{}, fmt.Errorf("service: failed to get pending invites: %w", err)
 	}
 
 	invitesToInsert := []models.Invite{}
 	now := time.Now()
+	expiresAt := now.Add(models.DefaultInviteExpiry)
+	if input.ExpiresAt != nil {
+		expiresAt = *input.ExpiresAt
+	}
 	// Prepare invites to insert
 	for _, receiverUUID := range receiverUUIDs {
-		// If invite already exists, update the updated_at timestamp
+		// If invite already exists, update the updated_at timestamp and extend the expiry
 		if existingInvite, ok := hasPendingInvite(receiverUUID, pendingInvites); ok {
 			existingInvite.UpdatedAt = now
+			existingInvite.ExpiresAt = &expiresAt
 			invitesToInsert = append(invitesToInsert, existingInvite)
 			continue
 		}
@@ -175,23 +305,185 @@ func (s *service) CreateInvites(ctx context.Context, input CreateInvitesInput) (
 			Status:     models.InviteStatusPending,
 			CreatedAt:  now,
 			UpdatedAt:  now,
+			ExpiresAt:  &expiresAt,
 		}
 		invitesToInsert = append(invitesToInsert, invite)
 	}
 
 	err = s.repo.CreateInvites(ctx, invitesToInsert)
 	if err != nil {
-		return nil, fmt.Errorf("service: failed to create board invites: %w", err)
+		return CreateInvitesDTO{}, fmt.Errorf("service: failed to create board invites: %w", err)
 	}
 
 	for _, invite := range invitesToInsert {
 		s.amqp.Publish(queues.Notification, tasks.EmailInvite, invite)
 	}
 
-	return invitesToInsert, nil
+	// Prepare email invites to insert
+	emailInvitesToInsert := []models.EmailInvite{}
+	if len(unregisteredEmails) > 0 {
+		pendingEmailInvites, err := s.repo.ListEmailInvitesByBoard(ctx, boardUUID, string(models.InviteStatusPending))
+		if err != nil {
+			return CreateInvitesDTO{}, fmt.Errorf("service: failed to get pending email invites: %w", err)
+		}
+		for _, email := range unregisteredEmails {
+			// If email invite already exists, update the updated_at timestamp
+			if existingInvite, ok := hasPendingEmailInvite(email, pendingEmailInvites); ok {
+				existingInvite.SenderID = senderUUID
+				existingInvite.UpdatedAt = now
+				emailInvitesToInsert = append(emailInvitesToInsert, existingInvite)
+				continue
+			}
+			emailInvite := models.EmailInvite{
+				ID:        uuid.New(),
+				BoardID:   boardUUID,
+				SenderID:  senderUUID,
+				Email:     email,
+				Status:    models.InviteStatusPending,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}
+			emailInvitesToInsert = append(emailInvitesToInsert, emailInvite)
+		}
+
+		err = s.repo.CreateEmailInvites(ctx, emailInvitesToInsert)
+		if err != nil {
+			return CreateInvitesDTO{}, fmt.Errorf("service: failed to create email invites: %w", err)
+		}
+
+		sender, _ := findMember(boardWithMembers.Members, senderUUID)
+		boardName := ""
+		if boardWithMembers.Name != nil {
+			boardName = *boardWithMembers.Name
+		}
+		for _, emailInvite := range emailInvitesToInsert {
+			s.amqp.Publish(queues.Notification, tasks.EmailSignupInvite, payloads.SignupInvite{
+				Email:      emailInvite.Email,
+				SenderName: sender.Name,
+				BoardName:  boardName,
+			})
+		}
+	}
+
+	return CreateInvitesDTO{Invites: invitesToInsert, EmailInvites: emailInvitesToInsert}, nil
+}
+
+// CreateInviteLink creates a shareable invite link for a board. Only board admins can create invite links. The
+// link grants the MEMBER role unless another role is requested.
+func (s *service) CreateInviteLink(ctx context.Context, input CreateInviteLinkInput) (models.InviteLink, error) {
+	// Validate input
+	if err := s.validator.Struct(input); err != nil {
+		return models.InviteLink{}, err
+	}
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return models.InviteLink{}, errInvalidID
+	}
+
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.InviteLink{}, fmt.Errorf("service: failed to get board when creating invite link: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return models.InviteLink{}, errUnauthorized
+	}
+
+	// Prepare invite link
+	token, err := generateInviteLinkToken()
+	if err != nil {
+		return models.InviteLink{}, fmt.Errorf("service: failed to generate invite link token: %w", err)
+	}
+	role := models.RoleMember
+	if input.Role != "" {
+		role = models.BoardMembershipRole(input.Role)
+	}
+	now := time.Now()
+	link := models.InviteLink{
+		ID:        uuid.New(),
+		BoardID:   boardWithMembers.ID,
+		UserID:    userUUID,
+		Token:     token,
+		Role:      role,
+		MaxUses:   input.MaxUses,
+		ExpiresAt: input.ExpiresAt,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.CreateInviteLink(ctx, link); err != nil {
+		return models.InviteLink{}, fmt.Errorf("service: failed to create invite link: %w", err)
+	}
+	return link, nil
+}
+
+// CreateAccessRequest files a request from a non-member to join a board. Board admins are notified by email.
+// If the user already has a pending access request for the board, that request is returned instead.
+func (s *service) CreateAccessRequest(ctx context.Context, input CreateAccessRequestInput) (models.AccessRequest, error) {
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return models.AccessRequest{}, errInvalidID
+	}
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.AccessRequest{}, fmt.Errorf("service: failed to get board when creating access request: %w", err)
+	}
+	if UserHasAccess(boardWithMembers, input.UserID) {
+		return models.AccessRequest{}, errAlreadyMember
+	}
+
+	// Return the existing pending request if there is one
+	pendingRequests, err := s.repo.ListAccessRequestsByBoard(ctx, boardWithMembers.ID, string(models.AccessRequestStatusPending))
+	if err != nil {
+		return models.AccessRequest{}, fmt.Errorf("service: failed to list pending access requests: %w", err)
+	}
+	for _, pendingRequest := range pendingRequests {
+		if pendingRequest.AccessRequest.UserID == userUUID {
+			return pendingRequest.AccessRequest, nil
+		}
+	}
+
+	user, err := s.repo.GetUser(ctx, userUUID)
+	if err != nil {
+		return models.AccessRequest{}, fmt.Errorf("service: failed to get user when creating access request: %w", err)
+	}
+	now := time.Now()
+	request := models.AccessRequest{
+		ID:        uuid.New(),
+		BoardID:   boardWithMembers.ID,
+		UserID:    userUUID,
+		Status:    models.AccessRequestStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.CreateAccessRequest(ctx, request); err != nil {
+		return models.AccessRequest{}, fmt.Errorf("service: failed to create access request: %w", err)
+	}
+
+	// Notify board admins
+	admins := []payloads.User{}
+	for _, member := range boardWithMembers.Members {
+		if member.Membership.Role == string(models.RoleAdmin) {
+			admins = append(admins, ToUserPayload(member))
+		}
+	}
+	requester := payloads.User{ID: user.ID.String(), Name: user.Name}
+	if user.Email != nil {
+		requester.Email = *user.Email
+	}
+	boardName := ""
+	if boardWithMembers.Name != nil {
+		boardName = *boardWithMembers.Name
+	}
+	s.amqp.Publish(queues.Notification, tasks.EmailAccessRequest, payloads.AccessRequest{
+		BoardID:   boardWithMembers.ID.String(),
+		BoardName: boardName,
+		Requester: requester,
+		Admins:    admins,
+	})
+	return request, nil
 }
 
-// GetBoard returns a single board for a given board ID
+// GetBoard returns a single board for a given board ID. Boards in the trash are not returned.
 func (s *service) GetBoard(ctx context.Context, boardID string) (models.Board, error) {
 	boardUUID, err := uuid.Parse(boardID)
 	if err != nil {
@@ -204,10 +496,14 @@ func (s *service) GetBoard(ctx context.Context, boardID string) (models.Board, e
 		}
 		return models.Board{}, fmt.Errorf("service: failed to get board: %w", err)
 	}
+	if board.DeletedAt != nil {
+		return models.Board{}, errBoardNotFound
+	}
 	return board, nil
 }
 
-// GetBoardWithMembers returns a single board with a list of associated members
+// GetBoardWithMembers returns a single board with a list of associated members. Boards in the trash are not
+// returned.
 func (s *service) GetBoardWithMembers(ctx context.Context, boardID string) (BoardWithMembersDTO, error) {
 	logger := logger.FromContext(ctx)
 	boardUUID, err := uuid.Parse(boardID)
@@ -220,7 +516,7 @@ func (s *service) GetBoardWithMembers(ctx context.Context, boardID string) (Boar
 		return BoardWithMembersDTO{}, fmt.Errorf("service: failed to get board with members: %w", err)
 	}
 	list := toBoardWithMembersDTO(rows)
-	if len(list) == 0 {
+	if len(list) == 0 || rows[0].Board.DeletedAt != nil {
 		return BoardWithMembersDTO{}, errBoardNotFound
 	}
 	return list[0], nil
@@ -285,6 +581,33 @@ func (s *service) ListSharedBoardsWithMembers(ctx context.Context, userID string
 	return list, nil
 }
 
+// ListArchivedBoardsWithMembers returns a list of archived boards that a user is a member of along with a list
+// of board members.
+func (s *service) ListArchivedBoardsWithMembers(ctx context.Context, userID string) ([]BoardWithMembersDTO, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, errInvalidID
+	}
+	rows, err := s.repo.ListArchivedBoardAndUsers(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get archived boards by user ID: %w", err)
+	}
+	return toBoardWithMembersDTO(rows), nil
+}
+
+// ListTrashedBoards returns a list of boards in the trash that a user is an admin of.
+func (s *service) ListTrashedBoards(ctx context.Context, userID string) ([]models.Board, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, errInvalidID
+	}
+	boards, err := s.repo.ListTrashedBoards(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get trashed boards by user ID: %w", err)
+	}
+	return boards, nil
+}
+
 // ListInvitesByBoard returns a list of invites belonging to a board. The user ID that is passed in should already
 // be authenticated.
 func (s *service) ListInvitesByBoard(ctx context.Context, input ListInvitesByBoardInput) ([]InviteWithReceiverDTO, error) {
@@ -316,6 +639,26 @@ func (s *service) ListInvitesByBoard(ctx context.Context, input ListInvitesByBoa
 	return toInviteWithReceiverDTO(rows), nil
 }
 
+// ListAccessRequests returns a list of access requests for a board along with the requesting users. Only board
+// admins can list access requests. The list can be filtered by status.
+func (s *service) ListAccessRequests(ctx context.Context, input ListAccessRequestsInput) ([]AccessRequestWithUserDTO, error) {
+	if input.Status != "" && !models.ValidAccessRequestStatusFilter(input.Status) {
+		return nil, errInvalidStatusFilter
+	}
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get board when listing access requests: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return nil, errUnauthorized
+	}
+	rows, err := s.repo.ListAccessRequestsByBoard(ctx, boardWithMembers.ID, input.Status)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list access requests: %w", err)
+	}
+	return toAccessRequestWithUserDTO(rows), nil
+}
+
 // ListInvitesByReceiver returns a list of board invites for a given receiver. Each board invite element is augmented with
 // sender and board details. The receiver ID should be the same as the authenticated user making the request.
 func (s *service) ListInvitesByReceiver(ctx context.Context, input ListInvitesByReceiverInput) ([]InviteWithBoardAndSenderDTO, error) {
@@ -338,6 +681,251 @@ func (s *service) ListInvitesByReceiver(ctx context.Context, input ListInvitesBy
 	return dto, nil
 }
 
+// UpdateBoard updates a board's name and/or description. Only board admins are allowed to update a board.
+func (s *service) UpdateBoard(ctx context.Context, input UpdateBoardInput) (models.Board, error) {
+	// Validate input
+	if err := s.validator.Struct(input); err != nil {
+		return models.Board{}, err
+	}
+
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to get board when updating board: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return models.Board{}, errUnauthorized
+	}
+
+	// Apply updates
+	board, err := s.repo.GetBoard(ctx, boardWithMembers.ID)
+	if err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to get board for update: %w", err)
+	}
+	if input.Name != nil {
+		board.Name = input.Name
+	}
+	if input.Description != nil {
+		board.Description = input.Description
+	}
+	if input.AllowedDomains != nil {
+		board.AllowedDomains = normalizeDomains(*input.AllowedDomains)
+	}
+	if input.VoteLimit != nil {
+		board.VoteLimit = *input.VoteLimit
+	}
+	if input.HideVotes != nil {
+		board.HideVotes = *input.HideVotes
+	}
+	if input.AnonymousPosts != nil {
+		board.AnonymousPosts = *input.AnonymousPosts
+	}
+	if input.HidePosts != nil {
+		board.HidePosts = *input.HidePosts
+	}
+	now := time.Now()
+	if input.Archived != nil {
+		if !*input.Archived {
+			board.ArchivedAt = nil
+		} else if board.ArchivedAt == nil {
+			board.ArchivedAt = &now
+		}
+	}
+	board.UpdatedAt = now
+
+	if err := s.repo.UpdateBoard(ctx, board); err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to update board: %w", err)
+	}
+	return board, nil
+}
+
+// ListInviteLinks returns the invite links of a board that can still be redeemed. Only board admins can list
+// invite links.
+func (s *service) ListInviteLinks(ctx context.Context, input ListInviteLinksInput) ([]models.InviteLink, error) {
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get board when listing invite links: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return nil, errUnauthorized
+	}
+
+	links, err := s.repo.ListInviteLinksByBoard(ctx, boardWithMembers.ID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list invite links: %w", err)
+	}
+	now := time.Now()
+	activeLinks := []models.InviteLink{}
+	for _, link := range links {
+		if link.IsActive(now) {
+			activeLinks = append(activeLinks, link)
+		}
+	}
+	return activeLinks, nil
+}
+
+// RedeemInviteLink adds the user to the board of an active invite link with the role granted by the link.
+// Users that are already board members keep their current role and do not consume a use of the link.
+func (s *service) RedeemInviteLink(ctx context.Context, input RedeemInviteLinkInput) (BoardWithMembersDTO, error) {
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return BoardWithMembersDTO{}, errInvalidID
+	}
+	link, err := s.repo.GetInviteLinkByToken(ctx, input.Token)
+	if err != nil {
+		if errors.Is(err, errInviteLinkDoesNotExist) {
+			return BoardWithMembersDTO{}, errInviteLinkNotFound
+		}
+		return BoardWithMembersDTO{}, fmt.Errorf("service: failed to get invite link: %w", err)
+	}
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, link.BoardID.String())
+	if err != nil {
+		return BoardWithMembersDTO{}, fmt.Errorf("service: failed to get board when redeeming invite link: %w", err)
+	}
+	if UserHasAccess(boardWithMembers, input.UserID) {
+		return boardWithMembers, nil
+	}
+
+	now := time.Now()
+	if !link.IsActive(now) {
+		return BoardWithMembersDTO{}, errInviteLinkInactive
+	}
+	membership := models.BoardMembership{
+		ID:        uuid.New(),
+		BoardID:   link.BoardID,
+		UserID:    userUUID,
+		Role:      link.Role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.RedeemInviteLink(ctx, link, membership); err != nil {
+		if errors.Is(err, errInviteLinkUsedUp) {
+			return BoardWithMembersDTO{}, errInviteLinkInactive
+		}
+		return BoardWithMembersDTO{}, fmt.Errorf("service: failed to redeem invite link: %w", err)
+	}
+	return s.GetBoardWithMembers(ctx, link.BoardID.String())
+}
+
+// JoinBoard adds the user to a board as a member without an invite. Only users with a verified email in one of
+// the board's allowed domains can join a board this way. Users that are already board members keep their
+// current role.
+func (s *service) JoinBoard(ctx context.Context, input JoinBoardInput) (BoardWithMembersDTO, error) {
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return BoardWithMembersDTO{}, errInvalidID
+	}
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return BoardWithMembersDTO{}, fmt.Errorf("service: failed to get board when joining board: %w", err)
+	}
+	if UserHasAccess(boardWithMembers, input.UserID) {
+		return boardWithMembers, nil
+	}
+	canJoin, err := s.CanJoinBoard(ctx, boardWithMembers, input.UserID)
+	if err != nil {
+		return BoardWithMembersDTO{}, err
+	}
+	if !canJoin {
+		return BoardWithMembersDTO{}, errDomainNotAllowed
+	}
+
+	now := time.Now()
+	membership := models.BoardMembership{
+		ID:        uuid.New(),
+		BoardID:   boardWithMembers.ID,
+		UserID:    userUUID,
+		Role:      models.RoleMember,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.CreateMembership(ctx, membership); err != nil {
+		return BoardWithMembersDTO{}, fmt.Errorf("service: failed to create membership when joining board: %w", err)
+	}
+	return s.GetBoardWithMembers(ctx, input.BoardID)
+}
+
+// CanJoinBoard reports whether a user can join a board without an invite, which requires a verified email in one
+// of the board's allowed domains.
+func (s *service) CanJoinBoard(ctx context.Context, board BoardWithMembersDTO, userID string) (bool, error) {
+	if len(board.AllowedDomains) == 0 {
+		return false, nil
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return false, errInvalidID
+	}
+	user, err := s.repo.GetUser(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, errUserDoesNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("service: failed to get user when checking allowed domains: %w", err)
+	}
+	if !user.IsVerified || user.Email == nil {
+		return false, nil
+	}
+	domain := emailDomain(*user.Email)
+	for _, allowedDomain := range board.AllowedDomains {
+		if domain == allowedDomain {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UpdateMembership changes the role of a board member. Only board admins can change roles. The board owner
+// cannot be demoted and a board must always keep at least one admin.
+func (s *service) UpdateMembership(ctx context.Context, input UpdateMembershipInput) (MemberDTO, error) {
+	// Validate input
+	if err := s.validator.Struct(input); err != nil {
+		return MemberDTO{}, err
+	}
+	memberUUID, err := uuid.Parse(input.MemberID)
+	if err != nil {
+		return MemberDTO{}, errInvalidID
+	}
+
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return MemberDTO{}, fmt.Errorf("service: failed to get board when updating membership: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return MemberDTO{}, errUnauthorized
+	}
+	member, ok := findMember(boardWithMembers.Members, memberUUID)
+	if !ok {
+		return MemberDTO{}, errMemberNotFound
+	}
+
+	// Guard against leaving the board without an admin
+	role := models.BoardMembershipRole(input.Role)
+	if role != models.RoleAdmin && member.Membership.Role == string(models.RoleAdmin) {
+		if memberUUID == boardWithMembers.UserID {
+			return MemberDTO{}, errOwnerMembership
+		}
+		if countAdmins(boardWithMembers.Members) == 1 {
+			return MemberDTO{}, errLastAdmin
+		}
+	}
+
+	now := time.Now()
+	membership := models.BoardMembership{
+		BoardID:   boardWithMembers.ID,
+		UserID:    memberUUID,
+		Role:      role,
+		UpdatedAt: now,
+	}
+	if err := s.repo.UpdateMembership(ctx, membership); err != nil {
+		return MemberDTO{}, fmt.Errorf("service: failed to update membership: %w", err)
+	}
+	member.Membership.Role = input.Role
+	member.Membership.UpdatedAt = now
+	return member, nil
+}
+
 // UpdateInvite updates a board invite. Only the sender of an invite can cancel the board invite, and only
 // the receiver of an invite can accept or ignore the board invite.
 func (s *service) UpdateInvite(ctx context.Context, input UpdateInviteInput) error {
@@ -358,6 +946,9 @@ func (s *service) UpdateInvite(ctx context.Context, input UpdateInviteInput) err
 		if invite.Status == string(models.InviteStatusCancelled) {
 			return errInviteCancelled
 		}
+		if inviteExpired(invite, now) {
+			return errInviteExpired
+		}
 		// Add user to board
 		membership := models.BoardMembership{
 			ID:        uuid.New(),
@@ -377,6 +968,9 @@ func (s *service) UpdateInvite(ctx context.Context, input UpdateInviteInput) err
 		if invite.Status == string(models.InviteStatusCancelled) {
 			return errInviteCancelled
 		}
+		if inviteExpired(invite, now) {
+			return errInviteExpired
+		}
 	case string(models.InviteStatusCancelled):
 		if invite.Sender.ID != userUUID {
 			return errUnauthorized
@@ -393,40 +987,923 @@ func (s *service) UpdateInvite(ctx context.Context, input UpdateInviteInput) err
 		Status:     models.InviteStatus(input.Status),
 		CreatedAt:  invite.CreatedAt,
 		UpdatedAt:  now,
+		ExpiresAt:  invite.ExpiresAt,
+		RemindedAt: invite.RemindedAt,
 	}
 
 	return s.repo.UpdateInvite(ctx, inviteToUpdate)
 }
 
-// toBoardWithMembersDTO transforms the BoardAndUser rows into a nested DTO struct
-func toBoardWithMembersDTO(rows []BoardMembershipUser) []BoardWithMembersDTO {
-	nestedList := []BoardWithMembersDTO{}
-	boardIndex := make(map[uuid.UUID]int)
-	for _, row := range rows {
-		// If board does not exist, add it to the list
-		if _, exists := boardIndex[row.Board.ID]; !exists {
-			// Convert board domain model to DTO
-			newItem := BoardWithMembersDTO{
-				ID:          row.Board.ID,
-				Name:        row.Board.Name,
-				Description: row.Board.Description,
-				UserID:      row.Board.UserID,
-				Members:     []MemberDTO{},
-				CreatedAt:   row.Board.CreatedAt,
-				UpdatedAt:   row.Board.UpdatedAt,
-			}
-			boardIndex[row.Board.ID] = len(nestedList)
-			nestedList = append(nestedList, newItem)
-		}
-		// Nest member/user details into board
-		member := MemberDTO{
-			ID:    row.User.ID,
-			Name:  row.User.Name,
-			Email: row.User.Email,
-			Membership: MembershipDTO{
-				Role:      string(row.Membership.Role),
-				CreatedAt: row.Membership.CreatedAt,
-				UpdatedAt: row.Membership.UpdatedAt,
+// UpdateAccessRequest approves or denies a pending access request. Only board admins can resolve access requests.
+// Approving a request adds the user to the board as a member, the same way accepting an invite does.
+func (s *service) UpdateAccessRequest(ctx context.Context, input UpdateAccessRequestInput) (models.AccessRequest, error) {
+	// Validate input
+	if err := s.validator.Struct(input); err != nil {
+		return models.AccessRequest{}, err
+	}
+	requestUUID, err := uuid.Parse(input.ID)
+	if err != nil {
+		return models.AccessRequest{}, errInvalidID
+	}
+
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.AccessRequest{}, fmt.Errorf("service: failed to get board when updating access request: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return models.AccessRequest{}, errUnauthorized
+	}
+
+	request, err := s.repo.GetAccessRequest(ctx, requestUUID)
+	if err != nil {
+		if errors.Is(err, errAccessRequestDoesNotExist) {
+			return models.AccessRequest{}, errAccessRequestNotFound
+		}
+		return models.AccessRequest{}, fmt.Errorf("service: failed to get access request: %w", err)
+	}
+	if request.BoardID != boardWithMembers.ID {
+		return models.AccessRequest{}, errAccessRequestNotFound
+	}
+	if request.Status != models.AccessRequestStatusPending {
+		return models.AccessRequest{}, errAccessRequestResolved
+	}
+
+	now := time.Now()
+	request.Status = models.AccessRequestStatus(input.Status)
+	request.UpdatedAt = now
+	if request.Status == models.AccessRequestStatusApproved && !UserHasAccess(boardWithMembers, request.UserID.String()) {
+		// Add user to board
+		membership := models.BoardMembership{
+			ID:        uuid.New(),
+			BoardID:   request.BoardID,
+			UserID:    request.UserID,
+			Role:      models.RoleMember,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err := s.repo.CreateMembership(ctx, membership); err != nil {
+			return models.AccessRequest{}, fmt.Errorf("service: failed to create membership when approving access request: %w", err)
+		}
+	}
+	if err := s.repo.UpdateAccessRequest(ctx, request); err != nil {
+		return models.AccessRequest{}, fmt.Errorf("service: failed to update access request: %w", err)
+	}
+	return request, nil
+}
+
+// RemindInvites publishes a reminder email task for every pending invite that expires within the reminder
+// window and has not been reminded yet. Each invite is reminded at most once. It returns the number of
+// reminders that were published.
+func (s *service) RemindInvites(ctx context.Context) (int, error) {
+	now := time.Now()
+	inviteIDs, err := s.repo.MarkInvitesReminded(ctx, now, now.Add(inviteReminderWindow))
+	if err != nil {
+		return 0, fmt.Errorf("service: failed to mark invites as reminded: %w", err)
+	}
+	for _, inviteID := range inviteIDs {
+		s.amqp.Publish(queues.Notification, tasks.EmailInviteReminder, payloads.Invite{ID: inviteID.String()})
+	}
+	return len(inviteIDs), nil
+}
+
+// ExpireInvites flips every pending invite that is past its expiry to EXPIRED. It returns the number of
+// invites that were expired.
+func (s *service) ExpireInvites(ctx context.Context) (int64, error) {
+	count, err := s.repo.ExpireInvites(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("service: failed to expire invites: %w", err)
+	}
+	return count, nil
+}
+
+// UpdatePhase moves a board to another retro phase. Only board admins can change the phase of a board, and a
+// board can only move to the phase right before or after its current phase.
+func (s *service) UpdatePhase(ctx context.Context, input UpdatePhaseInput) (models.Board, error) {
+	// Validate input
+	if err := s.validator.Struct(input); err != nil {
+		return models.Board{}, err
+	}
+
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to get board when updating phase: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return models.Board{}, errUnauthorized
+	}
+
+	board, err := s.repo.GetBoard(ctx, boardWithMembers.ID)
+	if err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to get board for phase update: %w", err)
+	}
+	phase := models.BoardPhase(input.Phase)
+	if !board.Phase.CanTransitionTo(phase) {
+		return models.Board{}, errInvalidPhaseTransition
+	}
+	board.Phase = phase
+	board.UpdatedAt = time.Now()
+	if err := s.repo.UpdateBoard(ctx, board); err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to update board phase: %w", err)
+	}
+	return board, nil
+}
+
+// RevealPosts stops hiding the contents of the posts on a board so that every member can see them. Only board
+// admins can reveal posts.
+func (s *service) RevealPosts(ctx context.Context, input RevealPostsInput) (models.Board, error) {
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to get board when revealing posts: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return models.Board{}, errUnauthorized
+	}
+
+	board, err := s.repo.GetBoard(ctx, boardWithMembers.ID)
+	if err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to get board for post reveal: %w", err)
+	}
+	board.HidePosts = false
+	board.UpdatedAt = time.Now()
+	if err := s.repo.UpdateBoard(ctx, board); err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to reveal board posts: %w", err)
+	}
+	return board, nil
+}
+
+// TransferOwnership reassigns a board to another existing member and upgrades that member to an admin. Only
+// board admins can transfer ownership so that boards are not stranded when their owner is no longer around.
+// Both the previous and the new owner are notified by email. The board is returned along with the new owner
+// and their upgraded membership.
+func (s *service) TransferOwnership(ctx context.Context, input TransferOwnershipInput) (models.Board, MemberDTO, error) {
+	// Validate input
+	if err := s.validator.Struct(input); err != nil {
+		return models.Board{}, MemberDTO{}, err
+	}
+	newOwnerUUID, err := uuid.Parse(input.NewOwnerID)
+	if err != nil {
+		return models.Board{}, MemberDTO{}, errInvalidID
+	}
+
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.Board{}, MemberDTO{}, fmt.Errorf("service: failed to get board when transferring ownership: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return models.Board{}, MemberDTO{}, errUnauthorized
+	}
+	if boardWithMembers.UserID == newOwnerUUID {
+		return models.Board{}, MemberDTO{}, errAlreadyOwner
+	}
+	newOwner, ok := findMember(boardWithMembers.Members, newOwnerUUID)
+	if !ok {
+		return models.Board{}, MemberDTO{}, errMemberNotFound
+	}
+	previousOwner, _ := findMember(boardWithMembers.Members, boardWithMembers.UserID)
+
+	// Reassign board and upgrade new owner
+	board, err := s.repo.GetBoard(ctx, boardWithMembers.ID)
+	if err != nil {
+		return models.Board{}, MemberDTO{}, fmt.Errorf("service: failed to get board for ownership transfer: %w", err)
+	}
+	now := time.Now()
+	board.UserID = newOwnerUUID
+	board.UpdatedAt = now
+	membership := models.BoardMembership{
+		BoardID:   board.ID,
+		UserID:    newOwnerUUID,
+		Role:      models.RoleAdmin,
+		UpdatedAt: now,
+	}
+	if err := s.repo.TransferOwnership(ctx, board, membership); err != nil {
+		return models.Board{}, MemberDTO{}, fmt.Errorf("service: failed to transfer ownership: %w", err)
+	}
+
+	boardName := ""
+	if board.Name != nil {
+		boardName = *board.Name
+	}
+	s.amqp.Publish(queues.Notification, tasks.EmailOwnership, payloads.OwnershipTransfer{
+		BoardID:       board.ID.String(),
+		BoardName:     boardName,
+		PreviousOwner: ToUserPayload(previousOwner),
+		NewOwner:      ToUserPayload(newOwner),
+	})
+	newOwner.Membership.Role = string(models.RoleAdmin)
+	newOwner.Membership.UpdatedAt = now
+	return board, newOwner, nil
+}
+
+// RevokeInviteLink revokes an invite link so that it can no longer be redeemed. Only board admins can revoke
+// invite links.
+func (s *service) RevokeInviteLink(ctx context.Context, input RevokeInviteLinkInput) error {
+	linkUUID, err := uuid.Parse(input.LinkID)
+	if err != nil {
+		return errInvalidID
+	}
+
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return fmt.Errorf("service: failed to get board when revoking invite link: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return errUnauthorized
+	}
+
+	link, err := s.repo.GetInviteLink(ctx, linkUUID)
+	if err != nil {
+		if errors.Is(err, errInviteLinkDoesNotExist) {
+			return errInviteLinkNotFound
+		}
+		return fmt.Errorf("service: failed to get invite link: %w", err)
+	}
+	if link.BoardID != boardWithMembers.ID {
+		return errInviteLinkNotFound
+	}
+	if link.RevokedAt != nil {
+		return nil
+	}
+	now := time.Now()
+	link.RevokedAt = &now
+	link.UpdatedAt = now
+	if err := s.repo.UpdateInviteLink(ctx, link); err != nil {
+		return fmt.Errorf("service: failed to revoke invite link: %w", err)
+	}
+	return nil
+}
+
+// DeleteBoard moves a board to the trash. Boards in the trash can be restored until they are purged. Only board
+// admins are allowed to delete a board.
+func (s *service) DeleteBoard(ctx context.Context, input DeleteBoardInput) error {
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return fmt.Errorf("service: failed to get board when deleting board: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return errUnauthorized
+	}
+	board, err := s.repo.GetBoard(ctx, boardWithMembers.ID)
+	if err != nil {
+		return fmt.Errorf("service: failed to get board for delete: %w", err)
+	}
+	now := time.Now()
+	board.DeletedAt = &now
+	board.UpdatedAt = now
+	if err := s.repo.UpdateBoard(ctx, board); err != nil {
+		return fmt.Errorf("service: failed to move board to trash: %w", err)
+	}
+	return nil
+}
+
+// RestoreBoard takes a board out of the trash. Only board admins are allowed to restore a board and a board
+// can only be restored while it is within the trash retention period, since it is purged after that.
+func (s *service) RestoreBoard(ctx context.Context, input RestoreBoardInput) (BoardWithMembersDTO, error) {
+	boardUUID, err := uuid.Parse(input.BoardID)
+	if err != nil {
+		return BoardWithMembersDTO{}, errInvalidID
+	}
+	board, err := s.repo.GetBoard(ctx, boardUUID)
+	if err != nil {
+		if errors.Is(err, errBoardDoesNotExist) {
+			return BoardWithMembersDTO{}, errBoardNotFound
+		}
+		return BoardWithMembersDTO{}, fmt.Errorf("service: failed to get board for restore: %w", err)
+	}
+
+	// Check if user is authorized
+	rows, err := s.repo.GetBoardAndUsers(ctx, boardUUID)
+	if err != nil {
+		return BoardWithMembersDTO{}, fmt.Errorf("service: failed to get board members for restore: %w", err)
+	}
+	list := toBoardWithMembersDTO(rows)
+	if len(list) == 0 || !UserIsAdmin(list[0], input.UserID) {
+		return BoardWithMembersDTO{}, errUnauthorized
+	}
+	if board.DeletedAt == nil {
+		return BoardWithMembersDTO{}, errBoardNotInTrash
+	}
+	now := time.Now()
+	if now.After(board.DeletedAt.Add(s.trashRetention)) {
+		return BoardWithMembersDTO{}, errTrashRetentionExpired
+	}
+
+	board.DeletedAt = nil
+	board.UpdatedAt = now
+	if err := s.repo.UpdateBoard(ctx, board); err != nil {
+		return BoardWithMembersDTO{}, fmt.Errorf("service: failed to restore board: %w", err)
+	}
+	return s.GetBoardWithMembers(ctx, input.BoardID)
+}
+
+// DuplicateBoard copies a board the user has access to, along with its post groups and, if requested, their
+// posts. Only admins can copy posts. Every copied entity gets a new ID and the user becomes the owner of the copy.
+// The copy keeps the anonymous and hidden post settings of the original so that copied posts stay masked.
+// Members, invites, and the archived state of the original board are not copied.
+func (s *service) DuplicateBoard(ctx context.Context, input DuplicateBoardInput) (models.Board, error) {
+	if err := s.validator.Struct(input); err != nil {
+		return models.Board{}, err
+	}
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return models.Board{}, errInvalidID
+	}
+	source, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.Board{}, err
+	}
+	if !UserHasAccess(source, input.UserID) {
+		return models.Board{}, errUnauthorized
+	}
+	// Posts may be hidden or anonymous, so only admins can copy them into a board they own
+	if input.IncludePosts && !UserIsAdmin(source, input.UserID) {
+		return models.Board{}, errUnauthorized
+	}
+
+	// Name the copy after the original board if no name is provided
+	if input.Name == nil {
+		boardName := "Copy of board"
+		if source.Name != nil {
+			boardName = fmt.Sprintf("Copy of %s", *source.Name)
+		}
+		input.Name = &boardName
+	}
+	description := source.Description
+	if description == nil {
+		description = &defaultBoardDescription
+	}
+
+	now := time.Now()
+	board := models.Board{
+		ID:             uuid.New(),
+		Name:           input.Name,
+		Description:    description,
+		UserID:         userUUID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		AllowedDomains: source.AllowedDomains,
+		Phase:          models.PhaseBrainstorm,
+		VoteLimit:      models.DefaultVoteLimit,
+		AnonymousPosts: source.AnonymousPosts,
+		HidePosts:      source.HidePosts,
+	}
+	membership := models.BoardMembership{
+		ID:        uuid.New(),
+		BoardID:   board.ID,
+		UserID:    userUUID,
+		Role:      models.RoleAdmin,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// Copy post groups and keep track of their new IDs so that posts can be moved into the copied groups
+	postGroups, err := s.repo.ListPostGroups(ctx, source.ID)
+	if err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to list post groups when duplicating board: %w", err)
+	}
+	postGroupIDs := make(map[uuid.UUID]uuid.UUID, len(postGroups))
+	for i, postGroup := range postGroups {
+		postGroupIDs[postGroup.ID] = uuid.New()
+		postGroups[i].ID = postGroupIDs[postGroup.ID]
+		postGroups[i].BoardID = board.ID
+		postGroups[i].CreatedAt = now
+		postGroups[i].UpdatedAt = now
+	}
+	posts := []models.Post{}
+	if input.IncludePosts {
+		posts, err = s.repo.ListPosts(ctx, source.ID)
+		if err != nil {
+			return models.Board{}, fmt.Errorf("service: failed to list posts when duplicating board: %w", err)
+		}
+		for i, post := range posts {
+			posts[i].ID = uuid.New()
+			posts[i].PostGroupID = postGroupIDs[post.PostGroupID]
+			posts[i].CreatedAt = now
+			posts[i].UpdatedAt = now
+		}
+	}
+
+	if err := s.repo.DuplicateBoard(ctx, board, membership, postGroups, posts); err != nil {
+		return models.Board{}, fmt.Errorf("service: failed to duplicate board: %w", err)
+	}
+	return board, nil
+}
+
+// ListTemplates returns the built-in templates followed by the templates that the user has saved.
+func (s *service) ListTemplates(ctx context.Context, userID string) ([]models.BoardTemplate, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return []models.BoardTemplate{}, errInvalidID
+	}
+	userTemplates, err := s.repo.ListTemplatesByUser(ctx, userUUID)
+	if err != nil {
+		return []models.BoardTemplate{}, fmt.Errorf("service: failed to list templates: %w", err)
+	}
+	templates := make([]models.BoardTemplate, 0, len(builtInTemplates)+len(userTemplates))
+	templates = append(templates, builtInTemplates...)
+	return append(templates, userTemplates...), nil
+}
+
+// CreateTemplate saves the post group titles and layout of a board the user has access to as a template. Posts
+// are not part of a template.
+func (s *service) CreateTemplate(ctx context.Context, input CreateTemplateInput) (models.BoardTemplate, error) {
+	if err := s.validator.Struct(input); err != nil {
+		return models.BoardTemplate{}, err
+	}
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return models.BoardTemplate{}, errInvalidID
+	}
+	board, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.BoardTemplate{}, err
+	}
+	if !UserHasAccess(board, input.UserID) {
+		return models.BoardTemplate{}, errUnauthorized
+	}
+	postGroups, err := s.repo.ListPostGroups(ctx, board.ID)
+	if err != nil {
+		return models.BoardTemplate{}, fmt.Errorf("service: failed to list post groups when creating template: %w", err)
+	}
+
+	now := time.Now()
+	template := models.BoardTemplate{
+		ID:          uuid.New(),
+		UserID:      &userUUID,
+		Name:        input.Name,
+		Description: input.Description,
+		PostGroups:  make([]models.TemplatePostGroup, len(postGroups)),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	for i, postGroup := range postGroups {
+		template.PostGroups[i] = models.TemplatePostGroup{
+			Title:  postGroup.Title,
+			PosX:   postGroup.PosX,
+			PosY:   postGroup.PosY,
+			ZIndex: postGroup.ZIndex,
+		}
+	}
+	if err := s.repo.CreateTemplate(ctx, template); err != nil {
+		return models.BoardTemplate{}, fmt.Errorf("service: failed to create template: %w", err)
+	}
+	return template, nil
+}
+
+// DeleteTemplate deletes a template that the user has saved. Built-in templates cannot be deleted.
+func (s *service) DeleteTemplate(ctx context.Context, input DeleteTemplateInput) error {
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return errInvalidID
+	}
+	templateUUID, err := uuid.Parse(input.TemplateID)
+	if err != nil {
+		return errInvalidID
+	}
+	if _, ok := getBuiltInTemplate(templateUUID); ok {
+		return errUnauthorized
+	}
+	if _, err := s.getTemplate(ctx, input.TemplateID, userUUID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteTemplate(ctx, templateUUID); err != nil {
+		return fmt.Errorf("service: failed to delete template: %w", err)
+	}
+	return nil
+}
+
+// getTemplate returns a built-in template or a template saved by the user. Templates saved by other users are
+// reported as not found.
+func (s *service) getTemplate(ctx context.Context, templateID string, userID uuid.UUID) (models.BoardTemplate, error) {
+	templateUUID, err := uuid.Parse(templateID)
+	if err != nil {
+		return models.BoardTemplate{}, errInvalidID
+	}
+	if template, ok := getBuiltInTemplate(templateUUID); ok {
+		return template, nil
+	}
+	template, err := s.repo.GetTemplate(ctx, templateUUID)
+	if err != nil {
+		if errors.Is(err, errTemplateDoesNotExist) {
+			return models.BoardTemplate{}, errTemplateNotFound
+		}
+		return models.BoardTemplate{}, fmt.Errorf("service: failed to get template: %w", err)
+	}
+	if template.UserID == nil || *template.UserID != userID {
+		return models.BoardTemplate{}, errTemplateNotFound
+	}
+	return template, nil
+}
+
+// CreateActionItem creates an action item on a board. Members with write access can create action items, and
+// an action item can only be assigned to a board member and linked to a post on the same board.
+func (s *service) CreateActionItem(ctx context.Context, input CreateActionItemInput) (models.ActionItem, error) {
+	// Validate input
+	if err := s.validator.Struct(input); err != nil {
+		return models.ActionItem{}, err
+	}
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return models.ActionItem{}, errInvalidID
+	}
+	dueDate, err := parseDueDate(input.DueDate)
+	if err != nil {
+		return models.ActionItem{}, err
+	}
+
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.ActionItem{}, fmt.Errorf("service: failed to get board when creating action item: %w", err)
+	}
+	if !UserCanWrite(boardWithMembers, input.UserID) {
+		return models.ActionItem{}, errUnauthorized
+	}
+
+	now := time.Now()
+	item := models.ActionItem{
+		ID:        uuid.New(),
+		BoardID:   boardWithMembers.ID,
+		UserID:    userUUID,
+		Title:     input.Title,
+		DueDate:   dueDate,
+		Status:    models.ActionItemStatusOpen,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if item.AssigneeID, err = getAssignee(boardWithMembers, input.AssigneeID); err != nil {
+		return models.ActionItem{}, err
+	}
+	if input.PostID != "" {
+		postUUID, err := s.getBoardPost(ctx, boardWithMembers.ID, input.PostID)
+		if err != nil {
+			return models.ActionItem{}, err
+		}
+		item.PostID = &postUUID
+	}
+	if err := s.repo.CreateActionItem(ctx, item); err != nil {
+		return models.ActionItem{}, fmt.Errorf("service: failed to create action item: %w", err)
+	}
+	return item, nil
+}
+
+// ListActionItems returns the action items of a board. Any board member can list them.
+func (s *service) ListActionItems(ctx context.Context, input ListActionItemsInput) ([]models.ActionItem, error) {
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get board when listing action items: %w", err)
+	}
+	if !UserHasAccess(boardWithMembers, input.UserID) {
+		return nil, errUnauthorized
+	}
+	items, err := s.repo.ListActionItemsByBoard(ctx, boardWithMembers.ID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list action items: %w", err)
+	}
+	return items, nil
+}
+
+// ListAssignedActionItems returns the action items assigned to a user across all of the boards that the user is
+// a member of.
+func (s *service) ListAssignedActionItems(ctx context.Context, userID string) ([]ActionItemWithBoardDTO, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, errInvalidID
+	}
+	rows, err := s.repo.ListActionItemsByAssignee(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list assigned action items: %w", err)
+	}
+	items := make([]ActionItemWithBoardDTO, len(rows))
+	for i, row := range rows {
+		items[i] = ActionItemWithBoardDTO{ActionItem: row.ActionItem, BoardName: row.BoardName}
+	}
+	return items, nil
+}
+
+// UpdateActionItem changes the assignee, title, due date, or status of an action item. Members with write
+// access can update action items.
+func (s *service) UpdateActionItem(ctx context.Context, input UpdateActionItemInput) (models.ActionItem, error) {
+	// Validate input
+	if err := s.validator.Struct(input); err != nil {
+		return models.ActionItem{}, err
+	}
+	item, boardWithMembers, err := s.getWritableActionItem(ctx, input.ID, input.UserID)
+	if err != nil {
+		return models.ActionItem{}, err
+	}
+
+	if input.AssigneeID != nil {
+		if item.AssigneeID, err = getAssignee(boardWithMembers, *input.AssigneeID); err != nil {
+			return models.ActionItem{}, err
+		}
+	}
+	if input.Title != nil {
+		item.Title = *input.Title
+	}
+	if input.DueDate != nil {
+		if item.DueDate, err = parseDueDate(*input.DueDate); err != nil {
+			return models.ActionItem{}, err
+		}
+	}
+	if input.Status != nil {
+		item.Status = models.ActionItemStatus(*input.Status)
+	}
+	item.UpdatedAt = time.Now()
+	if err := s.repo.UpdateActionItem(ctx, item); err != nil {
+		return models.ActionItem{}, fmt.Errorf("service: failed to update action item: %w", err)
+	}
+	return item, nil
+}
+
+// DeleteActionItem deletes an action item and returns it. Members with write access can delete action items.
+func (s *service) DeleteActionItem(ctx context.Context, input DeleteActionItemInput) (models.ActionItem, error) {
+	item, _, err := s.getWritableActionItem(ctx, input.ID, input.UserID)
+	if err != nil {
+		return models.ActionItem{}, err
+	}
+	if err := s.repo.DeleteActionItem(ctx, item.ID); err != nil {
+		return models.ActionItem{}, fmt.Errorf("service: failed to delete action item: %w", err)
+	}
+	return item, nil
+}
+
+// getWritableActionItem returns an action item along with its board if the user has write access to the board.
+func (s *service) getWritableActionItem(ctx context.Context, itemID string, userID string) (models.ActionItem, BoardWithMembersDTO, error) {
+	itemUUID, err := uuid.Parse(itemID)
+	if err != nil {
+		return models.ActionItem{}, BoardWithMembersDTO{}, errInvalidID
+	}
+	item, err := s.repo.GetActionItem(ctx, itemUUID)
+	if err != nil {
+		if errors.Is(err, errActionItemDoesNotExist) {
+			return models.ActionItem{}, BoardWithMembersDTO{}, errActionItemNotFound
+		}
+		return models.ActionItem{}, BoardWithMembersDTO{}, fmt.Errorf("service: failed to get action item: %w", err)
+	}
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, item.BoardID.String())
+	if err != nil {
+		return models.ActionItem{}, BoardWithMembersDTO{}, fmt.Errorf("service: failed to get board of action item: %w", err)
+	}
+	if !UserHasAccess(boardWithMembers, userID) {
+		return models.ActionItem{}, BoardWithMembersDTO{}, errActionItemNotFound
+	}
+	if !UserCanWrite(boardWithMembers, userID) {
+		return models.ActionItem{}, BoardWithMembersDTO{}, errUnauthorized
+	}
+	return item, boardWithMembers, nil
+}
+
+// getBoardPost returns the ID of a post if it belongs to the board.
+func (s *service) getBoardPost(ctx context.Context, boardID uuid.UUID, postID string) (uuid.UUID, error) {
+	postUUID, err := uuid.Parse(postID)
+	if err != nil {
+		return uuid.Nil, errInvalidID
+	}
+	posts, err := s.repo.ListPosts(ctx, boardID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("service: failed to list posts of board: %w", err)
+	}
+	for _, post := range posts {
+		if post.ID == postUUID {
+			return postUUID, nil
+		}
+	}
+	return uuid.Nil, errPostNotOnBoard
+}
+
+// CreateTag adds a tag to the tag catalog of a board. Only board admins can manage the tag catalog.
+func (s *service) CreateTag(ctx context.Context, input CreateTagInput) (models.BoardTag, error) {
+	// Validate input
+	if err := s.validator.Struct(input); err != nil {
+		return models.BoardTag{}, err
+	}
+
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.BoardTag{}, fmt.Errorf("service: failed to get board when creating tag: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return models.BoardTag{}, errUnauthorized
+	}
+
+	tag := models.BoardTag{
+		ID:        uuid.New(),
+		BoardID:   boardWithMembers.ID,
+		Name:      input.Name,
+		Color:     input.Color,
+		CreatedAt: time.Now(),
+	}
+	if err := s.repo.CreateTag(ctx, tag); err != nil {
+		if errors.Is(err, errTagNameExists) {
+			return models.BoardTag{}, errDuplicateTag
+		}
+		return models.BoardTag{}, fmt.Errorf("service: failed to create tag: %w", err)
+	}
+	return tag, nil
+}
+
+// ListTags returns the tag catalog of a board. Any board member can list it.
+func (s *service) ListTags(ctx context.Context, input ListTagsInput) ([]models.BoardTag, error) {
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get board when listing tags: %w", err)
+	}
+	if !UserHasAccess(boardWithMembers, input.UserID) {
+		return nil, errUnauthorized
+	}
+	tags, err := s.repo.ListTagsByBoard(ctx, boardWithMembers.ID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list tags: %w", err)
+	}
+	return tags, nil
+}
+
+// DeleteTag removes a tag from the tag catalog of a board, which also removes it from every post on the board.
+// Only board admins can manage the tag catalog.
+func (s *service) DeleteTag(ctx context.Context, input DeleteTagInput) (models.BoardTag, error) {
+	tagUUID, err := uuid.Parse(input.TagID)
+	if err != nil {
+		return models.BoardTag{}, errInvalidID
+	}
+
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return models.BoardTag{}, fmt.Errorf("service: failed to get board when deleting tag: %w", err)
+	}
+	if !UserIsAdmin(boardWithMembers, input.UserID) {
+		return models.BoardTag{}, errUnauthorized
+	}
+
+	tag, err := s.repo.GetTag(ctx, tagUUID)
+	if err != nil {
+		if errors.Is(err, errTagDoesNotExist) {
+			return models.BoardTag{}, errTagNotFound
+		}
+		return models.BoardTag{}, fmt.Errorf("service: failed to get tag: %w", err)
+	}
+	if tag.BoardID != boardWithMembers.ID {
+		return models.BoardTag{}, errTagNotFound
+	}
+	if err := s.repo.DeleteTag(ctx, tag.ID); err != nil {
+		return models.BoardTag{}, fmt.Errorf("service: failed to delete tag: %w", err)
+	}
+	return tag, nil
+}
+
+// PurgeBoards permanently deletes the boards that have been in the trash for longer than the retention period,
+// along with their post groups, posts, and attachment files. It returns the number of purged boards.
+func (s *service) PurgeBoards(ctx context.Context, retention time.Duration) (int64, error) {
+	logger := logger.FromContext(ctx)
+	deletedBefore := time.Now().Add(-retention)
+	attachments, err := s.repo.ListPurgeableAttachments(ctx, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("service: failed to list attachments when purging boards: %w", err)
+	}
+	count, err := s.repo.PurgeBoards(ctx, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("service: failed to purge boards: %w", err)
+	}
+	// The attachment records are purged along with the boards, so a file that cannot be removed is only logged
+	for _, attachment := range attachments {
+		if err := s.storage.Delete(ctx, attachment.StorageKey()); err != nil {
+			logger.Errorf("service: failed to delete attachment file %s: %v", attachment.ID, err)
+		}
+	}
+	return count, nil
+}
+
+// DeleteMembership removes a member from a board. Board admins can remove any member and any member can
+// remove themselves to leave the board. The board owner cannot be removed and a board must always keep at
+// least one admin.
+func (s *service) DeleteMembership(ctx context.Context, input DeleteMembershipInput) error {
+	memberUUID, err := uuid.Parse(input.MemberID)
+	if err != nil {
+		return errInvalidID
+	}
+
+	// Check if user is authorized
+	boardWithMembers, err := s.GetBoardWithMembers(ctx, input.BoardID)
+	if err != nil {
+		return fmt.Errorf("service: failed to get board when deleting membership: %w", err)
+	}
+	isLeaving := input.UserID == input.MemberID
+	if !isLeaving && !UserIsAdmin(boardWithMembers, input.UserID) {
+		return errUnauthorized
+	}
+	member, ok := findMember(boardWithMembers.Members, memberUUID)
+	if !ok {
+		return errMemberNotFound
+	}
+
+	// Guard against leaving the board without an admin
+	if memberUUID == boardWithMembers.UserID {
+		return errOwnerMembership
+	}
+	if member.Membership.Role == string(models.RoleAdmin) && countAdmins(boardWithMembers.Members) == 1 {
+		return errLastAdmin
+	}
+
+	if err := s.repo.DeleteMembership(ctx, boardWithMembers.ID, memberUUID); err != nil {
+		return fmt.Errorf("service: failed to delete membership: %w", err)
+	}
+	return nil
+}
+
+// getAssignee returns the ID of a board member to assign an action item to. An empty assignee ID leaves the
+// action item unassigned.
+func getAssignee(board BoardWithMembersDTO, assigneeID string) (*uuid.UUID, error) {
+	if assigneeID == "" {
+		return nil, nil
+	}
+	assigneeUUID, err := uuid.Parse(assigneeID)
+	if err != nil {
+		return nil, errInvalidID
+	}
+	if _, ok := findMember(board.Members, assigneeUUID); !ok {
+		return nil, errAssigneeNotMember
+	}
+	return &assigneeUUID, nil
+}
+
+// parseDueDate parses an action item due date in YYYY-MM-DD format. An empty due date is parsed as no due date.
+func parseDueDate(dueDate string) (*time.Time, error) {
+	if dueDate == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(time.DateOnly, dueDate)
+	if err != nil {
+		return nil, errInvalidDueDate
+	}
+	return &date, nil
+}
+
+// normalizeDomains lowercases the allowed domains of a board and drops duplicates.
+func normalizeDomains(domains []string) []string {
+	normalized := []string{}
+	seen := make(map[string]bool)
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if seen[domain] {
+			continue
+		}
+		seen[domain] = true
+		normalized = append(normalized, domain)
+	}
+	return normalized
+}
+
+// emailDomain returns the lowercased domain part of an email address.
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at == -1 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
+
+// toBoardWithMembersDTO transforms the BoardAndUser rows into a nested DTO struct
+func toBoardWithMembersDTO(rows []BoardMembershipUser) []BoardWithMembersDTO {
+	nestedList := []BoardWithMembersDTO{}
+	boardIndex := make(map[uuid.UUID]int)
+	for _, row := range rows {
+		// If board does not exist, add it to the list
+		if _, exists := boardIndex[row.Board.ID]; !exists {
+			// Convert board domain model to DTO
+			newItem := BoardWithMembersDTO{
+				ID:             row.Board.ID,
+				Name:           row.Board.Name,
+				Description:    row.Board.Description,
+				UserID:         row.Board.UserID,
+				Members:        []MemberDTO{},
+				CreatedAt:      row.Board.CreatedAt,
+				UpdatedAt:      row.Board.UpdatedAt,
+				AllowedDomains: row.Board.AllowedDomains,
+				ArchivedAt:     row.Board.ArchivedAt,
+				Phase:          row.Board.Phase,
+				VoteLimit:      row.Board.VoteLimit,
+				HideVotes:      row.Board.HideVotes,
+				AnonymousPosts: row.Board.AnonymousPosts,
+				HidePosts:      row.Board.HidePosts,
+			}
+			boardIndex[row.Board.ID] = len(nestedList)
+			nestedList = append(nestedList, newItem)
+		}
+		// Nest member/user details into board
+		member := MemberDTO{
+			ID:    row.User.ID,
+			Name:  row.User.Name,
+			Email: row.User.Email,
+			Membership: MembershipDTO{
+				Role:      string(row.Membership.Role),
+				CreatedAt: row.Membership.CreatedAt,
+				UpdatedAt: row.Membership.UpdatedAt,
 			},
 			CreatedAt: row.User.CreatedAt,
 			UpdatedAt: row.User.UpdatedAt,
@@ -439,6 +1916,27 @@ func toBoardWithMembersDTO(rows []BoardMembershipUser) []BoardWithMembersDTO {
 	return nestedList
 }
 
+// findMember looks for a member with the given user ID and returns that member with a bool true.
+func findMember(members []MemberDTO, userID uuid.UUID) (MemberDTO, bool) {
+	for _, member := range members {
+		if member.ID == userID {
+			return member, true
+		}
+	}
+	return MemberDTO{}, false
+}
+
+// countAdmins returns the number of members that have admin privileges.
+func countAdmins(members []MemberDTO) int {
+	count := 0
+	for _, member := range members {
+		if member.Membership.Role == string(models.RoleAdmin) {
+			count++
+		}
+	}
+	return count
+}
+
 // hasPendingInvite checks to see if the receiver already has a pending invite, and returns that invite with a bool true.
 func hasPendingInvite(receiverID uuid.UUID, pendingInvites []InviteWithReceiverDTO) (models.Invite, bool) {
 	for _, pendingInvite := range pendingInvites {
@@ -459,7 +1957,42 @@ func hasPendingInvite(receiverID uuid.UUID, pendingInvites []InviteWithReceiverD
 }
 
 // toInviteWithBoardAndSenderDTO takes the flat structure from InviteBoardSender and maps it to the nested
+// inviteExpired checks to see if an invite has been expired by the expiry job or is past its expiry.
+func inviteExpired(invite InviteWithSenderReceiverDTO, now time.Time) bool {
+	if invite.Status == string(models.InviteStatusExpired) {
+		return true
+	}
+	return invite.ExpiresAt != nil && !now.Before(*invite.ExpiresAt)
+}
+
+// hasPendingEmailInvite checks to see if the email already has a pending email invite, and returns that invite with a bool true.
+func hasPendingEmailInvite(email string, pendingInvites []models.EmailInvite) (models.EmailInvite, bool) {
+	for _, pendingInvite := range pendingInvites {
+		if pendingInvite.Email == email {
+			return pendingInvite, true
+		}
+	}
+	return models.EmailInvite{}, false
+}
+
 // DTO structure.
+func toAccessRequestWithUserDTO(rows []AccessRequestUser) []AccessRequestWithUserDTO {
+	dto := []AccessRequestWithUserDTO{}
+	for _, row := range rows {
+		row.User.Password = nil
+		mappedRow := AccessRequestWithUserDTO{
+			ID:        row.AccessRequest.ID,
+			BoardID:   row.AccessRequest.BoardID,
+			User:      row.User,
+			Status:    string(row.AccessRequest.Status),
+			CreatedAt: row.AccessRequest.CreatedAt,
+			UpdatedAt: row.AccessRequest.UpdatedAt,
+		}
+		dto = append(dto, mappedRow)
+	}
+	return dto
+}
+
 func toInviteWithBoardAndSenderDTO(rows []InviteBoardSender) []InviteWithBoardAndSenderDTO {
 	dto := []InviteWithBoardAndSenderDTO{}
 	for _, row := range rows {
@@ -472,6 +2005,7 @@ func toInviteWithBoardAndSenderDTO(rows []InviteBoardSender) []InviteWithBoardAn
 			Status:     string(row.Invite.Status),
 			CreatedAt:  row.Invite.CreatedAt,
 			UpdatedAt:  row.Invite.UpdatedAt,
+			ExpiresAt:  row.Invite.ExpiresAt,
 		}
 		dto = append(dto, mappedRow)
 	}
@@ -492,6 +2026,7 @@ func toInviteWithReceiverDTO(rows []InviteReceiver) []InviteWithReceiverDTO {
 			Status:    string(row.Invite.Status),
 			CreatedAt: row.Invite.CreatedAt,
 			UpdatedAt: row.Invite.UpdatedAt,
+			ExpiresAt: row.Invite.ExpiresAt,
 		}
 		dto = append(dto, mappedRow)
 	}
@@ -503,12 +2038,35 @@ func toInviteWithSenderReceiverDTO(row InviteSenderReceiver) InviteWithSenderRec
 	row.Receiver.Password = nil
 
 	return InviteWithSenderReceiverDTO{
-		ID:        row.Invite.ID,
-		BoardID:   row.Invite.BoardID,
-		Sender:    row.Sender,
-		Receiver:  row.Receiver,
-		Status:    string(row.Invite.Status),
-		CreatedAt: row.Invite.CreatedAt,
-		UpdatedAt: row.Invite.UpdatedAt,
+		ID:         row.Invite.ID,
+		BoardID:    row.Invite.BoardID,
+		Sender:     row.Sender,
+		Receiver:   row.Receiver,
+		Status:     string(row.Invite.Status),
+		CreatedAt:  row.Invite.CreatedAt,
+		UpdatedAt:  row.Invite.UpdatedAt,
+		ExpiresAt:  row.Invite.ExpiresAt,
+		RemindedAt: row.Invite.RemindedAt,
+	}
+}
+
+// ToUserPayload converts a board member into the user payload shape expected by notification tasks.
+func ToUserPayload(member MemberDTO) payloads.User {
+	user := payloads.User{
+		ID:   member.ID.String(),
+		Name: member.Name,
+	}
+	if member.Email != nil {
+		user.Email = *member.Email
+	}
+	return user
+}
+
+// generateInviteLinkToken returns a random URL-safe token that identifies an invite link.
+func generateInviteLinkToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
 	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
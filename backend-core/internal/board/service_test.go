@@ -2,9 +2,12 @@ package board
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Wave-95/boards/backend-core/internal/models"
+	"github.com/Wave-95/boards/backend-core/internal/storage"
 	"github.com/Wave-95/boards/backend-core/internal/test"
 	"github.com/Wave-95/boards/backend-core/pkg/validator"
 	"github.com/Wave-95/boards/wrappers/amqp"
@@ -18,7 +21,8 @@ func TestService(t *testing.T) {
 	mockBoardRepo := NewMockRepository()
 	mockBoardRepo.AddUser(testUser)
 	mockAmqp := amqp.NewMock()
-	boardService := NewService(mockBoardRepo, mockAmqp, validator)
+	attachmentStorage := storage.NewLocal(t.TempDir())
+	boardService := NewService(mockBoardRepo, attachmentStorage, mockAmqp, validator, time.Hour)
 	assert.NotNil(t, boardService)
 	t.Run("Create board", func(t *testing.T) {
 		t.Run("without name or description", func(t *testing.T) {
@@ -56,6 +60,429 @@ func TestService(t *testing.T) {
 		assert.NotNil(t, board)
 	})
 
+	t.Run("Update board", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		updatedName := "Updated name"
+
+		t.Run("as admin", func(t *testing.T) {
+			input := UpdateBoardInput{
+				BoardID: board.ID.String(),
+				UserID:  testUser.ID.String(),
+				Name:    &updatedName,
+			}
+			updatedBoard, err := boardService.UpdateBoard(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, updatedName, *updatedBoard.Name)
+			assert.Equal(t, *board.Description, *updatedBoard.Description)
+		})
+
+		t.Run("vote settings", func(t *testing.T) {
+			voteLimit := 3
+			hideVotes := true
+			input := UpdateBoardInput{
+				BoardID:   board.ID.String(),
+				UserID:    testUser.ID.String(),
+				VoteLimit: &voteLimit,
+				HideVotes: &hideVotes,
+			}
+			updatedBoard, err := boardService.UpdateBoard(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, voteLimit, updatedBoard.VoteLimit)
+
+			boardWithMembers, err := boardService.GetBoardWithMembers(context.Background(), board.ID.String())
+			assert.NoError(t, err)
+			assert.True(t, boardWithMembers.VotesHidden())
+		})
+
+		t.Run("anonymous posts", func(t *testing.T) {
+			anonymousPosts := true
+			input := UpdateBoardInput{
+				BoardID:        board.ID.String(),
+				UserID:         testUser.ID.String(),
+				AnonymousPosts: &anonymousPosts,
+			}
+			updatedBoard, err := boardService.UpdateBoard(context.Background(), input)
+			assert.NoError(t, err)
+			assert.True(t, updatedBoard.AnonymousPosts)
+
+			boardWithMembers, err := boardService.GetBoardWithMembers(context.Background(), board.ID.String())
+			assert.NoError(t, err)
+			assert.True(t, boardWithMembers.AnonymousPosts)
+		})
+
+		t.Run("as non-member", func(t *testing.T) {
+			input := UpdateBoardInput{
+				BoardID: board.ID.String(),
+				UserID:  uuid.New().String(),
+				Name:    &updatedName,
+			}
+			_, err := boardService.UpdateBoard(context.Background(), input)
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+	})
+
+	t.Run("Delete board", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		err = boardService.DeleteBoard(context.Background(), DeleteBoardInput{BoardID: board.ID.String(), UserID: uuid.New().String()})
+		assert.ErrorIs(t, err, errUnauthorized)
+
+		err = boardService.DeleteBoard(context.Background(), DeleteBoardInput{BoardID: board.ID.String(), UserID: testUser.ID.String()})
+		assert.NoError(t, err)
+		_, err = boardService.GetBoard(context.Background(), board.ID.String())
+		assert.Error(t, err)
+	})
+
+	t.Run("Update membership", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		member := addTestMember(t, mockBoardRepo, board.ID)
+
+		t.Run("promote member as admin", func(t *testing.T) {
+			input := UpdateMembershipInput{
+				BoardID:  board.ID.String(),
+				UserID:   testUser.ID.String(),
+				MemberID: member.ID.String(),
+				Role:     string(models.RoleAdmin),
+			}
+			updatedMember, err := boardService.UpdateMembership(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, string(models.RoleAdmin), updatedMember.Membership.Role)
+		})
+
+		t.Run("demote member to viewer", func(t *testing.T) {
+			input := UpdateMembershipInput{
+				BoardID:  board.ID.String(),
+				UserID:   testUser.ID.String(),
+				MemberID: member.ID.String(),
+				Role:     string(models.RoleViewer),
+			}
+			_, err := boardService.UpdateMembership(context.Background(), input)
+			assert.NoError(t, err)
+
+			boardWithMembers, err := boardService.GetBoardWithMembers(context.Background(), board.ID.String())
+			assert.NoError(t, err)
+			assert.False(t, UserCanWrite(boardWithMembers, member.ID.String()))
+			assert.True(t, UserCanWrite(boardWithMembers, testUser.ID.String()))
+		})
+
+		t.Run("demote board owner", func(t *testing.T) {
+			input := UpdateMembershipInput{
+				BoardID:  board.ID.String(),
+				UserID:   testUser.ID.String(),
+				MemberID: testUser.ID.String(),
+				Role:     string(models.RoleMember),
+			}
+			_, err := boardService.UpdateMembership(context.Background(), input)
+			assert.ErrorIs(t, err, errOwnerMembership)
+		})
+
+		t.Run("as non-admin", func(t *testing.T) {
+			input := UpdateMembershipInput{
+				BoardID:  board.ID.String(),
+				UserID:   uuid.New().String(),
+				MemberID: member.ID.String(),
+				Role:     string(models.RoleMember),
+			}
+			_, err := boardService.UpdateMembership(context.Background(), input)
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("with unsupported role", func(t *testing.T) {
+			input := UpdateMembershipInput{
+				BoardID:  board.ID.String(),
+				UserID:   testUser.ID.String(),
+				MemberID: member.ID.String(),
+				Role:     "OWNER",
+			}
+			_, err := boardService.UpdateMembership(context.Background(), input)
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("Delete membership", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		member := addTestMember(t, mockBoardRepo, board.ID)
+		otherMember := addTestMember(t, mockBoardRepo, board.ID)
+
+		t.Run("remove member as non-admin", func(t *testing.T) {
+			input := DeleteMembershipInput{
+				BoardID:  board.ID.String(),
+				UserID:   member.ID.String(),
+				MemberID: otherMember.ID.String(),
+			}
+			err := boardService.DeleteMembership(context.Background(), input)
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("remove board owner", func(t *testing.T) {
+			input := DeleteMembershipInput{
+				BoardID:  board.ID.String(),
+				UserID:   testUser.ID.String(),
+				MemberID: testUser.ID.String(),
+			}
+			err := boardService.DeleteMembership(context.Background(), input)
+			assert.ErrorIs(t, err, errOwnerMembership)
+		})
+
+		t.Run("remove member as admin", func(t *testing.T) {
+			input := DeleteMembershipInput{
+				BoardID:  board.ID.String(),
+				UserID:   testUser.ID.String(),
+				MemberID: otherMember.ID.String(),
+			}
+			err := boardService.DeleteMembership(context.Background(), input)
+			assert.NoError(t, err)
+		})
+
+		t.Run("leave board", func(t *testing.T) {
+			input := DeleteMembershipInput{
+				BoardID:  board.ID.String(),
+				UserID:   member.ID.String(),
+				MemberID: member.ID.String(),
+			}
+			err := boardService.DeleteMembership(context.Background(), input)
+			assert.NoError(t, err)
+
+			boardWithMembers, err := boardService.GetBoardWithMembers(context.Background(), board.ID.String())
+			assert.NoError(t, err)
+			_, ok := findMember(boardWithMembers.Members, member.ID)
+			assert.False(t, ok)
+		})
+
+		t.Run("remove member that does not exist", func(t *testing.T) {
+			input := DeleteMembershipInput{
+				BoardID:  board.ID.String(),
+				UserID:   testUser.ID.String(),
+				MemberID: member.ID.String(),
+			}
+			err := boardService.DeleteMembership(context.Background(), input)
+			assert.ErrorIs(t, err, errMemberNotFound)
+		})
+	})
+
+	t.Run("Transfer ownership", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		member := addTestMember(t, mockBoardRepo, board.ID)
+
+		t.Run("to non-member", func(t *testing.T) {
+			input := TransferOwnershipInput{
+				BoardID:    board.ID.String(),
+				UserID:     testUser.ID.String(),
+				NewOwnerID: uuid.New().String(),
+			}
+			_, _, err := boardService.TransferOwnership(context.Background(), input)
+			assert.ErrorIs(t, err, errMemberNotFound)
+		})
+
+		t.Run("as non-admin", func(t *testing.T) {
+			input := TransferOwnershipInput{
+				BoardID:    board.ID.String(),
+				UserID:     member.ID.String(),
+				NewOwnerID: member.ID.String(),
+			}
+			_, _, err := boardService.TransferOwnership(context.Background(), input)
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("to member", func(t *testing.T) {
+			input := TransferOwnershipInput{
+				BoardID:    board.ID.String(),
+				UserID:     testUser.ID.String(),
+				NewOwnerID: member.ID.String(),
+			}
+			updatedBoard, newOwner, err := boardService.TransferOwnership(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, member.ID, updatedBoard.UserID)
+			assert.Equal(t, member.ID, newOwner.ID)
+			assert.Equal(t, string(models.RoleAdmin), newOwner.Membership.Role)
+
+			boardWithMembers, err := boardService.GetBoardWithMembers(context.Background(), board.ID.String())
+			assert.NoError(t, err)
+			assert.True(t, UserIsAdmin(boardWithMembers, member.ID.String()))
+		})
+
+		t.Run("to current owner", func(t *testing.T) {
+			input := TransferOwnershipInput{
+				BoardID:    board.ID.String(),
+				UserID:     member.ID.String(),
+				NewOwnerID: member.ID.String(),
+			}
+			_, _, err := boardService.TransferOwnership(context.Background(), input)
+			assert.ErrorIs(t, err, errAlreadyOwner)
+		})
+
+		t.Run("of a board without a name", func(t *testing.T) {
+			unnamedBoard, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+			if err != nil {
+				assert.FailNow(t, "Failed to create test board")
+			}
+			unnamedBoard.Name = nil
+			mockBoardRepo.boards[unnamedBoard.ID] = unnamedBoard
+			unnamedMember := addTestMember(t, mockBoardRepo, unnamedBoard.ID)
+			input := TransferOwnershipInput{
+				BoardID:    unnamedBoard.ID.String(),
+				UserID:     testUser.ID.String(),
+				NewOwnerID: unnamedMember.ID.String(),
+			}
+			updatedBoard, _, err := boardService.TransferOwnership(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, unnamedMember.ID, updatedBoard.UserID)
+		})
+	})
+
+	t.Run("Board phases", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		member := addTestMember(t, mockBoardRepo, board.ID)
+		assert.Equal(t, models.PhaseBrainstorm, board.Phase)
+
+		t.Run("as non-admin", func(t *testing.T) {
+			input := UpdatePhaseInput{BoardID: board.ID.String(), UserID: member.ID.String(), Phase: string(models.PhaseGroup)}
+			_, err := boardService.UpdatePhase(context.Background(), input)
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("skip a phase", func(t *testing.T) {
+			input := UpdatePhaseInput{BoardID: board.ID.String(), UserID: testUser.ID.String(), Phase: string(models.PhaseVote)}
+			_, err := boardService.UpdatePhase(context.Background(), input)
+			assert.ErrorIs(t, err, errInvalidPhaseTransition)
+		})
+
+		t.Run("move to next phase", func(t *testing.T) {
+			input := UpdatePhaseInput{BoardID: board.ID.String(), UserID: testUser.ID.String(), Phase: string(models.PhaseGroup)}
+			updatedBoard, err := boardService.UpdatePhase(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, models.PhaseGroup, updatedBoard.Phase)
+
+			boardWithMembers, err := boardService.GetBoardWithMembers(context.Background(), board.ID.String())
+			assert.NoError(t, err)
+			assert.Equal(t, models.PhaseGroup, boardWithMembers.Phase)
+		})
+
+		t.Run("move back to previous phase", func(t *testing.T) {
+			input := UpdatePhaseInput{BoardID: board.ID.String(), UserID: testUser.ID.String(), Phase: string(models.PhaseBrainstorm)}
+			updatedBoard, err := boardService.UpdatePhase(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, models.PhaseBrainstorm, updatedBoard.Phase)
+		})
+	})
+
+	t.Run("Reveal posts", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		member := addTestMember(t, mockBoardRepo, board.ID)
+		hidePosts := true
+		_, err = boardService.UpdateBoard(context.Background(), UpdateBoardInput{
+			BoardID:   board.ID.String(),
+			UserID:    testUser.ID.String(),
+			HidePosts: &hidePosts,
+		})
+		if err != nil {
+			assert.FailNow(t, "Failed to hide test board posts")
+		}
+
+		t.Run("as non-admin", func(t *testing.T) {
+			input := RevealPostsInput{BoardID: board.ID.String(), UserID: member.ID.String()}
+			_, err := boardService.RevealPosts(context.Background(), input)
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("as admin", func(t *testing.T) {
+			input := RevealPostsInput{BoardID: board.ID.String(), UserID: testUser.ID.String()}
+			updatedBoard, err := boardService.RevealPosts(context.Background(), input)
+			assert.NoError(t, err)
+			assert.False(t, updatedBoard.HidePosts)
+
+			boardWithMembers, err := boardService.GetBoardWithMembers(context.Background(), board.ID.String())
+			assert.NoError(t, err)
+			assert.False(t, boardWithMembers.HidePosts)
+		})
+	})
+
+	t.Run("Invite links", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		maxUses := 1
+		link, err := boardService.CreateInviteLink(context.Background(), CreateInviteLinkInput{
+			BoardID: board.ID.String(),
+			UserID:  testUser.ID.String(),
+			Role:    string(models.RoleViewer),
+			MaxUses: &maxUses,
+		})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test invite link")
+		}
+
+		t.Run("create as non-admin", func(t *testing.T) {
+			input := CreateInviteLinkInput{BoardID: board.ID.String(), UserID: uuid.New().String()}
+			_, err := boardService.CreateInviteLink(context.Background(), input)
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("create with expiry in the past", func(t *testing.T) {
+			expiresAt := time.Now().Add(-time.Hour)
+			input := CreateInviteLinkInput{BoardID: board.ID.String(), UserID: testUser.ID.String(), ExpiresAt: &expiresAt}
+			_, err := boardService.CreateInviteLink(context.Background(), input)
+			assert.Error(t, err)
+		})
+
+		t.Run("redeem", func(t *testing.T) {
+			newMember := test.NewUser()
+			mockBoardRepo.AddUser(newMember)
+			boardWithMembers, err := boardService.RedeemInviteLink(context.Background(), RedeemInviteLinkInput{Token: link.Token, UserID: newMember.ID.String()})
+			assert.NoError(t, err)
+			member, ok := findMember(boardWithMembers.Members, newMember.ID)
+			assert.True(t, ok)
+			assert.Equal(t, string(models.RoleViewer), member.Membership.Role)
+		})
+
+		t.Run("redeem after usage limit is reached", func(t *testing.T) {
+			_, err := boardService.RedeemInviteLink(context.Background(), RedeemInviteLinkInput{Token: link.Token, UserID: uuid.New().String()})
+			assert.ErrorIs(t, err, errInviteLinkInactive)
+
+			links, err := boardService.ListInviteLinks(context.Background(), ListInviteLinksInput{BoardID: board.ID.String(), UserID: testUser.ID.String()})
+			assert.NoError(t, err)
+			assert.Empty(t, links)
+		})
+
+		t.Run("redeem after revoke", func(t *testing.T) {
+			revokedLink, err := boardService.CreateInviteLink(context.Background(), CreateInviteLinkInput{BoardID: board.ID.String(), UserID: testUser.ID.String()})
+			if err != nil {
+				assert.FailNow(t, "Failed to create test invite link")
+			}
+			err = boardService.RevokeInviteLink(context.Background(), RevokeInviteLinkInput{BoardID: board.ID.String(), UserID: testUser.ID.String(), LinkID: revokedLink.ID.String()})
+			assert.NoError(t, err)
+
+			_, err = boardService.RedeemInviteLink(context.Background(), RedeemInviteLinkInput{Token: revokedLink.Token, UserID: uuid.New().String()})
+			assert.ErrorIs(t, err, errInviteLinkInactive)
+		})
+
+		t.Run("redeem unknown token", func(t *testing.T) {
+			_, err := boardService.RedeemInviteLink(context.Background(), RedeemInviteLinkInput{Token: "unknown", UserID: testUser.ID.String()})
+			assert.ErrorIs(t, err, errInviteLinkNotFound)
+		})
+	})
+
 	t.Run("List owned boards", func(t *testing.T) {
 		boards, err := boardService.ListOwnedBoards(context.Background(), testUser.ID.String())
 		assert.NoError(t, err)
@@ -80,14 +507,708 @@ func TestService(t *testing.T) {
 		createBoardInvitesInput := CreateInvitesInput{
 			BoardID:  board.ID.String(),
 			SenderID: testUser.ID.String(),
-			Invites: []struct {
-				ReceiverID string `json:"receiver_id"`
-			}{{ReceiverID: receiver1.ID.String()}, {ReceiverID: receiver2.ID.String()}},
+			Invites:  []InviteRecipient{{ReceiverID: receiver1.ID.String()}, {ReceiverID: receiver2.ID.String()}},
 		}
 		invites, err := boardService.CreateInvites(context.Background(), createBoardInvitesInput)
 		assert.NoError(t, err)
-		assert.Equal(t, 2, len(invites), "Expected an invites slice of length 2 to be returned, got ", len(invites))
+		assert.Equal(t, 2, len(invites.Invites), "Expected an invites slice of length 2 to be returned, got ", len(invites.Invites))
+	})
+
+	t.Run("Remind and expire board invites", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		receiver := test.NewUser()
+		mockBoardRepo.AddUser(receiver)
+		expiresAt := time.Now().Add(time.Hour)
+		invites, err := boardService.CreateInvites(context.Background(), CreateInvitesInput{
+			BoardID:   board.ID.String(),
+			SenderID:  testUser.ID.String(),
+			Invites:   []InviteRecipient{{ReceiverID: receiver.ID.String()}},
+			ExpiresAt: &expiresAt,
+		})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test invite")
+		}
+		invite := invites.Invites[0]
+
+		// Reminders are only sent once
+		count, err := boardService.RemindInvites(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+		count, err = boardService.RemindInvites(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, count)
+
+		// Overdue invites are expired and can no longer be accepted
+		expiredAt := time.Now().Add(-time.Minute)
+		invite.ExpiresAt = &expiredAt
+		mockBoardRepo.invites[invite.ID] = invite
+		expired, err := boardService.ExpireInvites(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), expired)
+		assert.Equal(t, models.InviteStatusExpired, mockBoardRepo.invites[invite.ID].Status)
+		err = boardService.UpdateInvite(context.Background(), UpdateInviteInput{
+			ID:     invite.ID.String(),
+			UserID: receiver.ID.String(),
+			Status: string(models.InviteStatusAccepted),
+		})
+		assert.ErrorIs(t, err, errInviteExpired)
+	})
+
+	t.Run("Create board invites by email", func(t *testing.T) {
+		// Setup test board
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		registered := test.NewUser()
+		mockBoardRepo.AddUser(registered)
+		unregisteredEmail := "new.colleague@example.com"
+
+		input := CreateInvitesInput{
+			BoardID:  board.ID.String(),
+			SenderID: testUser.ID.String(),
+			Invites:  []InviteRecipient{{Email: *registered.Email}, {Email: unregisteredEmail}},
+		}
+		invites, err := boardService.CreateInvites(context.Background(), input)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(invites.Invites))
+		assert.Equal(t, registered.ID, invites.Invites[0].ReceiverID)
+		assert.Equal(t, 1, len(invites.EmailInvites))
+		assert.Equal(t, unregisteredEmail, invites.EmailInvites[0].Email)
+
+		// Inviting the same email again refreshes the pending email invite
+		invites, err = boardService.CreateInvites(context.Background(), CreateInvitesInput{
+			BoardID:  board.ID.String(),
+			SenderID: testUser.ID.String(),
+			Invites:  []InviteRecipient{{Email: unregisteredEmail}},
+		})
+		assert.NoError(t, err)
+		pendingEmailInvites, _ := mockBoardRepo.ListEmailInvitesByBoard(context.Background(), board.ID, string(models.InviteStatusPending))
+		assert.Equal(t, 1, len(pendingEmailInvites))
+
+		// Recipients need either a receiver ID or a valid email
+		_, err = boardService.CreateInvites(context.Background(), CreateInvitesInput{
+			BoardID:  board.ID.String(),
+			SenderID: testUser.ID.String(),
+			Invites:  []InviteRecipient{{Email: "not-an-email"}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Access requests", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		requester := test.NewUser()
+		mockBoardRepo.AddUser(requester)
+		request, err := boardService.CreateAccessRequest(context.Background(), CreateAccessRequestInput{
+			BoardID: board.ID.String(),
+			UserID:  requester.ID.String(),
+		})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test access request")
+		}
+		assert.Equal(t, models.AccessRequestStatusPending, request.Status)
+
+		t.Run("create again while pending", func(t *testing.T) {
+			duplicate, err := boardService.CreateAccessRequest(context.Background(), CreateAccessRequestInput{
+				BoardID: board.ID.String(),
+				UserID:  requester.ID.String(),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, request.ID, duplicate.ID)
+		})
+
+		t.Run("create as member", func(t *testing.T) {
+			_, err := boardService.CreateAccessRequest(context.Background(), CreateAccessRequestInput{
+				BoardID: board.ID.String(),
+				UserID:  testUser.ID.String(),
+			})
+			assert.ErrorIs(t, err, errAlreadyMember)
+		})
+
+		t.Run("list as non-admin", func(t *testing.T) {
+			input := ListAccessRequestsInput{BoardID: board.ID.String(), UserID: requester.ID.String()}
+			_, err := boardService.ListAccessRequests(context.Background(), input)
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("list pending", func(t *testing.T) {
+			input := ListAccessRequestsInput{
+				BoardID: board.ID.String(),
+				UserID:  testUser.ID.String(),
+				Status:  string(models.AccessRequestStatusPending),
+			}
+			requests, err := boardService.ListAccessRequests(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(requests))
+			assert.Equal(t, requester.ID, requests[0].User.ID)
+		})
+
+		t.Run("approve", func(t *testing.T) {
+			approved, err := boardService.UpdateAccessRequest(context.Background(), UpdateAccessRequestInput{
+				ID:      request.ID.String(),
+				BoardID: board.ID.String(),
+				UserID:  testUser.ID.String(),
+				Status:  string(models.AccessRequestStatusApproved),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, models.AccessRequestStatusApproved, approved.Status)
+			boardWithMembers, err := boardService.GetBoardWithMembers(context.Background(), board.ID.String())
+			assert.NoError(t, err)
+			member, ok := findMember(boardWithMembers.Members, requester.ID)
+			assert.True(t, ok)
+			assert.Equal(t, string(models.RoleMember), member.Membership.Role)
+		})
+
+		t.Run("deny after approval", func(t *testing.T) {
+			_, err := boardService.UpdateAccessRequest(context.Background(), UpdateAccessRequestInput{
+				ID:      request.ID.String(),
+				BoardID: board.ID.String(),
+				UserID:  testUser.ID.String(),
+				Status:  string(models.AccessRequestStatusDenied),
+			})
+			assert.ErrorIs(t, err, errAccessRequestResolved)
+		})
+	})
+
+	t.Run("Join board through an allowed domain", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		allowedDomains := []string{"Example.com", "example.com"}
+		updatedBoard, err := boardService.UpdateBoard(context.Background(), UpdateBoardInput{
+			BoardID:        board.ID.String(),
+			UserID:         testUser.ID.String(),
+			AllowedDomains: &allowedDomains,
+		})
+		if err != nil {
+			assert.FailNow(t, "Failed to set allowed domains of test board")
+		}
+		assert.Equal(t, []string{"example.com"}, updatedBoard.AllowedDomains)
+
+		t.Run("with invalid domain", func(t *testing.T) {
+			invalidDomains := []string{"not a domain"}
+			_, err := boardService.UpdateBoard(context.Background(), UpdateBoardInput{
+				BoardID:        board.ID.String(),
+				UserID:         testUser.ID.String(),
+				AllowedDomains: &invalidDomains,
+			})
+			assert.Error(t, err)
+		})
+
+		t.Run("as unverified user", func(t *testing.T) {
+			unverified := test.NewUser()
+			mockBoardRepo.AddUser(unverified)
+			_, err := boardService.JoinBoard(context.Background(), JoinBoardInput{BoardID: board.ID.String(), UserID: unverified.ID.String()})
+			assert.ErrorIs(t, err, errDomainNotAllowed)
+		})
+
+		t.Run("as verified user from another domain", func(t *testing.T) {
+			outsider := test.NewUser(test.WithEmail("outsider@another.com"))
+			outsider.IsVerified = true
+			mockBoardRepo.AddUser(outsider)
+			_, err := boardService.JoinBoard(context.Background(), JoinBoardInput{BoardID: board.ID.String(), UserID: outsider.ID.String()})
+			assert.ErrorIs(t, err, errDomainNotAllowed)
+		})
+
+		t.Run("as verified user from allowed domain", func(t *testing.T) {
+			colleague := test.NewUser(test.WithEmail("colleague@EXAMPLE.com"))
+			colleague.IsVerified = true
+			mockBoardRepo.AddUser(colleague)
+			boardWithMembers, err := boardService.GetBoardWithMembers(context.Background(), board.ID.String())
+			if err != nil {
+				assert.FailNow(t, "Failed to get test board")
+			}
+			canJoin, err := boardService.CanJoinBoard(context.Background(), boardWithMembers, colleague.ID.String())
+			assert.NoError(t, err)
+			assert.True(t, canJoin)
+
+			boardWithMembers, err = boardService.JoinBoard(context.Background(), JoinBoardInput{BoardID: board.ID.String(), UserID: colleague.ID.String()})
+			assert.NoError(t, err)
+			member, ok := findMember(boardWithMembers.Members, colleague.ID)
+			assert.True(t, ok)
+			assert.Equal(t, string(models.RoleMember), member.Membership.Role)
+		})
+	})
+
+	t.Run("Archive board", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		archived := true
+		updatedBoard, err := boardService.UpdateBoard(context.Background(), UpdateBoardInput{
+			BoardID:  board.ID.String(),
+			UserID:   testUser.ID.String(),
+			Archived: &archived,
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, updatedBoard.ArchivedAt)
+
+		ownedBoards, err := boardService.ListOwnedBoardsWithMembers(context.Background(), testUser.ID.String())
+		assert.NoError(t, err)
+		for _, ownedBoard := range ownedBoards {
+			assert.NotEqual(t, board.ID, ownedBoard.ID, "Expected archived board to be hidden from owned boards")
+		}
+		archivedBoards, err := boardService.ListArchivedBoardsWithMembers(context.Background(), testUser.ID.String())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(archivedBoards))
+		assert.Equal(t, board.ID, archivedBoards[0].ID)
+
+		archived = false
+		updatedBoard, err = boardService.UpdateBoard(context.Background(), UpdateBoardInput{
+			BoardID:  board.ID.String(),
+			UserID:   testUser.ID.String(),
+			Archived: &archived,
+		})
+		assert.NoError(t, err)
+		assert.Nil(t, updatedBoard.ArchivedAt)
+	})
+
+	t.Run("Trash, restore, and purge board", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		err = boardService.DeleteBoard(context.Background(), DeleteBoardInput{BoardID: board.ID.String(), UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to move test board to trash")
+		}
+		_, err = boardService.GetBoardWithMembers(context.Background(), board.ID.String())
+		assert.ErrorIs(t, err, errBoardNotFound)
+		trashedBoards, err := boardService.ListTrashedBoards(context.Background(), testUser.ID.String())
+		assert.NoError(t, err)
+		_, ok := findBoard(trashedBoards, board.ID)
+		assert.True(t, ok, "Expected deleted board to be in the trash")
+
+		t.Run("restore as non-admin", func(t *testing.T) {
+			_, err := boardService.RestoreBoard(context.Background(), RestoreBoardInput{BoardID: board.ID.String(), UserID: uuid.New().String()})
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("restore", func(t *testing.T) {
+			boardWithMembers, err := boardService.RestoreBoard(context.Background(), RestoreBoardInput{BoardID: board.ID.String(), UserID: testUser.ID.String()})
+			assert.NoError(t, err)
+			assert.Equal(t, board.ID, boardWithMembers.ID)
+
+			_, err = boardService.RestoreBoard(context.Background(), RestoreBoardInput{BoardID: board.ID.String(), UserID: testUser.ID.String()})
+			assert.ErrorIs(t, err, errBoardNotInTrash)
+		})
+
+		t.Run("restore after retention period", func(t *testing.T) {
+			deletedAt := time.Now().Add(-2 * time.Hour)
+			trashedBoard := mockBoardRepo.boards[board.ID]
+			trashedBoard.DeletedAt = &deletedAt
+			mockBoardRepo.boards[board.ID] = trashedBoard
+			_, err := boardService.RestoreBoard(context.Background(), RestoreBoardInput{BoardID: board.ID.String(), UserID: testUser.ID.String()})
+			assert.ErrorIs(t, err, errTrashRetentionExpired)
+
+			trashedBoard.DeletedAt = nil
+			mockBoardRepo.boards[board.ID] = trashedBoard
+		})
+
+		t.Run("purge after retention period", func(t *testing.T) {
+			attachment := models.PostAttachment{ID: uuid.New(), PostID: uuid.New(), BoardID: board.ID, UserID: testUser.ID}
+			content := "Burndown chart"
+			err := attachmentStorage.Put(context.Background(), attachment.StorageKey(), strings.NewReader(content), int64(len(content)), "text/plain")
+			if err != nil {
+				assert.FailNow(t, "Failed to store test attachment")
+			}
+			mockBoardRepo.AddAttachment(attachment)
+			err = boardService.DeleteBoard(context.Background(), DeleteBoardInput{BoardID: board.ID.String(), UserID: testUser.ID.String()})
+			if err != nil {
+				assert.FailNow(t, "Failed to move test board to trash")
+			}
+			count, err := boardService.PurgeBoards(context.Background(), time.Hour)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(0), count)
+			_, ok := mockBoardRepo.boards[board.ID]
+			assert.True(t, ok, "Expected board within the retention period to be kept")
+			file, err := attachmentStorage.Get(context.Background(), attachment.StorageKey())
+			if assert.NoError(t, err, "Expected attachment file within the retention period to be kept") {
+				file.Close()
+			}
+
+			deletedAt := time.Now().Add(-2 * time.Hour)
+			trashedBoard := mockBoardRepo.boards[board.ID]
+			trashedBoard.DeletedAt = &deletedAt
+			mockBoardRepo.boards[board.ID] = trashedBoard
+			count, err = boardService.PurgeBoards(context.Background(), time.Hour)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), count)
+			_, ok = mockBoardRepo.boards[board.ID]
+			assert.False(t, ok, "Expected board past the retention period to be purged")
+			_, err = attachmentStorage.Get(context.Background(), attachment.StorageKey())
+			assert.ErrorIs(t, err, storage.ErrNotFound)
+		})
 	})
+
+	t.Run("Duplicate board", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		member := addTestMember(t, mockBoardRepo, board.ID)
+		postGroup := models.PostGroup{ID: uuid.New(), BoardID: board.ID, Title: "Went well", PosX: 10, PosY: 20, ZIndex: 3}
+		emptyPostGroup := models.PostGroup{ID: uuid.New(), BoardID: board.ID, Title: "To improve", PosX: 300, PosY: 20, ZIndex: 1}
+		post := models.Post{ID: uuid.New(), UserID: testUser.ID, Content: "Shipped on time", PostOrder: 1, PostGroupID: postGroup.ID}
+		mockBoardRepo.AddPostGroup(postGroup)
+		mockBoardRepo.AddPostGroup(emptyPostGroup)
+		mockBoardRepo.AddPost(post)
+
+		t.Run("as non-member", func(t *testing.T) {
+			_, err := boardService.DuplicateBoard(context.Background(), DuplicateBoardInput{BoardID: board.ID.String(), UserID: uuid.New().String()})
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("without posts", func(t *testing.T) {
+			copied, err := boardService.DuplicateBoard(context.Background(), DuplicateBoardInput{BoardID: board.ID.String(), UserID: member.ID.String()})
+			assert.NoError(t, err)
+			assert.NotEqual(t, board.ID, copied.ID)
+			assert.Equal(t, member.ID, copied.UserID)
+			assert.Equal(t, "Copy of "+*board.Name, *copied.Name)
+
+			boardWithMembers, err := boardService.GetBoardWithMembers(context.Background(), copied.ID.String())
+			assert.NoError(t, err)
+			assert.True(t, UserIsAdmin(boardWithMembers, member.ID.String()))
+			assert.False(t, UserHasAccess(boardWithMembers, testUser.ID.String()))
+
+			postGroups, _ := mockBoardRepo.ListPostGroups(context.Background(), copied.ID)
+			assert.Len(t, postGroups, 2)
+			for _, copiedGroup := range postGroups {
+				assert.NotEqual(t, postGroup.ID, copiedGroup.ID)
+				assert.NotEqual(t, emptyPostGroup.ID, copiedGroup.ID)
+				if copiedGroup.Title == postGroup.Title {
+					assert.Equal(t, postGroup.PosX, copiedGroup.PosX)
+					assert.Equal(t, postGroup.PosY, copiedGroup.PosY)
+					assert.Equal(t, postGroup.ZIndex, copiedGroup.ZIndex)
+				}
+			}
+			posts, _ := mockBoardRepo.ListPosts(context.Background(), copied.ID)
+			assert.Empty(t, posts)
+		})
+
+		t.Run("with posts as non-admin", func(t *testing.T) {
+			_, err := boardService.DuplicateBoard(context.Background(), DuplicateBoardInput{BoardID: board.ID.String(), UserID: member.ID.String(), IncludePosts: true})
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("with posts", func(t *testing.T) {
+			name := "Sprint 2 retro"
+			copied, err := boardService.DuplicateBoard(context.Background(), DuplicateBoardInput{BoardID: board.ID.String(), UserID: testUser.ID.String(), Name: &name, IncludePosts: true})
+			assert.NoError(t, err)
+			assert.Equal(t, name, *copied.Name)
+
+			posts, _ := mockBoardRepo.ListPosts(context.Background(), copied.ID)
+			if assert.Len(t, posts, 1) {
+				assert.NotEqual(t, post.ID, posts[0].ID)
+				assert.Equal(t, post.Content, posts[0].Content)
+				copiedGroup := mockBoardRepo.postGroups[posts[0].PostGroupID]
+				assert.Equal(t, copied.ID, copiedGroup.BoardID)
+				assert.Equal(t, postGroup.Title, copiedGroup.Title)
+			}
+		})
+
+		t.Run("without a name", func(t *testing.T) {
+			unnamedBoard, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+			if err != nil {
+				assert.FailNow(t, "Failed to create test board")
+			}
+			unnamedBoard.Name = nil
+			unnamedBoard.Description = nil
+			mockBoardRepo.boards[unnamedBoard.ID] = unnamedBoard
+
+			copied, err := boardService.DuplicateBoard(context.Background(), DuplicateBoardInput{BoardID: unnamedBoard.ID.String(), UserID: testUser.ID.String()})
+			assert.NoError(t, err)
+			assert.Equal(t, "Copy of board", *copied.Name)
+			assert.Equal(t, defaultBoardDescription, *copied.Description)
+		})
+
+		t.Run("keeps anonymous and hidden posts", func(t *testing.T) {
+			maskedBoard, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+			if err != nil {
+				assert.FailNow(t, "Failed to create test board")
+			}
+			maskedBoard.AnonymousPosts = true
+			maskedBoard.HidePosts = true
+			if err := mockBoardRepo.UpdateBoard(context.Background(), maskedBoard); err != nil {
+				assert.FailNow(t, "Failed to update test board")
+			}
+
+			copied, err := boardService.DuplicateBoard(context.Background(), DuplicateBoardInput{BoardID: maskedBoard.ID.String(), UserID: testUser.ID.String(), IncludePosts: true})
+			assert.NoError(t, err)
+			assert.True(t, copied.AnonymousPosts)
+			assert.True(t, copied.HidePosts)
+
+			boardWithMembers, err := boardService.GetBoardWithMembers(context.Background(), copied.ID.String())
+			assert.NoError(t, err)
+			assert.True(t, boardWithMembers.AnonymousPosts)
+			assert.True(t, boardWithMembers.HidePosts)
+		})
+	})
+
+	t.Run("Board templates", func(t *testing.T) {
+		startStopContinue := builtInTemplates[0]
+
+		t.Run("list built-in templates", func(t *testing.T) {
+			templates, err := boardService.ListTemplates(context.Background(), testUser.ID.String())
+			assert.NoError(t, err)
+			assert.Len(t, templates, len(builtInTemplates))
+		})
+
+		t.Run("create board from built-in template", func(t *testing.T) {
+			templateID := startStopContinue.ID.String()
+			board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String(), TemplateID: &templateID})
+			assert.NoError(t, err)
+			assert.Equal(t, startStopContinue.Description, *board.Description)
+			titles := []string{}
+			for _, postGroup := range mockBoardRepo.postGroups {
+				if postGroup.BoardID == board.ID {
+					titles = append(titles, postGroup.Title)
+				}
+			}
+			assert.ElementsMatch(t, []string{"Start", "Stop", "Continue"}, titles)
+		})
+
+		t.Run("create board from unknown template", func(t *testing.T) {
+			templateID := uuid.New().String()
+			_, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String(), TemplateID: &templateID})
+			assert.ErrorIs(t, err, errTemplateNotFound)
+		})
+
+		t.Run("save, use, and delete user template", func(t *testing.T) {
+			board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+			if err != nil {
+				assert.FailNow(t, "Failed to create test board")
+			}
+			mockBoardRepo.AddPostGroup(models.PostGroup{ID: uuid.New(), BoardID: board.ID, Title: "Kudos", PosX: 40, PosY: 80, ZIndex: 2})
+
+			template, err := boardService.CreateTemplate(context.Background(), CreateTemplateInput{BoardID: board.ID.String(), UserID: testUser.ID.String(), Name: "Team retro"})
+			assert.NoError(t, err)
+			assert.Equal(t, []models.TemplatePostGroup{{Title: "Kudos", PosX: 40, PosY: 80, ZIndex: 2}}, template.PostGroups)
+			templates, err := boardService.ListTemplates(context.Background(), testUser.ID.String())
+			assert.NoError(t, err)
+			assert.Len(t, templates, len(builtInTemplates)+1)
+
+			otherUser := uuid.New()
+			templateID := template.ID.String()
+			_, err = boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: otherUser.String(), TemplateID: &templateID})
+			assert.ErrorIs(t, err, errTemplateNotFound)
+			_, err = boardService.CreateTemplate(context.Background(), CreateTemplateInput{BoardID: board.ID.String(), UserID: otherUser.String(), Name: "Stolen retro"})
+			assert.ErrorIs(t, err, errUnauthorized)
+
+			newBoard, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String(), TemplateID: &templateID})
+			assert.NoError(t, err)
+			for _, postGroup := range mockBoardRepo.postGroups {
+				if postGroup.BoardID == newBoard.ID {
+					assert.Equal(t, "Kudos", postGroup.Title)
+				}
+			}
+
+			err = boardService.DeleteTemplate(context.Background(), DeleteTemplateInput{TemplateID: templateID, UserID: otherUser.String()})
+			assert.ErrorIs(t, err, errTemplateNotFound)
+			err = boardService.DeleteTemplate(context.Background(), DeleteTemplateInput{TemplateID: startStopContinue.ID.String(), UserID: testUser.ID.String()})
+			assert.ErrorIs(t, err, errUnauthorized)
+			err = boardService.DeleteTemplate(context.Background(), DeleteTemplateInput{TemplateID: templateID, UserID: testUser.ID.String()})
+			assert.NoError(t, err)
+			_, ok := mockBoardRepo.templates[template.ID]
+			assert.False(t, ok, "Expected template to be deleted")
+		})
+	})
+
+	t.Run("Action items", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		member := addTestMember(t, mockBoardRepo, board.ID)
+		postGroup := models.PostGroup{ID: uuid.New(), BoardID: board.ID, Title: "To improve"}
+		post := models.Post{ID: uuid.New(), UserID: testUser.ID, Content: "Flaky tests", PostGroupID: postGroup.ID}
+		mockBoardRepo.AddPostGroup(postGroup)
+		mockBoardRepo.AddPost(post)
+		input := CreateActionItemInput{
+			BoardID:    board.ID.String(),
+			UserID:     testUser.ID.String(),
+			PostID:     post.ID.String(),
+			AssigneeID: member.ID.String(),
+			Title:      "Quarantine flaky tests",
+			DueDate:    "2030-01-31",
+		}
+
+		t.Run("create as non-member", func(t *testing.T) {
+			input := input
+			input.UserID = uuid.New().String()
+			_, err := boardService.CreateActionItem(context.Background(), input)
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("assign to non-member", func(t *testing.T) {
+			input := input
+			input.AssigneeID = uuid.New().String()
+			_, err := boardService.CreateActionItem(context.Background(), input)
+			assert.ErrorIs(t, err, errAssigneeNotMember)
+		})
+
+		t.Run("link to a post on another board", func(t *testing.T) {
+			input := input
+			input.PostID = uuid.New().String()
+			_, err := boardService.CreateActionItem(context.Background(), input)
+			assert.ErrorIs(t, err, errPostNotOnBoard)
+		})
+
+		t.Run("create with invalid due date", func(t *testing.T) {
+			input := input
+			input.DueDate = "31/01/2030"
+			_, err := boardService.CreateActionItem(context.Background(), input)
+			assert.ErrorIs(t, err, errInvalidDueDate)
+		})
+
+		t.Run("create, list, update, and delete", func(t *testing.T) {
+			item, err := boardService.CreateActionItem(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, models.ActionItemStatusOpen, item.Status)
+			assert.Equal(t, &post.ID, item.PostID)
+			assert.Equal(t, &member.ID, item.AssigneeID)
+			if assert.NotNil(t, item.DueDate) {
+				assert.Equal(t, "2030-01-31", item.DueDate.Format(time.DateOnly))
+			}
+
+			items, err := boardService.ListActionItems(context.Background(), ListActionItemsInput{BoardID: board.ID.String(), UserID: member.ID.String()})
+			assert.NoError(t, err)
+			assert.Len(t, items, 1)
+			_, err = boardService.ListActionItems(context.Background(), ListActionItemsInput{BoardID: board.ID.String(), UserID: uuid.New().String()})
+			assert.ErrorIs(t, err, errUnauthorized)
+
+			assigned, err := boardService.ListAssignedActionItems(context.Background(), member.ID.String())
+			assert.NoError(t, err)
+			if assert.Len(t, assigned, 1) {
+				assert.Equal(t, item.ID, assigned[0].ID)
+				assert.Equal(t, board.Name, assigned[0].BoardName)
+			}
+
+			done := string(models.ActionItemStatusDone)
+			noDueDate := ""
+			updated, err := boardService.UpdateActionItem(context.Background(), UpdateActionItemInput{
+				ID:      item.ID.String(),
+				UserID:  member.ID.String(),
+				Status:  &done,
+				DueDate: &noDueDate,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, models.ActionItemStatusDone, updated.Status)
+			assert.Nil(t, updated.DueDate)
+			assert.Equal(t, input.Title, updated.Title)
+
+			_, err = boardService.DeleteActionItem(context.Background(), DeleteActionItemInput{ID: item.ID.String(), UserID: uuid.New().String()})
+			assert.ErrorIs(t, err, errActionItemNotFound)
+			deleted, err := boardService.DeleteActionItem(context.Background(), DeleteActionItemInput{ID: item.ID.String(), UserID: testUser.ID.String()})
+			assert.NoError(t, err)
+			assert.Equal(t, board.ID, deleted.BoardID)
+			assigned, err = boardService.ListAssignedActionItems(context.Background(), member.ID.String())
+			assert.NoError(t, err)
+			assert.Empty(t, assigned)
+		})
+
+		t.Run("update as viewer", func(t *testing.T) {
+			item, err := boardService.CreateActionItem(context.Background(), input)
+			if err != nil {
+				assert.FailNow(t, "Failed to create test action item")
+			}
+			viewer := addTestMember(t, mockBoardRepo, board.ID)
+			for id, membership := range mockBoardRepo.boardMemberships {
+				if membership.UserID == viewer.ID {
+					membership.Role = models.RoleViewer
+					mockBoardRepo.boardMemberships[id] = membership
+				}
+			}
+			title := "Skip the tests"
+			_, err = boardService.UpdateActionItem(context.Background(), UpdateActionItemInput{ID: item.ID.String(), UserID: viewer.ID.String(), Title: &title})
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+	})
+
+	t.Run("Tag catalog", func(t *testing.T) {
+		board, err := boardService.CreateBoard(context.Background(), CreateBoardInput{UserID: testUser.ID.String()})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		member := addTestMember(t, mockBoardRepo, board.ID)
+		input := CreateTagInput{
+			BoardID: board.ID.String(),
+			UserID:  testUser.ID.String(),
+			Name:    "Process",
+			Color:   "#FFD700",
+		}
+
+		t.Run("create as non-admin", func(t *testing.T) {
+			input := input
+			input.UserID = member.ID.String()
+			_, err := boardService.CreateTag(context.Background(), input)
+			assert.ErrorIs(t, err, errUnauthorized)
+		})
+
+		t.Run("create, list, and delete", func(t *testing.T) {
+			tag, err := boardService.CreateTag(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, board.ID, tag.BoardID)
+			assert.Equal(t, input.Name, tag.Name)
+
+			_, err = boardService.CreateTag(context.Background(), input)
+			assert.ErrorIs(t, err, errDuplicateTag)
+
+			tags, err := boardService.ListTags(context.Background(), ListTagsInput{BoardID: board.ID.String(), UserID: member.ID.String()})
+			assert.NoError(t, err)
+			assert.Len(t, tags, 1)
+			_, err = boardService.ListTags(context.Background(), ListTagsInput{BoardID: board.ID.String(), UserID: uuid.New().String()})
+			assert.ErrorIs(t, err, errUnauthorized)
+
+			deleteInput := DeleteTagInput{BoardID: board.ID.String(), UserID: member.ID.String(), TagID: tag.ID.String()}
+			_, err = boardService.DeleteTag(context.Background(), deleteInput)
+			assert.ErrorIs(t, err, errUnauthorized)
+			deleteInput.UserID = testUser.ID.String()
+			_, err = boardService.DeleteTag(context.Background(), deleteInput)
+			assert.NoError(t, err)
+			_, err = boardService.DeleteTag(context.Background(), deleteInput)
+			assert.ErrorIs(t, err, errTagNotFound)
+		})
+
+		t.Run("create with invalid color", func(t *testing.T) {
+			input := input
+			input.Color = "gold"
+			_, err := boardService.CreateTag(context.Background(), input)
+			assert.Error(t, err)
+		})
+	})
+}
+
+func addTestMember(t *testing.T, repo *mockRepository, boardID uuid.UUID) models.User {
+	member := test.NewUser()
+	repo.AddUser(member)
+	membership := models.BoardMembership{
+		ID:      uuid.New(),
+		BoardID: boardID,
+		UserID:  member.ID,
+		Role:    models.RoleMember,
+	}
+	if err := repo.CreateMembership(context.Background(), membership); err != nil {
+		assert.FailNow(t, "Failed to create test membership")
+	}
+	return member
+}
+
+func findBoard(boards []models.Board, boardID uuid.UUID) (models.Board, bool) {
+	for _, board := range boards {
+		if board.ID == boardID {
+			return board, true
+		}
+	}
+	return models.Board{}, false
 }
 
 func getFirstBoard(m map[uuid.UUID]models.Board) (models.Board, bool) {
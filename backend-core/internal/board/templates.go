@@ -0,0 +1,66 @@
+package board
+
+import (
+	"github.com/Wave-95/boards/backend-core/internal/models"
+	"github.com/google/uuid"
+)
+
+const (
+	// templateColumnWidth is the horizontal distance between the post groups of a built-in template. It leaves
+	// room for a post to sit in each group.
+	templateColumnWidth = 325
+	// templateOffset is the distance from the top left corner of the board to the first post group.
+	templateOffset = 50
+)
+
+// builtInTemplates are the templates that are available to every user. Their IDs are fixed so that clients can
+// refer to them across deployments.
+var builtInTemplates = []models.BoardTemplate{
+	newBuiltInTemplate(
+		"0f92b703-cbef-4125-9bd3-c9590de944d5",
+		"Start, Stop, Continue",
+		"Decide what the team should start doing, stop doing, and keep doing",
+		"Start", "Stop", "Continue",
+	),
+	newBuiltInTemplate(
+		"3d27cbee-62d6-4bbc-a3d5-8fd3f9c5ec4a",
+		"4Ls",
+		"Reflect on what the team liked, learned, lacked, and longed for",
+		"Liked", "Learned", "Lacked", "Longed for",
+	),
+	newBuiltInTemplate(
+		"05e74ca9-be6d-4468-aa8d-e284c8600d95",
+		"Mad, Sad, Glad",
+		"Share what made the team mad, sad, and glad",
+		"Mad", "Sad", "Glad",
+	),
+}
+
+// newBuiltInTemplate creates a built-in template that lays out a post group for each title in a single row.
+func newBuiltInTemplate(id string, name string, description string, titles ...string) models.BoardTemplate {
+	postGroups := make([]models.TemplatePostGroup, len(titles))
+	for i, title := range titles {
+		postGroups[i] = models.TemplatePostGroup{
+			Title:  title,
+			PosX:   templateOffset + i*templateColumnWidth,
+			PosY:   templateOffset,
+			ZIndex: i + 1,
+		}
+	}
+	return models.BoardTemplate{
+		ID:          uuid.MustParse(id),
+		Name:        name,
+		Description: description,
+		PostGroups:  postGroups,
+	}
+}
+
+// getBuiltInTemplate returns the built-in template with the given ID.
+func getBuiltInTemplate(templateID uuid.UUID) (models.BoardTemplate, bool) {
+	for _, template := range builtInTemplates {
+		if template.ID == templateID {
+			return template, true
+		}
+	}
+	return models.BoardTemplate{}, false
+}
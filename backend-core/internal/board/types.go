@@ -11,16 +11,113 @@ import (
 type CreateBoardInput struct {
 	Name        *string `json:"name" validate:"omitempty,required,min=3,max=20"`
 	Description *string `json:"description" validate:"omitempty,required,min=3,max=100"`
+	TemplateID  *string `json:"template_id" validate:"omitempty,uuid"`
 	UserID      string
 }
 
+// UpdateBoardInput defines the data structure for an update board request.
+type UpdateBoardInput struct {
+	BoardID     string
+	UserID      string
+	Name        *string `json:"name" validate:"omitempty,required,min=3,max=20"`
+	Description *string `json:"description" validate:"omitempty,required,min=3,max=100"`
+	// AllowedDomains replaces the email domains whose verified users can join the board without an invite.
+	AllowedDomains *[]string `json:"allowed_domains" validate:"omitempty,max=20,dive,fqdn"`
+	// Archived hides the board from board listings when true and brings it back when false.
+	Archived *bool `json:"archived"`
+	// VoteLimit sets the number of votes each member can cast on the board.
+	VoteLimit *int `json:"vote_limit" validate:"omitempty,min=1,max=50"`
+	// HideVotes keeps vote counts hidden until voting closes when true.
+	HideVotes *bool `json:"hide_votes"`
+	// AnonymousPosts hides the author of each post from everyone but the author when true.
+	AnonymousPosts *bool `json:"anonymous_posts"`
+	// HidePosts hides the contents of each post from everyone but the author until the posts are revealed.
+	HidePosts *bool `json:"hide_posts"`
+}
+
+// DeleteBoardInput defines the data structure for a delete board request.
+type DeleteBoardInput struct {
+	BoardID string
+	UserID  string
+}
+
+// RestoreBoardInput defines the data structure for a request to restore a board from the trash.
+type RestoreBoardInput struct {
+	BoardID string
+	UserID  string
+}
+
+// DuplicateBoardInput defines the data structure for a request to duplicate a board.
+type DuplicateBoardInput struct {
+	BoardID      string
+	UserID       string
+	Name         *string `json:"name" validate:"omitempty,required,min=3,max=20"`
+	IncludePosts bool    `json:"include_posts"`
+}
+
+// CreateTemplateInput defines the data structure for a request to save a board as a template.
+type CreateTemplateInput struct {
+	BoardID     string `json:"board_id" validate:"required,uuid"`
+	Name        string `json:"name" validate:"required,min=3,max=50"`
+	Description string `json:"description" validate:"max=100"`
+	UserID      string
+}
+
+// DeleteTemplateInput defines the data structure for a request to delete a saved template.
+type DeleteTemplateInput struct {
+	TemplateID string
+	UserID     string
+}
+
+// UpdateMembershipInput defines the data structure for a request to change a board member's role.
+type UpdateMembershipInput struct {
+	BoardID  string
+	UserID   string
+	MemberID string
+	Role     string `json:"role" validate:"required,oneof=VIEWER COMMENTER EDITOR MEMBER ADMIN"`
+}
+
+// DeleteMembershipInput defines the data structure for a request to remove a member from a board. A member
+// leaves a board when the member ID is the same as the requesting user ID.
+type DeleteMembershipInput struct {
+	BoardID  string
+	UserID   string
+	MemberID string
+}
+
+// TransferOwnershipInput defines the data structure for a request to transfer board ownership to another member.
+type TransferOwnershipInput struct {
+	BoardID    string
+	UserID     string
+	NewOwnerID string `json:"user_id" validate:"required,uuid"`
+}
+
+// UpdatePhaseInput defines the data structure for a request to move a board to another retro phase.
+type UpdatePhaseInput struct {
+	BoardID string
+	UserID  string
+	Phase   string `json:"phase" validate:"required,oneof=BRAINSTORM GROUP VOTE DISCUSS DONE"`
+}
+
+// RevealPostsInput defines the data structure for a request to reveal the hidden posts of a board.
+type RevealPostsInput struct {
+	BoardID string
+	UserID  string
+}
+
 // CreateInvitesInput defines the data structure for a create board invites request.
 type CreateInvitesInput struct {
-	BoardID  string
-	SenderID string
-	Invites  []struct {
-		ReceiverID string `json:"receiver_id"`
-	} `json:"invites"`
+	BoardID   string
+	SenderID  string
+	Invites   []InviteRecipient `json:"invites" validate:"dive"`
+	ExpiresAt *time.Time        `json:"expires_at" validate:"omitempty,gt"`
+}
+
+// InviteRecipient defines a single recipient of a create board invites request. A recipient is
+// addressed either by user ID or by email, which does not need to belong to a user yet.
+type InviteRecipient struct {
+	ReceiverID string `json:"receiver_id" validate:"required_without=Email"`
+	Email      string `json:"email" validate:"omitempty,email"`
 }
 
 // UpdateInviteInput defines the data structure for a update invite request.
@@ -43,15 +140,151 @@ type ListInvitesByReceiverInput struct {
 	Status     string
 }
 
+// CreateInviteLinkInput defines the data structure for a create invite link request. Invite links cannot grant
+// admin privileges.
+type CreateInviteLinkInput struct {
+	BoardID   string
+	UserID    string
+	Role      string     `json:"role" validate:"omitempty,oneof=VIEWER COMMENTER EDITOR MEMBER"`
+	MaxUses   *int       `json:"max_uses" validate:"omitempty,min=1"`
+	ExpiresAt *time.Time `json:"expires_at" validate:"omitempty,gt"`
+}
+
+// ListInviteLinksInput defines the input params for listing the active invite links of a board.
+type ListInviteLinksInput struct {
+	BoardID string
+	UserID  string
+}
+
+// RevokeInviteLinkInput defines the data structure for a revoke invite link request.
+type RevokeInviteLinkInput struct {
+	BoardID string
+	UserID  string
+	LinkID  string
+}
+
+// RedeemInviteLinkInput defines the data structure for a redeem invite link request.
+type RedeemInviteLinkInput struct {
+	Token  string
+	UserID string
+}
+
+// JoinBoardInput defines the data structure for joining a board through one of its allowed domains.
+type JoinBoardInput struct {
+	BoardID string
+	UserID  string
+}
+
+// CreateAccessRequestInput defines the data structure for a request to access a board.
+type CreateAccessRequestInput struct {
+	BoardID string
+	UserID  string
+}
+
+// ListAccessRequestsInput defines the input params for listing the access requests of a board.
+type ListAccessRequestsInput struct {
+	BoardID string
+	UserID  string
+	Status  string
+}
+
+// UpdateAccessRequestInput defines the data structure for approving or denying an access request.
+type UpdateAccessRequestInput struct {
+	ID      string
+	BoardID string
+	UserID  string
+	Status  string `json:"status" validate:"required,oneof=APPROVED DENIED"`
+}
+
+// CreateActionItemInput defines the data structure for a request to create an action item on a board. The due
+// date is a calendar date in YYYY-MM-DD format.
+type CreateActionItemInput struct {
+	BoardID    string
+	UserID     string
+	PostID     string `json:"post_id" validate:"omitempty,uuid"`
+	AssigneeID string `json:"assignee_id" validate:"omitempty,uuid"`
+	Title      string `json:"title" validate:"required,max=255"`
+	DueDate    string `json:"due_date"`
+}
+
+// ListActionItemsInput defines the input params for listing the action items of a board.
+type ListActionItemsInput struct {
+	BoardID string
+	UserID  string
+}
+
+// UpdateActionItemInput defines the data structure for a request to update an action item. Fields that are nil
+// are left unchanged, while an empty assignee ID or due date unassigns the action item or clears its due date.
+type UpdateActionItemInput struct {
+	ID         string
+	UserID     string
+	AssigneeID *string `json:"assignee_id"`
+	Title      *string `json:"title" validate:"omitempty,required,max=255"`
+	DueDate    *string `json:"due_date"`
+	Status     *string `json:"status" validate:"omitempty,oneof=OPEN DONE"`
+}
+
+// DeleteActionItemInput defines the data structure for a request to delete an action item.
+type DeleteActionItemInput struct {
+	ID     string
+	UserID string
+}
+
+// CreateTagInput defines the data structure for a request to add a tag to the tag catalog of a board.
+type CreateTagInput struct {
+	BoardID string
+	UserID  string
+	Name    string `json:"name" validate:"required,max=50"`
+	Color   string `json:"color" validate:"required,min=7,max=7"`
+}
+
+// ListTagsInput defines the input params for listing the tag catalog of a board.
+type ListTagsInput struct {
+	BoardID string
+	UserID  string
+}
+
+// DeleteTagInput defines the data structure for a request to remove a tag from the tag catalog of a board.
+type DeleteTagInput struct {
+	BoardID string
+	UserID  string
+	TagID   string
+}
+
+// ActionItemWithBoardDTO is a formatted response representing an action item along with the name of its board.
+type ActionItemWithBoardDTO struct {
+	models.ActionItem
+	BoardName *string `json:"board_name"`
+}
+
 // BoardWithMembersDTO is a formatted response representing a board and its associated members.
 type BoardWithMembersDTO struct {
-	ID          uuid.UUID   `json:"id"`
-	Name        *string     `json:"name"`
-	Description *string     `json:"description"`
-	UserID      uuid.UUID   `json:"user_id"`
-	Members     []MemberDTO `json:"members"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	ID             uuid.UUID         `json:"id"`
+	Name           *string           `json:"name"`
+	Description    *string           `json:"description"`
+	UserID         uuid.UUID         `json:"user_id"`
+	Members        []MemberDTO       `json:"members"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+	AllowedDomains []string          `json:"allowed_domains"`
+	ArchivedAt     *time.Time        `json:"archived_at"`
+	Phase          models.BoardPhase `json:"phase"`
+	VoteLimit      int               `json:"vote_limit"`
+	HideVotes      bool              `json:"hide_votes"`
+	AnonymousPosts bool              `json:"anonymous_posts"`
+	HidePosts      bool              `json:"hide_posts"`
+}
+
+// VotesHidden reports whether the vote counts of the board are hidden because voting has not closed yet.
+func (b BoardWithMembersDTO) VotesHidden() bool {
+	return b.HideVotes && !b.Phase.VotingClosed()
+}
+
+// CreateInvitesDTO is a formatted response representing the board invites and email invites created by a
+// create board invites request.
+type CreateInvitesDTO struct {
+	Invites      []models.Invite      `json:"invites"`
+	EmailInvites []models.EmailInvite `json:"email_invites"`
 }
 
 // MemberDTO is a formatted response representing a board member's details.
@@ -71,6 +304,16 @@ type MembershipDTO struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// AccessRequestWithUserDTO is a formatted response representing an access request along with the requesting user's details.
+type AccessRequestWithUserDTO struct {
+	ID        uuid.UUID   `json:"id"`
+	BoardID   uuid.UUID   `json:"board_id"`
+	User      models.User `json:"user"`
+	Status    string      `json:"status"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
 // InviteWithBoardAndSenderDTO is a formatted response representing a board invite along with its associated board and sender details.
 type InviteWithBoardAndSenderDTO struct {
 	ID         uuid.UUID    `json:"id"`
@@ -80,6 +323,7 @@ type InviteWithBoardAndSenderDTO struct {
 	Status     string       `json:"status"`
 	CreatedAt  time.Time    `json:"created_at"`
 	UpdatedAt  time.Time    `json:"updated_at"`
+	ExpiresAt  *time.Time   `json:"expires_at"`
 }
 
 // InviteWithReceiverDTO is a formatted response representing a board invite along with its receiver details.
@@ -91,15 +335,18 @@ type InviteWithReceiverDTO struct {
 	Status    string      `json:"status"`
 	CreatedAt time.Time   `json:"created_at"`
 	UpdatedAt time.Time   `json:"updated_at"`
+	ExpiresAt *time.Time  `json:"expires_at"`
 }
 
 // InviteWithSenderReceiverDTO is a formatted response representing a board invite along with its sender and receiver details.
 type InviteWithSenderReceiverDTO struct {
-	ID        uuid.UUID   `json:"id"`
-	BoardID   uuid.UUID   `json:"board_id"`
-	Sender    models.User `json:"sender"`
-	Receiver  models.User `json:"receiver"`
-	Status    string      `json:"status"`
-	CreatedAt time.Time   `json:"created_at"`
-	UpdatedAt time.Time   `json:"updated_at"`
+	ID         uuid.UUID   `json:"id"`
+	BoardID    uuid.UUID   `json:"board_id"`
+	Sender     models.User `json:"sender"`
+	Receiver   models.User `json:"receiver"`
+	Status     string      `json:"status"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+	ExpiresAt  *time.Time  `json:"expires_at"`
+	RemindedAt *time.Time  `json:"reminded_at"`
 }
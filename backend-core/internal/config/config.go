@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/joho/godotenv"
@@ -29,18 +30,43 @@ const (
 	keyJWTSecret       = "JWT_SIGNING_KEY"
 	keyJWTExpiration   = "JWT_EXPIRATION"
 	keyInternalNetwork = "INTERNAL_NETWORK"
+	keyServiceToken    = "SERVICE_TOKEN"
+
+	keyTrashRetention         = "BOARD_TRASH_RETENTION"
+	defaultTrashRetention     = 30 * 24 * time.Hour
+	keyTrashPurgeInterval     = "BOARD_TRASH_PURGE_INTERVAL"
+	defaultTrashPurgeInterval = time.Hour
+
+	keyStorageDriver   = "STORAGE_DRIVER"
+	keyStorageLocalDir = "STORAGE_LOCAL_DIR"
+	defaultStorageDir  = "uploads"
+	keyS3Endpoint      = "S3_ENDPOINT"
+	keyS3Region        = "S3_REGION"
+	keyS3Bucket        = "S3_BUCKET"
+	keyS3AccessKey     = "S3_ACCESS_KEY"
+	keyS3SecretKey     = "S3_SECRET_KEY"
 
 	valEnvDev = "DEVELOPMENT"
 )
 
+const (
+	// StorageDriverLocal stores attachments on the local filesystem.
+	StorageDriverLocal = "local"
+	// StorageDriverS3 stores attachments in an S3 compatible object store.
+	StorageDriverS3 = "s3"
+)
+
 // Config encapsulates all the server configuration values.
 type Config struct {
 	ServerPort    string
 	JwtSecret     string
 	JwtExpiration int
+	ServiceToken  string
 	DB            DatabaseConfig
 	Rdb           RedisConfig
 	Amqp          AmqpConfig
+	Trash         TrashConfig
+	Storage       StorageConfig
 }
 
 // Load looks for config values in environment table and .env files (development), and sets them
@@ -70,9 +96,23 @@ func Load(file string) (*Config, error) {
 		return nil, err
 	}
 
+	trashConfig, err := getTrashConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	storageConfig, err := getStorageConfig()
+	if err != nil {
+		return nil, err
+	}
+
 	serverPort := os.Getenv(keyServerPort)
 	jwtSecret := os.Getenv(keyJWTSecret)
 	jwtExpirationStr := os.Getenv(keyJWTExpiration)
+	serviceToken := os.Getenv(keyServiceToken)
+	if serviceToken == "" {
+		return nil, fmt.Errorf("missing %s env var", keyServiceToken)
+	}
 
 	jwtExpiration, err := strconv.Atoi(jwtExpirationStr)
 	if err != nil {
@@ -86,6 +126,9 @@ func Load(file string) (*Config, error) {
 		Amqp:          amqpConfig,
 		JwtSecret:     jwtSecret,
 		JwtExpiration: jwtExpiration,
+		ServiceToken:  serviceToken,
+		Trash:         trashConfig,
+		Storage:       storageConfig,
 	}, nil
 }
 
@@ -192,3 +235,90 @@ func getAmqpConfig() (AmqpConfig, error) {
 
 	return cfg, nil
 }
+
+// TrashConfig represents the config for purging boards that have been moved to the trash.
+type TrashConfig struct {
+	Retention     time.Duration
+	PurgeInterval time.Duration
+}
+
+// getTrashConfig looks for the trash env vars and falls back to the defaults when they are not set.
+func getTrashConfig() (TrashConfig, error) {
+	retention, err := getDuration(keyTrashRetention, defaultTrashRetention)
+	if err != nil {
+		return TrashConfig{}, err
+	}
+	purgeInterval, err := getDuration(keyTrashPurgeInterval, defaultTrashPurgeInterval)
+	if err != nil {
+		return TrashConfig{}, err
+	}
+	return TrashConfig{Retention: retention, PurgeInterval: purgeInterval}, nil
+}
+
+// StorageConfig represents the config for storing post attachments. Attachments are stored in LocalDir unless
+// the S3 driver is used.
+type StorageConfig struct {
+	Driver   string
+	LocalDir string
+	S3       S3Config
+}
+
+// S3Config represents the config for connecting to an S3 compatible object store.
+type S3Config struct {
+	Endpoint  string `validate:"required,url"`
+	Region    string `validate:"required"`
+	Bucket    string `validate:"required"`
+	AccessKey string `validate:"required"`
+	SecretKey string `validate:"required"`
+}
+
+// Validate checks that all values are properly loaded into the s3 config.
+func (config *S3Config) Validate() error {
+	validate := validator.New()
+	if err := validate.Struct(config); err != nil {
+		return fmt.Errorf("missing s3 env var: %v", err)
+	}
+	return nil
+}
+
+// getStorageConfig looks for the storage env vars and falls back to local storage when no driver is set.
+func getStorageConfig() (StorageConfig, error) {
+	cfg := StorageConfig{
+		Driver:   os.Getenv(keyStorageDriver),
+		LocalDir: os.Getenv(keyStorageLocalDir),
+	}
+	if cfg.LocalDir == "" {
+		cfg.LocalDir = defaultStorageDir
+	}
+	switch cfg.Driver {
+	case "", StorageDriverLocal:
+		cfg.Driver = StorageDriverLocal
+	case StorageDriverS3:
+		cfg.S3 = S3Config{
+			Endpoint:  os.Getenv(keyS3Endpoint),
+			Region:    os.Getenv(keyS3Region),
+			Bucket:    os.Getenv(keyS3Bucket),
+			AccessKey: os.Getenv(keyS3AccessKey),
+			SecretKey: os.Getenv(keyS3SecretKey),
+		}
+		if err := cfg.S3.Validate(); err != nil {
+			return StorageConfig{}, err
+		}
+	default:
+		return StorageConfig{}, fmt.Errorf("invalid %s value: %v", keyStorageDriver, cfg.Driver)
+	}
+	return cfg, nil
+}
+
+// getDuration parses a positive duration env var such as "720h".
+func getDuration(key string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid %s value: %v", key, value)
+	}
+	return duration, nil
+}
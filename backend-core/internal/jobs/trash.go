@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Wave-95/boards/backend-core/internal/board"
+)
+
+// TrashJob periodically purges the boards that have been in the trash for longer than the retention period.
+type TrashJob struct {
+	boardService board.Service
+	retention    time.Duration
+	interval     time.Duration
+}
+
+// NewTrashJob returns a job that purges the boards that have been in the trash for longer than the retention
+// period every interval.
+func NewTrashJob(boardService board.Service, retention time.Duration, interval time.Duration) TrashJob {
+	return TrashJob{boardService: boardService, retention: retention, interval: interval}
+}
+
+// Run is a blocking operation that runs the job right away and then on every interval.
+func (j *TrashJob) Run() {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		if err := j.runOnce(); err != nil {
+			log.Printf("trash job: %v", err)
+		}
+		<-ticker.C
+	}
+}
+
+func (j *TrashJob) runOnce() error {
+	count, err := j.boardService.PurgeBoards(context.Background(), j.retention)
+	if err != nil {
+		return fmt.Errorf("failed to purge boards: %w", err)
+	}
+	if count > 0 {
+		log.Printf("trash job: purged %d boards", count)
+	}
+	return nil
+}
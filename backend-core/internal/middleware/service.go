@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/Wave-95/boards/backend-core/internal/endpoint"
+)
+
+const (
+	// HeaderServiceToken is the header that internal services use to send the shared service token.
+	HeaderServiceToken = "X-Service-Token"
+
+	errMsgInvalidServiceToken = "Missing or invalid service token."
+)
+
+// ServiceAuth creates a middleware function that only lets through requests carrying the shared service token
+// in the X-Service-Token header. It is used for endpoints that are called by other services rather than users.
+// If the token is missing or does not match, it will write an Unauthorized response.
+func ServiceAuth(token string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			reqToken := r.Header.Get(HeaderServiceToken)
+			if token == "" || subtle.ConstantTimeCompare([]byte(reqToken), []byte(token)) != 1 {
+				endpoint.WriteWithError(w, http.StatusUnauthorized, errMsgInvalidServiceToken)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
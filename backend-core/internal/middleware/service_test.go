@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceAuth(t *testing.T) {
+	token := "service-secret"
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("valid service token is let through", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		req := buildServiceRequest(token)
+
+		ServiceAuth(token)(testHandler).ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+
+	t.Run("missing service token returns unauthorized error", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		req := buildServiceRequest("")
+
+		ServiceAuth(token)(testHandler).ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+		assert.Contains(t, res.Body.String(), errMsgInvalidServiceToken)
+	})
+
+	t.Run("invalid service token returns unauthorized error", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		req := buildServiceRequest("wrong-secret")
+
+		ServiceAuth(token)(testHandler).ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+
+	t.Run("unconfigured service token rejects every request", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		req := buildServiceRequest("")
+
+		ServiceAuth("")(testHandler).ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func buildServiceRequest(token string) *http.Request {
+	req, _ := http.NewRequest(http.MethodPost, "/", nil)
+	if token != "" {
+		req.Header.Set(HeaderServiceToken, token)
+	}
+	return req
+}
@@ -6,26 +6,135 @@ import (
 	"github.com/google/uuid"
 )
 
-// Board defines the domain model for a board entity.
+// Board defines the domain model for a board entity. Verified users with an email in one of the
+// AllowedDomains can join the board without an invite. Archived boards are hidden from board listings, and
+// boards with a DeletedAt are in the trash until they are purged. Each member can cast up to VoteLimit votes on
+// the posts of the board, and HideVotes keeps vote counts hidden until voting closes. Boards with AnonymousPosts
+// still record the author of each post but only show it to the author. While HidePosts is set, members only see
+// the contents of their own posts until an admin reveals all posts.
 type Board struct {
-	ID          uuid.UUID `json:"id"`
-	Name        *string   `json:"name"`
-	Description *string   `json:"description"`
-	UserID      uuid.UUID `json:"user_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID             uuid.UUID  `json:"id"`
+	Name           *string    `json:"name"`
+	Description    *string    `json:"description"`
+	UserID         uuid.UUID  `json:"user_id"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	AllowedDomains []string   `json:"allowed_domains"`
+	ArchivedAt     *time.Time `json:"archived_at"`
+	DeletedAt      *time.Time `json:"deleted_at"`
+	Phase          BoardPhase `json:"phase"`
+	VoteLimit      int        `json:"vote_limit"`
+	HideVotes      bool       `json:"hide_votes"`
+	AnonymousPosts bool       `json:"anonymous_posts"`
+	HidePosts      bool       `json:"hide_posts"`
+}
+
+// DefaultVoteLimit is the number of votes each member can cast on a board when no vote limit is configured.
+const DefaultVoteLimit = 5
+
+// BoardPhase is a custom string type to represent the phase of a retro that is run on a board. A board moves
+// through the phases in order and the phase determines which changes can be made to its posts.
+type BoardPhase string
+
+const (
+	// PhaseBrainstorm represents the phase where members add, edit, and delete posts. Boards start in this phase.
+	PhaseBrainstorm BoardPhase = "BRAINSTORM"
+	// PhaseGroup represents the phase where members arrange posts into post groups. Posts can no longer be added
+	// or edited.
+	PhaseGroup BoardPhase = "GROUP"
+	// PhaseVote represents the phase where members vote on post groups.
+	PhaseVote BoardPhase = "VOTE"
+	// PhaseDiscuss represents the phase where the team discusses the post groups with the most votes.
+	PhaseDiscuss BoardPhase = "DISCUSS"
+	// PhaseDone represents a retro that has been wrapped up.
+	PhaseDone BoardPhase = "DONE"
+)
+
+// boardPhases lists the board phases in the order that a retro moves through them.
+var boardPhases = []BoardPhase{PhaseBrainstorm, PhaseGroup, PhaseVote, PhaseDiscuss, PhaseDone}
+
+// ValidBoardPhase checks if a phase is one of the board phases.
+func ValidBoardPhase(phase string) bool {
+	return phaseIndex(BoardPhase(phase)) != -1
+}
+
+// CanTransitionTo reports whether a board can move from the phase to the next phase. Boards can only move to
+// the phase right before or right after the current one, which lets a facilitator step back if needed.
+func (p BoardPhase) CanTransitionTo(next BoardPhase) bool {
+	current, target := phaseIndex(p), phaseIndex(next)
+	if current == -1 || target == -1 {
+		return false
+	}
+	return target == current+1 || target == current-1
+}
+
+// CanAddPosts reports whether posts can be created in the phase.
+func (p BoardPhase) CanAddPosts() bool {
+	return p == PhaseBrainstorm
+}
+
+// CanEditPosts reports whether the content of posts can be changed and whether posts can be deleted in the phase.
+func (p BoardPhase) CanEditPosts() bool {
+	return p == PhaseBrainstorm
+}
+
+// CanGroupPosts reports whether posts can be moved between post groups and whether post groups can be
+// rearranged, renamed, or deleted in the phase.
+func (p BoardPhase) CanGroupPosts() bool {
+	return p == PhaseBrainstorm || p == PhaseGroup
+}
+
+// CanVote reports whether members can vote on posts in the phase.
+func (p BoardPhase) CanVote() bool {
+	return p == PhaseVote
+}
+
+// VotingClosed reports whether the voting phase has ended, after which hidden vote counts are revealed.
+func (p BoardPhase) VotingClosed() bool {
+	return phaseIndex(p) > phaseIndex(PhaseVote)
+}
+
+// phaseIndex returns the position of a phase in boardPhases, or -1 if the phase does not exist.
+func phaseIndex(phase BoardPhase) int {
+	for i, p := range boardPhases {
+		if p == phase {
+			return i
+		}
+	}
+	return -1
 }
 
 // BoardMembershipRole is a custom string type to represent board membership roles.
 type BoardMembershipRole string
 
 const (
-	// RoleMember represents a role of type MEMBER.
+	// RoleViewer represents a role of type VIEWER. Viewers have read-only access to a board.
+	RoleViewer BoardMembershipRole = "VIEWER"
+	// RoleCommenter represents a role of type COMMENTER. Commenters can read a board and comment on posts.
+	RoleCommenter BoardMembershipRole = "COMMENTER"
+	// RoleEditor represents a role of type EDITOR. Editors can create, update, and delete posts.
+	RoleEditor BoardMembershipRole = "EDITOR"
+	// RoleMember represents a role of type MEMBER. Members have the same write access as editors.
 	RoleMember BoardMembershipRole = "MEMBER"
 	// RoleAdmin represents a role of type ADMIN.
 	RoleAdmin BoardMembershipRole = "ADMIN"
 )
 
+// CanWrite reports whether the role is allowed to create, update, and delete posts and post groups.
+func (r BoardMembershipRole) CanWrite() bool {
+	switch r {
+	case RoleEditor, RoleMember, RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanComment reports whether the role is allowed to comment on posts.
+func (r BoardMembershipRole) CanComment() bool {
+	return r == RoleCommenter || r.CanWrite()
+}
+
 // BoardMembership defines the domain model for a board membership entity.
 type BoardMembership struct {
 	ID        uuid.UUID           `json:"id"`
@@ -48,19 +157,28 @@ const (
 	InviteStatusIgnored InviteStatus = "IGNORED"
 	// InviteStatusCancelled represents an invite in the ignored state.
 	InviteStatusCancelled InviteStatus = "CANCELLED"
+	// InviteStatusExpired represents an invite that was not answered before it expired.
+	InviteStatusExpired InviteStatus = "EXPIRED"
+	// InviteStatusConverted represents an email invite that has been converted into a board invite after the
+	// recipient signed up.
+	InviteStatusConverted InviteStatus = "CONVERTED"
 )
 
 // ValidInviteStatusFilter checks if the status filter is valid if it is non-empty.
 func ValidInviteStatusFilter(status string) bool {
 	switch status {
-	case string(InviteStatusAccepted), string(InviteStatusIgnored), string(InviteStatusCancelled), string(InviteStatusPending):
+	case string(InviteStatusAccepted), string(InviteStatusIgnored), string(InviteStatusCancelled), string(InviteStatusPending), string(InviteStatusExpired):
 		return true
 	default:
 		return false
 	}
 }
 
-// Invite defines the domain model for a board invite entity.
+// DefaultInviteExpiry is how long a board invite stays pending when no expiry is provided.
+const DefaultInviteExpiry = 14 * 24 * time.Hour
+
+// Invite defines the domain model for a board invite entity. A nil ExpiresAt means that the invite does not
+// expire, and RemindedAt is set once a reminder has been sent ahead of the expiry.
 type Invite struct {
 	ID         uuid.UUID    `json:"id"`
 	BoardID    uuid.UUID    `json:"board_id"`
@@ -69,4 +187,135 @@ type Invite struct {
 	Status     InviteStatus `json:"status"`
 	CreatedAt  time.Time    `json:"created_at"`
 	UpdatedAt  time.Time    `json:"updated_at"`
+	ExpiresAt  *time.Time   `json:"expires_at"`
+	RemindedAt *time.Time   `json:"reminded_at"`
+}
+
+// EmailInvite defines the domain model for a board invite addressed to an email that does not belong to a
+// user yet. It is converted into an Invite once a user signs up with that email.
+type EmailInvite struct {
+	ID        uuid.UUID    `json:"id"`
+	BoardID   uuid.UUID    `json:"board_id"`
+	SenderID  uuid.UUID    `json:"sender_id"`
+	Email     string       `json:"email"`
+	Status    InviteStatus `json:"status"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// AccessRequestStatus is a custom string type to represent access request statuses.
+type AccessRequestStatus string
+
+const (
+	// AccessRequestStatusPending represents an access request waiting for an admin.
+	AccessRequestStatusPending AccessRequestStatus = "PENDING"
+	// AccessRequestStatusApproved represents an access request that granted the user a membership.
+	AccessRequestStatusApproved AccessRequestStatus = "APPROVED"
+	// AccessRequestStatusDenied represents an access request that was turned down by an admin.
+	AccessRequestStatusDenied AccessRequestStatus = "DENIED"
+)
+
+// ValidAccessRequestStatusFilter checks if the access request status filter is valid.
+func ValidAccessRequestStatusFilter(status string) bool {
+	switch status {
+	case string(AccessRequestStatusPending), string(AccessRequestStatusApproved), string(AccessRequestStatusDenied):
+		return true
+	default:
+		return false
+	}
+}
+
+// AccessRequest defines the domain model for a request from a non-member to join a board.
+type AccessRequest struct {
+	ID        uuid.UUID           `json:"id"`
+	BoardID   uuid.UUID           `json:"board_id"`
+	UserID    uuid.UUID           `json:"user_id"`
+	Status    AccessRequestStatus `json:"status"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// InviteLink defines the domain model for a shareable board invite link. A nil MaxUses or ExpiresAt means
+// that the link is not limited by usage or time.
+type InviteLink struct {
+	ID        uuid.UUID           `json:"id"`
+	BoardID   uuid.UUID           `json:"board_id"`
+	UserID    uuid.UUID           `json:"user_id"`
+	Token     string              `json:"token"`
+	Role      BoardMembershipRole `json:"role"`
+	MaxUses   *int                `json:"max_uses"`
+	UseCount  int                 `json:"use_count"`
+	ExpiresAt *time.Time          `json:"expires_at"`
+	RevokedAt *time.Time          `json:"revoked_at"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// IsActive reports whether the invite link can still be redeemed at the given time.
+func (l InviteLink) IsActive(now time.Time) bool {
+	if l.RevokedAt != nil {
+		return false
+	}
+	if l.ExpiresAt != nil && !now.Before(*l.ExpiresAt) {
+		return false
+	}
+	if l.MaxUses != nil && l.UseCount >= *l.MaxUses {
+		return false
+	}
+	return true
+}
+
+// BoardTemplate defines the domain model for a board template. Built-in templates have no UserID and are
+// available to every user, while user templates are saved from an existing board and only visible to their owner.
+type BoardTemplate struct {
+	ID          uuid.UUID           `json:"id"`
+	UserID      *uuid.UUID          `json:"user_id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	PostGroups  []TemplatePostGroup `json:"post_groups"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+// TemplatePostGroup defines the title and layout of a post group that is created from a board template.
+type TemplatePostGroup struct {
+	Title  string `json:"title"`
+	PosX   int    `json:"pos_x"`
+	PosY   int    `json:"pos_y"`
+	ZIndex int    `json:"z_index"`
+}
+
+// ActionItemStatus is a custom string type to represent action item statuses.
+type ActionItemStatus string
+
+const (
+	// ActionItemStatusOpen represents an action item that still needs to be done.
+	ActionItemStatusOpen ActionItemStatus = "OPEN"
+	// ActionItemStatusDone represents an action item that has been completed.
+	ActionItemStatusDone ActionItemStatus = "DONE"
+)
+
+// ActionItem defines the domain model for a follow-up task that comes out of a board. PostID is set when the
+// action item was created from a post, and AssigneeID is the board member who is responsible for it.
+type ActionItem struct {
+	ID         uuid.UUID        `json:"id"`
+	BoardID    uuid.UUID        `json:"board_id"`
+	PostID     *uuid.UUID       `json:"post_id"`
+	UserID     uuid.UUID        `json:"user_id"`
+	AssigneeID *uuid.UUID       `json:"assignee_id"`
+	Title      string           `json:"title"`
+	DueDate    *time.Time       `json:"due_date"`
+	Status     ActionItemStatus `json:"status"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+}
+
+// BoardTag defines the domain model for a tag in the tag catalog of a board. Posts on the board can be labeled
+// with any number of the board's tags.
+type BoardTag struct {
+	ID        uuid.UUID `json:"id"`
+	BoardID   uuid.UUID `json:"board_id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
 }
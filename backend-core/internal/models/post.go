@@ -11,17 +11,99 @@ const (
 	PostColorLightPink = "#F5E6E8"
 )
 
-// Post defines the domain model for a post entity.
+// Post defines the domain model for a post entity. ContentFormat determines how the content is rendered.
 type Post struct {
+	ID            uuid.UUID         `json:"id"`
+	UserID        uuid.UUID         `json:"user_id"`
+	Content       string            `json:"content"`
+	Color         string            `json:"color"`
+	Height        int               `json:"height"`
+	CreatedAt     time.Time         `json:"created_at,omitempty"`
+	UpdatedAt     time.Time         `json:"updated_at,omitempty"`
+	PostOrder     float64           `json:"post_order"`
+	PostGroupID   uuid.UUID         `json:"post_group_id"`
+	ContentFormat PostContentFormat `json:"content_format"`
+}
+
+// PostContentFormat is a custom string type to represent the format of the content of a post.
+type PostContentFormat string
+
+const (
+	// PostContentFormatPlain represents content that is shown as written.
+	PostContentFormatPlain PostContentFormat = "PLAIN"
+	// PostContentFormatMarkdown represents content that is written in markdown.
+	PostContentFormatMarkdown PostContentFormat = "MARKDOWN"
+)
+
+// PostVote defines the domain model for a vote cast on a post. A member can cast several votes on the same post
+// as long as they stay within the vote limit of the board.
+type PostVote struct {
+	ID        uuid.UUID `json:"id"`
+	PostID    uuid.UUID `json:"post_id"`
+	BoardID   uuid.UUID `json:"board_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PostReaction defines the domain model for an emoji reaction on a post. A user can react to a post with
+// several different emoji, but only once with each.
+type PostReaction struct {
+	ID        uuid.UUID `json:"id"`
+	PostID    uuid.UUID `json:"post_id"`
+	BoardID   uuid.UUID `json:"board_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Emoji     string    `json:"emoji"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PostTag defines the domain model for a tag from the tag catalog of a board that is attached to a post.
+type PostTag struct {
+	PostID    uuid.UUID `json:"post_id"`
+	TagID     uuid.UUID `json:"tag_id"`
+	BoardID   uuid.UUID `json:"board_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PostComment defines the domain model for a comment on a post. ParentID is set when the comment is a reply
+// to another comment on the same post.
+type PostComment struct {
+	ID        uuid.UUID  `json:"id"`
+	PostID    uuid.UUID  `json:"post_id"`
+	BoardID   uuid.UUID  `json:"board_id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	ParentID  *uuid.UUID `json:"parent_id"`
+	Content   string     `json:"content"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// PostAttachment defines the domain model for a file attached to a post.
+type PostAttachment struct {
 	ID          uuid.UUID `json:"id"`
+	PostID      uuid.UUID `json:"post_id"`
+	BoardID     uuid.UUID `json:"board_id"`
 	UserID      uuid.UUID `json:"user_id"`
-	Content     string    `json:"content"`
-	Color       string    `json:"color"`
-	Height      int       `json:"height"`
-	CreatedAt   time.Time `json:"created_at,omitempty"`
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`
-	PostOrder   float64   `json:"post_order"`
-	PostGroupID uuid.UUID `json:"post_group_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// StorageKey returns the key that the file of an attachment is stored under.
+func (a PostAttachment) StorageKey() string {
+	return a.BoardID.String() + "/" + a.ID.String()
+}
+
+// PostMention defines the domain model for a board member mentioned in a post, or in a comment on the post
+// when CommentID is set.
+type PostMention struct {
+	ID          uuid.UUID  `json:"id"`
+	PostID      uuid.UUID  `json:"post_id"`
+	CommentID   *uuid.UUID `json:"comment_id"`
+	BoardID     uuid.UUID  `json:"board_id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	MentionedBy uuid.UUID  `json:"mentioned_by"`
+	CreatedAt   time.Time  `json:"created_at"`
 }
 
 // PostGroup defines the domain model for a post group entity.
@@ -1,22 +1,45 @@
 package post
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/Wave-95/boards/backend-core/internal/board"
 	"github.com/Wave-95/boards/backend-core/internal/endpoint"
 	"github.com/Wave-95/boards/backend-core/internal/middleware"
+	"github.com/Wave-95/boards/backend-core/internal/models"
 	"github.com/Wave-95/boards/backend-core/pkg/logger"
 	"github.com/Wave-95/boards/backend-core/pkg/validator"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 )
 
 const (
 	errMsgInternalServer = "Internal server error."
 	errMsgBoardNotFound  = "Board not found."
 	errMsgInvalidBoardID = "Invalid board ID. Please pass in a boardID query param."
+	errMsgInvalidPostID  = "Invalid post ID."
+	errMsgPostNotFound   = "Post not found."
+	errMsgInvalidTagID   = "Invalid tag ID. Please pass in tagID query params in UUID format."
+
+	errMsgInvalidAttachmentID = "Invalid attachment ID."
+	errMsgAttachmentNotFound  = "Attachment not found."
+	errMsgInvalidFile         = "Invalid file. Please upload a file in the file form field."
+	errMsgAttachmentTooLarge  = "File is too large. Attachments can be at most 10 MB."
+	errMsgUnsupportedFileType = "Unsupported file type. Attachments can be PNG, JPEG, GIF, or WebP images, PDFs, or plain text files."
+	errMsgUnauthorized        = "You do not have permission to do that."
+	errMsgNotAttachmentOwner  = "Only the uploader or a board admin can delete this attachment."
 )
 
+// maxMultipartOverhead is the room that is left in an upload request for the multipart boundaries and headers
+// around the file.
+const maxMultipartOverhead = 1 << 20
+
 // API represents the struct that encapsulates all the post API dependencies.
 type API struct {
 	postService  Service
@@ -34,7 +57,8 @@ func NewAPI(postService Service, boardService board.Service, validator validator
 }
 
 // HandleListPostGroups is a handler for listing post groups that belong to a board. The handler will check
-// if the requesting user has access to the board.
+// if the requesting user has access to the board. Posts can be filtered by tag with one or more tagID query
+// params.
 func (api *API) HandleListPostGroups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := logger.FromContext(ctx)
@@ -46,6 +70,13 @@ func (api *API) HandleListPostGroups(w http.ResponseWriter, r *http.Request) {
 		endpoint.WriteWithError(w, http.StatusBadRequest, errMsgInvalidBoardID)
 		return
 	}
+	tagIDs := r.URL.Query()["tagID"]
+	for _, tagID := range tagIDs {
+		if _, err := uuid.Parse(tagID); err != nil {
+			endpoint.WriteWithError(w, http.StatusBadRequest, errMsgInvalidTagID)
+			return
+		}
+	}
 
 	boardWithMembers, err := api.boardService.GetBoardWithMembers(ctx, boardID)
 	if err != nil {
@@ -59,7 +90,15 @@ func (api *API) HandleListPostGroups(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	postGroups, err := api.postService.ListPostGroups(ctx, boardID)
+	input := ListPostGroupsInput{
+		BoardID:        boardID,
+		UserID:         userID,
+		HideVotes:      boardWithMembers.VotesHidden(),
+		TagIDs:         tagIDs,
+		AnonymousPosts: boardWithMembers.AnonymousPosts,
+		HidePosts:      boardWithMembers.HidePosts,
+	}
+	postGroups, err := api.postService.ListPostGroups(ctx, input)
 	if err != nil {
 		logger.Errorf("handler: failed to list post groups: %v", err)
 		endpoint.WriteWithError(w, http.StatusInternalServerError, errMsgInternalServer)
@@ -71,6 +110,300 @@ func (api *API) HandleListPostGroups(w http.ResponseWriter, r *http.Request) {
 	}{Result: postGroups})
 }
 
+// HandleListComments is a handler for listing the comment threads on a post. The handler will check if the
+// requesting user has access to the board that the post belongs to.
+func (api *API) HandleListComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	userID := middleware.UserIDFromContext(ctx)
+	postID := chi.URLParam(r, "postID")
+	if _, err := uuid.Parse(postID); err != nil {
+		endpoint.WriteWithError(w, http.StatusBadRequest, errMsgInvalidPostID)
+		return
+	}
+
+	if _, _, ok := api.getPostBoard(w, r, postID, userID); !ok {
+		return
+	}
+
+	comments, err := api.postService.ListComments(ctx, postID)
+	if err != nil {
+		logger.Errorf("handler: failed to list comments: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, errMsgInternalServer)
+		return
+	}
+
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Result []CommentDTO `json:"result"`
+	}{Result: comments})
+}
+
+// HandleUploadAttachment is a handler for attaching a file to a post. The file is sent as multipart form data in
+// the file field. The handler will check if the requesting user has write access to the board that the post
+// belongs to.
+func (api *API) HandleUploadAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	userID := middleware.UserIDFromContext(ctx)
+	postID := chi.URLParam(r, "postID")
+	if _, err := uuid.Parse(postID); err != nil {
+		endpoint.WriteWithError(w, http.StatusBadRequest, errMsgInvalidPostID)
+		return
+	}
+
+	_, boardWithMembers, ok := api.getPostBoard(w, r, postID, userID)
+	if !ok {
+		return
+	}
+	if !board.UserCanWrite(boardWithMembers, userID) {
+		endpoint.WriteWithError(w, http.StatusForbidden, errMsgUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, MaxAttachmentSize+maxMultipartOverhead)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			endpoint.WriteWithError(w, http.StatusRequestEntityTooLarge, errMsgAttachmentTooLarge)
+			return
+		}
+		endpoint.WriteWithError(w, http.StatusBadRequest, errMsgInvalidFile)
+		return
+	}
+	defer file.Close()
+	defer func() {
+		if err := r.MultipartForm.RemoveAll(); err != nil {
+			logger.Errorf("handler: failed to remove multipart files: %v", err)
+		}
+	}()
+
+	input := CreateAttachmentInput{
+		PostID:   postID,
+		UserID:   userID,
+		FileName: header.Filename,
+		Size:     header.Size,
+		File:     file,
+	}
+	attachment, err := api.postService.CreateAttachment(ctx, input)
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			endpoint.WriteValidationErr(w, input, err)
+		case errors.Is(err, ErrAttachmentTooLarge):
+			endpoint.WriteWithError(w, http.StatusRequestEntityTooLarge, errMsgAttachmentTooLarge)
+		case errors.Is(err, ErrUnsupportedFileType):
+			endpoint.WriteWithError(w, http.StatusUnsupportedMediaType, errMsgUnsupportedFileType)
+		default:
+			logger.Errorf("handler: failed to create attachment: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, errMsgInternalServer)
+		}
+		return
+	}
+
+	endpoint.WriteWithStatus(w, http.StatusCreated, struct {
+		Result AttachmentDTO `json:"result"`
+	}{Result: toAttachmentDTO(attachment, boardWithMembers, userID)})
+}
+
+// HandleListAttachments is a handler for listing the attachments of a post, oldest first. The handler will check
+// if the requesting user has access to the board that the post belongs to.
+func (api *API) HandleListAttachments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	userID := middleware.UserIDFromContext(ctx)
+	postID := chi.URLParam(r, "postID")
+	if _, err := uuid.Parse(postID); err != nil {
+		endpoint.WriteWithError(w, http.StatusBadRequest, errMsgInvalidPostID)
+		return
+	}
+
+	post, boardWithMembers, ok := api.getPostBoard(w, r, postID, userID)
+	if !ok {
+		return
+	}
+
+	attachmentsDTO := []AttachmentDTO{}
+	// The attachments of hidden posts stay hidden along with their content
+	if !boardWithMembers.HidePosts || post.UserID.String() == userID {
+		attachments, err := api.postService.ListAttachments(ctx, postID)
+		if err != nil {
+			logger.Errorf("handler: failed to list attachments: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, errMsgInternalServer)
+			return
+		}
+		for _, attachment := range attachments {
+			attachmentsDTO = append(attachmentsDTO, toAttachmentDTO(attachment, boardWithMembers, userID))
+		}
+	}
+
+	endpoint.WriteWithStatus(w, http.StatusOK, struct {
+		Result []AttachmentDTO `json:"result"`
+	}{Result: attachmentsDTO})
+}
+
+// HandleDownloadAttachment is a handler for downloading the file of an attachment. The handler will check if the
+// requesting user has access to the board that the attachment belongs to.
+func (api *API) HandleDownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	userID := middleware.UserIDFromContext(ctx)
+	attachment, boardWithMembers, ok := api.getAttachmentBoard(w, r, userID)
+	if !ok {
+		return
+	}
+	if boardWithMembers.HidePosts && attachment.UserID.String() != userID {
+		endpoint.WriteWithError(w, http.StatusNotFound, errMsgAttachmentNotFound)
+		return
+	}
+
+	file, err := api.postService.OpenAttachment(ctx, attachment)
+	if err != nil {
+		logger.Errorf("handler: failed to open attachment: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, errMsgInternalServer)
+		return
+	}
+	defer file.Close()
+
+	// Images are shown in the browser, every other type is saved as a file
+	disposition := "attachment"
+	if strings.HasPrefix(attachment.ContentType, "image/") {
+		disposition = "inline"
+	}
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": attachment.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, file); err != nil {
+		logger.Errorf("handler: failed to write attachment: %v", err)
+	}
+}
+
+// HandleDeleteAttachment is a handler for deleting an attachment along with its file. Only the uploader of an
+// attachment or an admin of the board can delete it.
+func (api *API) HandleDeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	userID := middleware.UserIDFromContext(ctx)
+	attachment, boardWithMembers, ok := api.getAttachmentBoard(w, r, userID)
+	if !ok {
+		return
+	}
+
+	input := DeleteAttachmentInput{
+		AttachmentID: attachment.ID.String(),
+		UserID:       userID,
+		IsAdmin:      board.UserIsAdmin(boardWithMembers, userID),
+	}
+	if _, err := api.postService.DeleteAttachment(ctx, input); err != nil {
+		switch {
+		case errors.Is(err, ErrNotAttachmentOwner):
+			endpoint.WriteWithError(w, http.StatusForbidden, errMsgNotAttachmentOwner)
+		case errors.Is(err, ErrAttachmentNotFound):
+			endpoint.WriteWithError(w, http.StatusNotFound, errMsgAttachmentNotFound)
+		default:
+			logger.Errorf("handler: failed to delete attachment: %v", err)
+			endpoint.WriteWithError(w, http.StatusInternalServerError, errMsgInternalServer)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getPostBoard returns a post along with the board that it belongs to. If the post does not exist or the user
+// does not have access to the board, an error response is written and ok is false.
+func (api *API) getPostBoard(w http.ResponseWriter, r *http.Request, postID string, userID string) (post models.Post, boardWithMembers board.BoardWithMembersDTO, ok bool) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	post, err := api.postService.GetPost(ctx, postID)
+	if err != nil {
+		if errors.Is(err, errPostNotFound) {
+			endpoint.WriteWithError(w, http.StatusNotFound, errMsgPostNotFound)
+			return post, boardWithMembers, false
+		}
+		logger.Errorf("handler: failed to get post: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, errMsgInternalServer)
+		return post, boardWithMembers, false
+	}
+	postGroup, err := api.postService.GetPostGroup(ctx, post.PostGroupID.String())
+	if err != nil {
+		logger.Errorf("handler: failed to get post group: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, errMsgInternalServer)
+		return post, boardWithMembers, false
+	}
+	boardWithMembers, err = api.boardService.GetBoardWithMembers(ctx, postGroup.BoardID.String())
+	if err != nil {
+		logger.Errorf("handler: failed to get board with members: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, errMsgInternalServer)
+		return post, boardWithMembers, false
+	}
+
+	if !board.UserHasAccess(boardWithMembers, userID) {
+		endpoint.WriteWithError(w, http.StatusNotFound, errMsgPostNotFound)
+		return post, boardWithMembers, false
+	}
+	return post, boardWithMembers, true
+}
+
+// getAttachmentBoard returns the attachment in the attachmentID URL param along with the board that it belongs
+// to. If the attachment does not exist or the user does not have access to the board, an error response is
+// written and ok is false.
+func (api *API) getAttachmentBoard(w http.ResponseWriter, r *http.Request, userID string) (attachment models.PostAttachment, boardWithMembers board.BoardWithMembersDTO, ok bool) {
+	ctx := r.Context()
+	logger := logger.FromContext(ctx)
+
+	attachmentID := chi.URLParam(r, "attachmentID")
+	if _, err := uuid.Parse(attachmentID); err != nil {
+		endpoint.WriteWithError(w, http.StatusBadRequest, errMsgInvalidAttachmentID)
+		return attachment, boardWithMembers, false
+	}
+
+	attachment, err := api.postService.GetAttachment(ctx, attachmentID)
+	if err != nil {
+		if errors.Is(err, ErrAttachmentNotFound) {
+			endpoint.WriteWithError(w, http.StatusNotFound, errMsgAttachmentNotFound)
+			return attachment, boardWithMembers, false
+		}
+		logger.Errorf("handler: failed to get attachment: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, errMsgInternalServer)
+		return attachment, boardWithMembers, false
+	}
+	boardWithMembers, err = api.boardService.GetBoardWithMembers(ctx, attachment.BoardID.String())
+	if err != nil {
+		logger.Errorf("handler: failed to get board with members: %v", err)
+		endpoint.WriteWithError(w, http.StatusInternalServerError, errMsgInternalServer)
+		return attachment, boardWithMembers, false
+	}
+
+	if !board.UserHasAccess(boardWithMembers, userID) {
+		endpoint.WriteWithError(w, http.StatusNotFound, errMsgAttachmentNotFound)
+		return attachment, boardWithMembers, false
+	}
+	return attachment, boardWithMembers, true
+}
+
+// toAttachmentDTO adds the download URL to an attachment and leaves out the uploader on anonymous boards, unless
+// the attachment was uploaded by the requesting user.
+func toAttachmentDTO(attachment models.PostAttachment, boardWithMembers board.BoardWithMembersDTO, userID string) AttachmentDTO {
+	attachmentDTO := AttachmentDTO{
+		PostAttachment: attachment,
+		URL:            fmt.Sprintf("/attachments/%s/download", attachment.ID),
+	}
+	if !boardWithMembers.AnonymousPosts || attachment.UserID.String() == userID {
+		attachmentDTO.UserID = &attachment.UserID
+	}
+	return attachmentDTO
+}
+
 // RegisterHandlers registers all the post API handlers to their respective routes.
 func (api *API) RegisterHandlers(r chi.Router, authHandler func(http.Handler) http.Handler) {
 	r.Route("/post-groups", func(r chi.Router) {
@@ -79,4 +412,19 @@ func (api *API) RegisterHandlers(r chi.Router, authHandler func(http.Handler) ht
 			r.Get("/", api.HandleListPostGroups)
 		})
 	})
+	r.Route("/posts", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authHandler)
+			r.Get("/{postID}/comments", api.HandleListComments)
+			r.Get("/{postID}/attachments", api.HandleListAttachments)
+			r.Post("/{postID}/attachments", api.HandleUploadAttachment)
+		})
+	})
+	r.Route("/attachments", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authHandler)
+			r.Get("/{attachmentID}/download", api.HandleDownloadAttachment)
+			r.Delete("/{attachmentID}", api.HandleDeleteAttachment)
+		})
+	})
 }
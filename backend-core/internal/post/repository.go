@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 
 	"github.com/Wave-95/boards/backend-core/db"
 	"github.com/Wave-95/boards/backend-core/internal/models"
@@ -14,6 +15,24 @@ import (
 
 var (
 	errPostNotFound = errors.New("Post not found")
+	// ErrVoteLimitReached is returned when a user has already cast all of their votes on a board.
+	ErrVoteLimitReached = errors.New("Vote limit reached")
+	// ErrVoteNotFound is returned when a user has no votes on a post to take back.
+	ErrVoteNotFound = errors.New("Vote not found")
+	// ErrReactionExists is returned when a user has already reacted to a post with an emoji.
+	ErrReactionExists = errors.New("Reaction already exists")
+	// ErrReactionNotFound is returned when a user has not reacted to a post with an emoji.
+	ErrReactionNotFound = errors.New("Reaction not found")
+	// ErrCommentNotFound is returned when a comment does not exist.
+	ErrCommentNotFound = errors.New("Comment not found")
+	// ErrTagNotFound is returned when a tag is not in the tag catalog of the board of a post.
+	ErrTagNotFound = errors.New("Tag not found")
+	// ErrPostTagExists is returned when a tag has already been added to a post.
+	ErrPostTagExists = errors.New("Post already has tag")
+	// ErrPostTagNotFound is returned when a tag has not been added to a post.
+	ErrPostTagNotFound = errors.New("Post does not have tag")
+	// ErrAttachmentNotFound is returned when an attachment does not exist.
+	ErrAttachmentNotFound = errors.New("Attachment not found")
 )
 
 // Repository is an interface that represents all the database capabilities for the post repository.
@@ -27,6 +46,29 @@ type Repository interface {
 	GetPostGroup(ctx context.Context, postGroupID uuid.UUID) (models.PostGroup, error)
 	UpdatePostGroup(ctx context.Context, postGroup models.PostGroup) error
 	DeletePostGroup(context.Context, uuid.UUID) error
+	CreateVote(ctx context.Context, vote models.PostVote, voteLimit int) error
+	DeleteVote(ctx context.Context, postID uuid.UUID, userID uuid.UUID) error
+	ListVoteCounts(ctx context.Context, boardID uuid.UUID, userID uuid.UUID) (map[uuid.UUID]VoteCount, error)
+	CreateReaction(ctx context.Context, reaction models.PostReaction) error
+	DeleteReaction(ctx context.Context, postID uuid.UUID, userID uuid.UUID, emoji string) error
+	ListReactions(ctx context.Context, boardID uuid.UUID) (map[uuid.UUID][]ReactionSummary, error)
+	GetTag(ctx context.Context, tagID uuid.UUID) (models.BoardTag, error)
+	CreatePostTag(ctx context.Context, postTag models.PostTag) error
+	DeletePostTag(ctx context.Context, postID uuid.UUID, tagID uuid.UUID) error
+	ListPostTags(ctx context.Context, boardID uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+	CreateComment(ctx context.Context, comment models.PostComment) error
+	GetComment(ctx context.Context, commentID uuid.UUID) (models.PostComment, error)
+	ListComments(ctx context.Context, postID uuid.UUID) ([]models.PostComment, error)
+	UpdateComment(ctx context.Context, comment models.PostComment) error
+	DeleteComment(ctx context.Context, commentID uuid.UUID) error
+	CreateAttachment(ctx context.Context, attachment models.PostAttachment) error
+	GetAttachment(ctx context.Context, attachmentID uuid.UUID) (models.PostAttachment, error)
+	ListAttachments(ctx context.Context, postID uuid.UUID) ([]models.PostAttachment, error)
+	ListAttachmentsByPostGroup(ctx context.Context, postGroupID uuid.UUID) ([]models.PostAttachment, error)
+	DeleteAttachment(ctx context.Context, attachmentID uuid.UUID) error
+	CreateMention(ctx context.Context, mention models.PostMention) error
+	ListMentions(ctx context.Context, postID uuid.UUID) ([]models.PostMention, error)
+	DeleteMention(ctx context.Context, mentionID uuid.UUID) error
 }
 
 type repository struct {
@@ -98,7 +140,7 @@ func (r *repository) UpdatePost(ctx context.Context, post models.Post) error {
 	return r.q.UpdatePost(ctx, arg)
 }
 
-// DeletePost delets a single post.
+// DeletePost delets a single post. The votes, reactions, comments, and tags on the post are deleted along with it.
 func (r *repository) DeletePost(ctx context.Context, postID uuid.UUID) error {
 	return r.q.DeletePost(ctx, pgtype.UUID{Bytes: postID, Valid: true})
 }
@@ -136,33 +178,461 @@ func (r *repository) DeletePostGroup(ctx context.Context, postGroupID uuid.UUID)
 	return nil
 }
 
+// CreateVote uses a db tx to cast a vote on a post. The vote is only created if the user has cast fewer than
+// voteLimit votes on the board, otherwise ErrVoteLimitReached is returned.
+func (r *repository) CreateVote(ctx context.Context, vote models.PostVote, voteLimit int) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("repository: failed to rollback tx: %v", rbErr)
+			}
+		}
+	}()
+	qtx := r.q.WithTx(tx)
+	// Votes of the same user on the same board are cast one at a time so that concurrent votes cannot both pass
+	// the vote limit check
+	err = qtx.LockPostVotes(ctx, db.LockPostVotesParams{
+		BoardID: pgtype.UUID{Bytes: vote.BoardID, Valid: true},
+		UserID:  pgtype.UUID{Bytes: vote.UserID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("repository: failed to lock votes: %w", err)
+	}
+	arg := db.CreatePostVoteParams{
+		ID:        pgtype.UUID{Bytes: vote.ID, Valid: true},
+		PostID:    pgtype.UUID{Bytes: vote.PostID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: vote.BoardID, Valid: true},
+		UserID:    pgtype.UUID{Bytes: vote.UserID, Valid: true},
+		CreatedAt: pgtype.Timestamp{Time: vote.CreatedAt, Valid: true},
+		VoteLimit: int32(voteLimit),
+	}
+	count, err := qtx.CreatePostVote(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("repository: failed to create vote: %w", err)
+	}
+	if count == 0 {
+		err = ErrVoteLimitReached
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// DeleteVote takes back the latest vote that a user cast on a post.
+func (r *repository) DeleteVote(ctx context.Context, postID uuid.UUID, userID uuid.UUID) error {
+	arg := db.DeletePostVoteParams{
+		PostID: pgtype.UUID{Bytes: postID, Valid: true},
+		UserID: pgtype.UUID{Bytes: userID, Valid: true},
+	}
+	count, err := r.q.DeletePostVote(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("repository: failed to delete vote: %w", err)
+	}
+	if count == 0 {
+		return ErrVoteNotFound
+	}
+	return nil
+}
+
+// ListVoteCounts returns the vote counts of every post on a board that has votes, keyed by post ID. The counts
+// include the number of votes that the given user cast on each post.
+func (r *repository) ListVoteCounts(ctx context.Context, boardID uuid.UUID, userID uuid.UUID) (map[uuid.UUID]VoteCount, error) {
+	arg := db.ListPostVoteCountsParams{
+		BoardID: pgtype.UUID{Bytes: boardID, Valid: true},
+		UserID:  pgtype.UUID{Bytes: userID, Valid: true},
+	}
+	rows, err := r.q.ListPostVoteCounts(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list vote counts: %w", err)
+	}
+	counts := make(map[uuid.UUID]VoteCount, len(rows))
+	for _, row := range rows {
+		counts[row.PostID.Bytes] = VoteCount{Votes: int(row.Votes), UserVotes: int(row.UserVotes)}
+	}
+	return counts, nil
+}
+
+// CreateReaction adds an emoji reaction to a post. ErrReactionExists is returned if the user has already reacted
+// to the post with the same emoji.
+func (r *repository) CreateReaction(ctx context.Context, reaction models.PostReaction) error {
+	arg := db.CreatePostReactionParams{
+		ID:        pgtype.UUID{Bytes: reaction.ID, Valid: true},
+		PostID:    pgtype.UUID{Bytes: reaction.PostID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: reaction.BoardID, Valid: true},
+		UserID:    pgtype.UUID{Bytes: reaction.UserID, Valid: true},
+		Emoji:     reaction.Emoji,
+		CreatedAt: pgtype.Timestamp{Time: reaction.CreatedAt, Valid: true},
+	}
+	count, err := r.q.CreatePostReaction(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("repository: failed to create reaction: %w", err)
+	}
+	if count == 0 {
+		return ErrReactionExists
+	}
+	return nil
+}
+
+// DeleteReaction removes the emoji reaction that a user added to a post.
+func (r *repository) DeleteReaction(ctx context.Context, postID uuid.UUID, userID uuid.UUID, emoji string) error {
+	arg := db.DeletePostReactionParams{
+		PostID: pgtype.UUID{Bytes: postID, Valid: true},
+		UserID: pgtype.UUID{Bytes: userID, Valid: true},
+		Emoji:  emoji,
+	}
+	count, err := r.q.DeletePostReaction(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("repository: failed to delete reaction: %w", err)
+	}
+	if count == 0 {
+		return ErrReactionNotFound
+	}
+	return nil
+}
+
+// ListReactions returns the reaction summaries of every post on a board that has reactions, keyed by post ID.
+// The summaries of a post are ordered by when each emoji was first used.
+func (r *repository) ListReactions(ctx context.Context, boardID uuid.UUID) (map[uuid.UUID][]ReactionSummary, error) {
+	rows, err := r.q.ListPostReactionsByBoard(ctx, pgtype.UUID{Bytes: boardID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list reactions: %w", err)
+	}
+	reactions := make(map[uuid.UUID][]ReactionSummary)
+	for _, row := range rows {
+		userIDs := make([]uuid.UUID, len(row.UserIds))
+		for i, userID := range row.UserIds {
+			userIDs[i] = userID.Bytes
+		}
+		postID := uuid.UUID(row.PostID.Bytes)
+		reactions[postID] = append(reactions[postID], ReactionSummary{
+			Emoji:   row.Emoji,
+			Count:   len(userIDs),
+			UserIDs: userIDs,
+		})
+	}
+	return reactions, nil
+}
+
+// GetTag returns a single tag from the tag catalog of a board.
+func (r *repository) GetTag(ctx context.Context, tagID uuid.UUID) (models.BoardTag, error) {
+	row, err := r.q.GetBoardTag(ctx, pgtype.UUID{Bytes: tagID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.BoardTag{}, ErrTagNotFound
+		}
+		return models.BoardTag{}, fmt.Errorf("repository: failed to get tag: %w", err)
+	}
+	return models.BoardTag{
+		ID:        row.ID.Bytes,
+		BoardID:   row.BoardID.Bytes,
+		Name:      row.Name,
+		Color:     row.Color,
+		CreatedAt: row.CreatedAt.Time,
+	}, nil
+}
+
+// CreatePostTag adds a tag to a post. ErrPostTagExists is returned if the post already has the tag.
+func (r *repository) CreatePostTag(ctx context.Context, postTag models.PostTag) error {
+	arg := db.CreatePostTagParams{
+		PostID:    pgtype.UUID{Bytes: postTag.PostID, Valid: true},
+		TagID:     pgtype.UUID{Bytes: postTag.TagID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: postTag.BoardID, Valid: true},
+		CreatedAt: pgtype.Timestamp{Time: postTag.CreatedAt, Valid: true},
+	}
+	count, err := r.q.CreatePostTag(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("repository: failed to create post tag: %w", err)
+	}
+	if count == 0 {
+		return ErrPostTagExists
+	}
+	return nil
+}
+
+// DeletePostTag removes a tag from a post.
+func (r *repository) DeletePostTag(ctx context.Context, postID uuid.UUID, tagID uuid.UUID) error {
+	arg := db.DeletePostTagParams{
+		PostID: pgtype.UUID{Bytes: postID, Valid: true},
+		TagID:  pgtype.UUID{Bytes: tagID, Valid: true},
+	}
+	count, err := r.q.DeletePostTag(ctx, arg)
+	if err != nil {
+		return fmt.Errorf("repository: failed to delete post tag: %w", err)
+	}
+	if count == 0 {
+		return ErrPostTagNotFound
+	}
+	return nil
+}
+
+// ListPostTags returns the IDs of the tags on every post on a board that has tags, keyed by post ID. The tags
+// of a post are ordered by when they were added.
+func (r *repository) ListPostTags(ctx context.Context, boardID uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	rows, err := r.q.ListPostTagsByBoard(ctx, pgtype.UUID{Bytes: boardID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list post tags: %w", err)
+	}
+	postTags := make(map[uuid.UUID][]uuid.UUID)
+	for _, row := range rows {
+		postID := uuid.UUID(row.PostID.Bytes)
+		postTags[postID] = append(postTags[postID], row.TagID.Bytes)
+	}
+	return postTags, nil
+}
+
+// CreateComment creates a comment on a post.
+func (r *repository) CreateComment(ctx context.Context, comment models.PostComment) error {
+	arg := db.CreatePostCommentParams{
+		ID:        pgtype.UUID{Bytes: comment.ID, Valid: true},
+		PostID:    pgtype.UUID{Bytes: comment.PostID, Valid: true},
+		BoardID:   pgtype.UUID{Bytes: comment.BoardID, Valid: true},
+		UserID:    pgtype.UUID{Bytes: comment.UserID, Valid: true},
+		Content:   comment.Content,
+		CreatedAt: pgtype.Timestamp{Time: comment.CreatedAt, Valid: true},
+		UpdatedAt: pgtype.Timestamp{Time: comment.UpdatedAt, Valid: true},
+	}
+	if comment.ParentID != nil {
+		arg.ParentID = pgtype.UUID{Bytes: *comment.ParentID, Valid: true}
+	}
+	if err := r.q.CreatePostComment(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to create comment: %w", err)
+	}
+	return nil
+}
+
+// GetComment returns a single comment.
+func (r *repository) GetComment(ctx context.Context, commentID uuid.UUID) (models.PostComment, error) {
+	commentDB, err := r.q.GetPostComment(ctx, pgtype.UUID{Bytes: commentID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.PostComment{}, ErrCommentNotFound
+		}
+		return models.PostComment{}, fmt.Errorf("repository: failed to get comment: %w", err)
+	}
+	return toComment(commentDB), nil
+}
+
+// ListComments returns all the comments on a post, oldest first.
+func (r *repository) ListComments(ctx context.Context, postID uuid.UUID) ([]models.PostComment, error) {
+	commentsDB, err := r.q.ListPostCommentsByPost(ctx, pgtype.UUID{Bytes: postID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list comments: %w", err)
+	}
+	comments := make([]models.PostComment, len(commentsDB))
+	for i, commentDB := range commentsDB {
+		comments[i] = toComment(commentDB)
+	}
+	return comments, nil
+}
+
+// UpdateComment updates the content of a comment.
+func (r *repository) UpdateComment(ctx context.Context, comment models.PostComment) error {
+	arg := db.UpdatePostCommentParams{
+		ID:        pgtype.UUID{Bytes: comment.ID, Valid: true},
+		Content:   comment.Content,
+		UpdatedAt: pgtype.Timestamp{Time: comment.UpdatedAt, Valid: true},
+	}
+	if err := r.q.UpdatePostComment(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to update comment: %w", err)
+	}
+	return nil
+}
+
+// DeleteComment deletes a comment along with all of its replies.
+func (r *repository) DeleteComment(ctx context.Context, commentID uuid.UUID) error {
+	if err := r.q.DeletePostComment(ctx, pgtype.UUID{Bytes: commentID, Valid: true}); err != nil {
+		return fmt.Errorf("repository: failed to delete comment: %w", err)
+	}
+	return nil
+}
+
+// CreateAttachment creates the record of a file attached to a post.
+func (r *repository) CreateAttachment(ctx context.Context, attachment models.PostAttachment) error {
+	arg := db.CreatePostAttachmentParams{
+		ID:          pgtype.UUID{Bytes: attachment.ID, Valid: true},
+		PostID:      pgtype.UUID{Bytes: attachment.PostID, Valid: true},
+		BoardID:     pgtype.UUID{Bytes: attachment.BoardID, Valid: true},
+		UserID:      pgtype.UUID{Bytes: attachment.UserID, Valid: true},
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   pgtype.Timestamp{Time: attachment.CreatedAt, Valid: true},
+	}
+	if err := r.q.CreatePostAttachment(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to create attachment: %w", err)
+	}
+	return nil
+}
+
+// GetAttachment returns a single attachment.
+func (r *repository) GetAttachment(ctx context.Context, attachmentID uuid.UUID) (models.PostAttachment, error) {
+	attachmentDB, err := r.q.GetPostAttachment(ctx, pgtype.UUID{Bytes: attachmentID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.PostAttachment{}, ErrAttachmentNotFound
+		}
+		return models.PostAttachment{}, fmt.Errorf("repository: failed to get attachment: %w", err)
+	}
+	return toAttachment(attachmentDB), nil
+}
+
+// ListAttachments returns all the attachments of a post, oldest first.
+func (r *repository) ListAttachments(ctx context.Context, postID uuid.UUID) ([]models.PostAttachment, error) {
+	attachmentsDB, err := r.q.ListPostAttachmentsByPost(ctx, pgtype.UUID{Bytes: postID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list attachments: %w", err)
+	}
+	attachments := make([]models.PostAttachment, len(attachmentsDB))
+	for i, attachmentDB := range attachmentsDB {
+		attachments[i] = toAttachment(attachmentDB)
+	}
+	return attachments, nil
+}
+
+// ListAttachmentsByPostGroup returns the attachments of all the posts in a post group.
+func (r *repository) ListAttachmentsByPostGroup(ctx context.Context, postGroupID uuid.UUID) ([]models.PostAttachment, error) {
+	attachmentsDB, err := r.q.ListPostAttachmentsByPostGroup(ctx, pgtype.UUID{Bytes: postGroupID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list attachments: %w", err)
+	}
+	attachments := make([]models.PostAttachment, len(attachmentsDB))
+	for i, attachmentDB := range attachmentsDB {
+		attachments[i] = toAttachment(attachmentDB)
+	}
+	return attachments, nil
+}
+
+// DeleteAttachment deletes the record of an attachment.
+func (r *repository) DeleteAttachment(ctx context.Context, attachmentID uuid.UUID) error {
+	if err := r.q.DeletePostAttachment(ctx, pgtype.UUID{Bytes: attachmentID, Valid: true}); err != nil {
+		return fmt.Errorf("repository: failed to delete attachment: %w", err)
+	}
+	return nil
+}
+
+// toAttachment maps a db attachment to a domain attachment.
+func toAttachment(attachment db.PostAttachment) models.PostAttachment {
+	return models.PostAttachment{
+		ID:          attachment.ID.Bytes,
+		PostID:      attachment.PostID.Bytes,
+		BoardID:     attachment.BoardID.Bytes,
+		UserID:      attachment.UserID.Bytes,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt.Time,
+	}
+}
+
+// CreateMention creates the record of a board member mentioned in a post or a comment.
+func (r *repository) CreateMention(ctx context.Context, mention models.PostMention) error {
+	arg := db.CreatePostMentionParams{
+		ID:          pgtype.UUID{Bytes: mention.ID, Valid: true},
+		PostID:      pgtype.UUID{Bytes: mention.PostID, Valid: true},
+		BoardID:     pgtype.UUID{Bytes: mention.BoardID, Valid: true},
+		UserID:      pgtype.UUID{Bytes: mention.UserID, Valid: true},
+		MentionedBy: pgtype.UUID{Bytes: mention.MentionedBy, Valid: true},
+		CreatedAt:   pgtype.Timestamp{Time: mention.CreatedAt, Valid: true},
+	}
+	if mention.CommentID != nil {
+		arg.CommentID = pgtype.UUID{Bytes: *mention.CommentID, Valid: true}
+	}
+	if err := r.q.CreatePostMention(ctx, arg); err != nil {
+		return fmt.Errorf("repository: failed to create mention: %w", err)
+	}
+	return nil
+}
+
+// ListMentions returns the mentions in a post and in the comments on the post, oldest first.
+func (r *repository) ListMentions(ctx context.Context, postID uuid.UUID) ([]models.PostMention, error) {
+	mentionsDB, err := r.q.ListPostMentionsByPost(ctx, pgtype.UUID{Bytes: postID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list mentions: %w", err)
+	}
+	mentions := make([]models.PostMention, len(mentionsDB))
+	for i, mentionDB := range mentionsDB {
+		mentions[i] = toMention(mentionDB)
+	}
+	return mentions, nil
+}
+
+// DeleteMention deletes the record of a mention.
+func (r *repository) DeleteMention(ctx context.Context, mentionID uuid.UUID) error {
+	if err := r.q.DeletePostMention(ctx, pgtype.UUID{Bytes: mentionID, Valid: true}); err != nil {
+		return fmt.Errorf("repository: failed to delete mention: %w", err)
+	}
+	return nil
+}
+
+// toMention maps a db mention to a domain mention.
+func toMention(mention db.PostMention) models.PostMention {
+	var commentID *uuid.UUID
+	if mention.CommentID.Valid {
+		id := uuid.UUID(mention.CommentID.Bytes)
+		commentID = &id
+	}
+	return models.PostMention{
+		ID:          mention.ID.Bytes,
+		PostID:      mention.PostID.Bytes,
+		CommentID:   commentID,
+		BoardID:     mention.BoardID.Bytes,
+		UserID:      mention.UserID.Bytes,
+		MentionedBy: mention.MentionedBy.Bytes,
+		CreatedAt:   mention.CreatedAt.Time,
+	}
+}
+
+// toComment maps a db comment to a domain comment.
+func toComment(comment db.PostComment) models.PostComment {
+	var parentID *uuid.UUID
+	if comment.ParentID.Valid {
+		id := uuid.UUID(comment.ParentID.Bytes)
+		parentID = &id
+	}
+	return models.PostComment{
+		ID:        comment.ID.Bytes,
+		PostID:    comment.PostID.Bytes,
+		BoardID:   comment.BoardID.Bytes,
+		UserID:    comment.UserID.Bytes,
+		ParentID:  parentID,
+		Content:   comment.Content,
+		CreatedAt: comment.CreatedAt.Time,
+		UpdatedAt: comment.UpdatedAt.Time,
+	}
+}
+
 // toPost maps a db post to a domain post.
 func toPost(postDB db.Post) models.Post {
 	return models.Post{
-		ID:          postDB.ID.Bytes,
-		UserID:      postDB.UserID.Bytes,
-		Content:     postDB.Content.String,
-		Color:       postDB.Color.String,
-		Height:      int(postDB.Height.Int32),
-		CreatedAt:   postDB.CreatedAt.Time,
-		UpdatedAt:   postDB.UpdatedAt.Time,
-		PostOrder:   postDB.PostOrder.Float64,
-		PostGroupID: postDB.PostGroupID.Bytes,
+		ID:            postDB.ID.Bytes,
+		UserID:        postDB.UserID.Bytes,
+		Content:       postDB.Content.String,
+		Color:         postDB.Color.String,
+		Height:        int(postDB.Height.Int32),
+		CreatedAt:     postDB.CreatedAt.Time,
+		UpdatedAt:     postDB.UpdatedAt.Time,
+		PostOrder:     postDB.PostOrder.Float64,
+		PostGroupID:   postDB.PostGroupID.Bytes,
+		ContentFormat: models.PostContentFormat(postDB.ContentFormat),
 	}
 }
 
 // toPostDB maps a domain post to a db post.
 func toPostDB(post models.Post) db.Post {
 	return db.Post{
-		ID:          pgtype.UUID{Bytes: post.ID, Valid: true},
-		UserID:      pgtype.UUID{Bytes: post.UserID, Valid: true},
-		Content:     pgtype.Text{String: post.Content, Valid: true},
-		Color:       pgtype.Text{String: post.Color, Valid: true},
-		Height:      pgtype.Int4{Int32: int32(post.Height), Valid: true},
-		CreatedAt:   pgtype.Timestamp{Time: post.CreatedAt, Valid: true},
-		UpdatedAt:   pgtype.Timestamp{Time: post.UpdatedAt, Valid: true},
-		PostOrder:   pgtype.Float8{Float64: post.PostOrder, Valid: true},
-		PostGroupID: pgtype.UUID{Bytes: post.PostGroupID, Valid: true},
+		ID:            pgtype.UUID{Bytes: post.ID, Valid: true},
+		UserID:        pgtype.UUID{Bytes: post.UserID, Valid: true},
+		Content:       pgtype.Text{String: post.Content, Valid: true},
+		Color:         pgtype.Text{String: post.Color, Valid: true},
+		Height:        pgtype.Int4{Int32: int32(post.Height), Valid: true},
+		CreatedAt:     pgtype.Timestamp{Time: post.CreatedAt, Valid: true},
+		UpdatedAt:     pgtype.Timestamp{Time: post.UpdatedAt, Valid: true},
+		PostOrder:     pgtype.Float8{Float64: post.PostOrder, Valid: true},
+		PostGroupID:   pgtype.UUID{Bytes: post.PostGroupID, Valid: true},
+		ContentFormat: string(post.ContentFormat),
 	}
 }
 
@@ -8,15 +8,23 @@ import (
 )
 
 type mockRepository struct {
-	posts      map[uuid.UUID]models.Post
-	postGroups map[uuid.UUID]models.PostGroup
+	posts       map[uuid.UUID]models.Post
+	postGroups  map[uuid.UUID]models.PostGroup
+	votes       []models.PostVote
+	reactions   []models.PostReaction
+	comments    []models.PostComment
+	tags        map[uuid.UUID]models.BoardTag
+	postTags    []models.PostTag
+	attachments []models.PostAttachment
+	mentions    []models.PostMention
 }
 
 // NewMockRepository returns a mock post repository.
 func NewMockRepository() *mockRepository {
 	posts := make(map[uuid.UUID]models.Post)
 	postGroups := make(map[uuid.UUID]models.PostGroup)
-	return &mockRepository{posts: posts, postGroups: postGroups}
+	tags := make(map[uuid.UUID]models.BoardTag)
+	return &mockRepository{posts: posts, postGroups: postGroups, tags: tags}
 }
 
 func (r *mockRepository) CreatePost(_ context.Context, post models.Post) error {
@@ -33,6 +41,7 @@ func (r *mockRepository) ListPostGroups(_ context.Context, boardID uuid.UUID) ([
 	list := []GroupAndPost{}
 	for _, postGroup := range r.postGroups {
 		if postGroup.BoardID == boardID {
+			hasPosts := false
 			for _, post := range r.posts {
 				if post.PostGroupID == postGroup.ID {
 					item := GroupAndPost{
@@ -40,8 +49,12 @@ func (r *mockRepository) ListPostGroups(_ context.Context, boardID uuid.UUID) ([
 						Post:      post,
 					}
 					list = append(list, item)
+					hasPosts = true
 				}
 			}
+			if !hasPosts {
+				list = append(list, GroupAndPost{PostGroup: postGroup})
+			}
 		}
 	}
 	return list, nil
@@ -61,6 +74,34 @@ func (r *mockRepository) UpdatePost(_ context.Context, post models.Post) error {
 
 func (r *mockRepository) DeletePost(_ context.Context, postID uuid.UUID) error {
 	delete(r.posts, postID)
+	comments := r.comments[:0]
+	for _, comment := range r.comments {
+		if comment.PostID != postID {
+			comments = append(comments, comment)
+		}
+	}
+	r.comments = comments
+	postTags := r.postTags[:0]
+	for _, postTag := range r.postTags {
+		if postTag.PostID != postID {
+			postTags = append(postTags, postTag)
+		}
+	}
+	r.postTags = postTags
+	attachments := r.attachments[:0]
+	for _, attachment := range r.attachments {
+		if attachment.PostID != postID {
+			attachments = append(attachments, attachment)
+		}
+	}
+	r.attachments = attachments
+	mentions := r.mentions[:0]
+	for _, mention := range r.mentions {
+		if mention.PostID != postID {
+			mentions = append(mentions, mention)
+		}
+	}
+	r.mentions = mentions
 	return nil
 }
 
@@ -76,7 +117,261 @@ func (r *mockRepository) UpdatePostGroup(_ context.Context, postGroup models.Pos
 	return nil
 }
 
-func (r *mockRepository) DeletePostGroup(_ context.Context, postGroupID uuid.UUID) error {
+func (r *mockRepository) DeletePostGroup(ctx context.Context, postGroupID uuid.UUID) error {
 	delete(r.postGroups, postGroupID)
+	for _, post := range r.posts {
+		if post.PostGroupID == postGroupID {
+			if err := r.DeletePost(ctx, post.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *mockRepository) CreateVote(_ context.Context, vote models.PostVote, voteLimit int) error {
+	count := 0
+	for _, v := range r.votes {
+		if v.BoardID == vote.BoardID && v.UserID == vote.UserID {
+			count++
+		}
+	}
+	if count >= voteLimit {
+		return ErrVoteLimitReached
+	}
+	r.votes = append(r.votes, vote)
+	return nil
+}
+
+func (r *mockRepository) DeleteVote(_ context.Context, postID uuid.UUID, userID uuid.UUID) error {
+	for i := len(r.votes) - 1; i >= 0; i-- {
+		if r.votes[i].PostID == postID && r.votes[i].UserID == userID {
+			r.votes = append(r.votes[:i], r.votes[i+1:]...)
+			return nil
+		}
+	}
+	return ErrVoteNotFound
+}
+
+func (r *mockRepository) ListVoteCounts(_ context.Context, boardID uuid.UUID, userID uuid.UUID) (map[uuid.UUID]VoteCount, error) {
+	counts := make(map[uuid.UUID]VoteCount)
+	for _, v := range r.votes {
+		if v.BoardID != boardID {
+			continue
+		}
+		count := counts[v.PostID]
+		count.Votes++
+		if v.UserID == userID {
+			count.UserVotes++
+		}
+		counts[v.PostID] = count
+	}
+	return counts, nil
+}
+
+func (r *mockRepository) CreateReaction(_ context.Context, reaction models.PostReaction) error {
+	for _, existing := range r.reactions {
+		if existing.PostID == reaction.PostID && existing.UserID == reaction.UserID && existing.Emoji == reaction.Emoji {
+			return ErrReactionExists
+		}
+	}
+	r.reactions = append(r.reactions, reaction)
+	return nil
+}
+
+func (r *mockRepository) DeleteReaction(_ context.Context, postID uuid.UUID, userID uuid.UUID, emoji string) error {
+	for i, reaction := range r.reactions {
+		if reaction.PostID == postID && reaction.UserID == userID && reaction.Emoji == emoji {
+			r.reactions = append(r.reactions[:i], r.reactions[i+1:]...)
+			return nil
+		}
+	}
+	return ErrReactionNotFound
+}
+
+func (r *mockRepository) ListReactions(_ context.Context, boardID uuid.UUID) (map[uuid.UUID][]ReactionSummary, error) {
+	reactions := make(map[uuid.UUID][]ReactionSummary)
+	for _, reaction := range r.reactions {
+		if reaction.BoardID != boardID {
+			continue
+		}
+		summaries := reactions[reaction.PostID]
+		found := false
+		for i := range summaries {
+			if summaries[i].Emoji == reaction.Emoji {
+				summaries[i].Count++
+				summaries[i].UserIDs = append(summaries[i].UserIDs, reaction.UserID)
+				found = true
+			}
+		}
+		if !found {
+			summaries = append(summaries, ReactionSummary{Emoji: reaction.Emoji, Count: 1, UserIDs: []uuid.UUID{reaction.UserID}})
+		}
+		reactions[reaction.PostID] = summaries
+	}
+	return reactions, nil
+}
+
+func (r *mockRepository) GetTag(_ context.Context, tagID uuid.UUID) (models.BoardTag, error) {
+	if tag, ok := r.tags[tagID]; ok {
+		return tag, nil
+	}
+	return models.BoardTag{}, ErrTagNotFound
+}
+
+func (r *mockRepository) CreatePostTag(_ context.Context, postTag models.PostTag) error {
+	for _, existing := range r.postTags {
+		if existing.PostID == postTag.PostID && existing.TagID == postTag.TagID {
+			return ErrPostTagExists
+		}
+	}
+	r.postTags = append(r.postTags, postTag)
+	return nil
+}
+
+func (r *mockRepository) DeletePostTag(_ context.Context, postID uuid.UUID, tagID uuid.UUID) error {
+	for i, postTag := range r.postTags {
+		if postTag.PostID == postID && postTag.TagID == tagID {
+			r.postTags = append(r.postTags[:i], r.postTags[i+1:]...)
+			return nil
+		}
+	}
+	return ErrPostTagNotFound
+}
+
+func (r *mockRepository) ListPostTags(_ context.Context, boardID uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	postTags := make(map[uuid.UUID][]uuid.UUID)
+	for _, postTag := range r.postTags {
+		if postTag.BoardID == boardID {
+			postTags[postTag.PostID] = append(postTags[postTag.PostID], postTag.TagID)
+		}
+	}
+	return postTags, nil
+}
+
+func (r *mockRepository) CreateComment(_ context.Context, comment models.PostComment) error {
+	r.comments = append(r.comments, comment)
+	return nil
+}
+
+func (r *mockRepository) GetComment(_ context.Context, commentID uuid.UUID) (models.PostComment, error) {
+	for _, comment := range r.comments {
+		if comment.ID == commentID {
+			return comment, nil
+		}
+	}
+	return models.PostComment{}, ErrCommentNotFound
+}
+
+func (r *mockRepository) ListComments(_ context.Context, postID uuid.UUID) ([]models.PostComment, error) {
+	comments := []models.PostComment{}
+	for _, comment := range r.comments {
+		if comment.PostID == postID {
+			comments = append(comments, comment)
+		}
+	}
+	return comments, nil
+}
+
+func (r *mockRepository) UpdateComment(_ context.Context, comment models.PostComment) error {
+	for i := range r.comments {
+		if r.comments[i].ID == comment.ID {
+			r.comments[i].Content = comment.Content
+			r.comments[i].UpdatedAt = comment.UpdatedAt
+		}
+	}
+	return nil
+}
+
+func (r *mockRepository) DeleteComment(_ context.Context, commentID uuid.UUID) error {
+	deleted := map[uuid.UUID]bool{commentID: true}
+	comments := r.comments[:0]
+	// Comments are stored oldest first, so replies are always seen after their parent
+	for _, comment := range r.comments {
+		if deleted[comment.ID] || (comment.ParentID != nil && deleted[*comment.ParentID]) {
+			deleted[comment.ID] = true
+			continue
+		}
+		comments = append(comments, comment)
+	}
+	r.comments = comments
+	mentions := r.mentions[:0]
+	for _, mention := range r.mentions {
+		if mention.CommentID == nil || !deleted[*mention.CommentID] {
+			mentions = append(mentions, mention)
+		}
+	}
+	r.mentions = mentions
+	return nil
+}
+
+func (r *mockRepository) CreateAttachment(_ context.Context, attachment models.PostAttachment) error {
+	r.attachments = append(r.attachments, attachment)
+	return nil
+}
+
+func (r *mockRepository) GetAttachment(_ context.Context, attachmentID uuid.UUID) (models.PostAttachment, error) {
+	for _, attachment := range r.attachments {
+		if attachment.ID == attachmentID {
+			return attachment, nil
+		}
+	}
+	return models.PostAttachment{}, ErrAttachmentNotFound
+}
+
+func (r *mockRepository) ListAttachments(_ context.Context, postID uuid.UUID) ([]models.PostAttachment, error) {
+	attachments := []models.PostAttachment{}
+	for _, attachment := range r.attachments {
+		if attachment.PostID == postID {
+			attachments = append(attachments, attachment)
+		}
+	}
+	return attachments, nil
+}
+
+func (r *mockRepository) ListAttachmentsByPostGroup(_ context.Context, postGroupID uuid.UUID) ([]models.PostAttachment, error) {
+	attachments := []models.PostAttachment{}
+	for _, attachment := range r.attachments {
+		if post, ok := r.posts[attachment.PostID]; ok && post.PostGroupID == postGroupID {
+			attachments = append(attachments, attachment)
+		}
+	}
+	return attachments, nil
+}
+
+func (r *mockRepository) DeleteAttachment(_ context.Context, attachmentID uuid.UUID) error {
+	attachments := r.attachments[:0]
+	for _, attachment := range r.attachments {
+		if attachment.ID != attachmentID {
+			attachments = append(attachments, attachment)
+		}
+	}
+	r.attachments = attachments
+	return nil
+}
+
+func (r *mockRepository) CreateMention(_ context.Context, mention models.PostMention) error {
+	r.mentions = append(r.mentions, mention)
+	return nil
+}
+
+func (r *mockRepository) ListMentions(_ context.Context, postID uuid.UUID) ([]models.PostMention, error) {
+	mentions := []models.PostMention{}
+	for _, mention := range r.mentions {
+		if mention.PostID == postID {
+			mentions = append(mentions, mention)
+		}
+	}
+	return mentions, nil
+}
+
+func (r *mockRepository) DeleteMention(_ context.Context, mentionID uuid.UUID) error {
+	mentions := r.mentions[:0]
+	for _, mention := range r.mentions {
+		if mention.ID != mentionID {
+			mentions = append(mentions, mention)
+		}
+	}
+	r.mentions = mentions
 	return nil
 }
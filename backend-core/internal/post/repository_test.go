@@ -2,13 +2,16 @@ package post
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/Wave-95/boards/backend-core/db"
 	"github.com/Wave-95/boards/backend-core/internal/board"
 	"github.com/Wave-95/boards/backend-core/internal/models"
 	"github.com/Wave-95/boards/backend-core/internal/test"
 	"github.com/Wave-95/boards/backend-core/internal/user"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,6 +58,208 @@ func TestRepository(t *testing.T) {
 		_, err = repo.GetPost(context.Background(), testPost.ID)
 		assert.ErrorIs(t, err, errPostNotFound)
 	})
+
+	t.Run("Create, list, and delete votes", func(t *testing.T) {
+		postGroup := test.NewPostGroup(testBoard.ID)
+		err := repo.CreatePostGroup(context.Background(), postGroup)
+		if err != nil {
+			assert.FailNow(t, "Failed to create post group")
+		}
+		testPost := test.NewPost(testUser.ID, postGroup.ID)
+		err = repo.CreatePost(context.Background(), testPost)
+		if err != nil {
+			assert.FailNow(t, "Failed to create post")
+		}
+		vote := models.PostVote{
+			ID:        uuid.New(),
+			PostID:    testPost.ID,
+			BoardID:   testBoard.ID,
+			UserID:    testUser.ID,
+			CreatedAt: time.Now(),
+		}
+
+		// Create within and over the vote limit
+		err = repo.CreateVote(context.Background(), vote, 1)
+		assert.NoError(t, err)
+		vote.ID = uuid.New()
+		err = repo.CreateVote(context.Background(), vote, 1)
+		assert.ErrorIs(t, err, ErrVoteLimitReached)
+
+		// List
+		counts, err := repo.ListVoteCounts(context.Background(), testBoard.ID, testUser.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, VoteCount{Votes: 1, UserVotes: 1}, counts[testPost.ID])
+
+		// Delete
+		err = repo.DeleteVote(context.Background(), testPost.ID, testUser.ID)
+		assert.NoError(t, err)
+		err = repo.DeleteVote(context.Background(), testPost.ID, testUser.ID)
+		assert.ErrorIs(t, err, ErrVoteNotFound)
+
+		err = repo.DeletePostGroup(context.Background(), postGroup.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Concurrent votes stay within the vote limit", func(t *testing.T) {
+		postGroup := test.NewPostGroup(testBoard.ID)
+		err := repo.CreatePostGroup(context.Background(), postGroup)
+		if err != nil {
+			assert.FailNow(t, "Failed to create post group")
+		}
+		testPost := test.NewPost(testUser.ID, postGroup.ID)
+		err = repo.CreatePost(context.Background(), testPost)
+		if err != nil {
+			assert.FailNow(t, "Failed to create post")
+		}
+
+		voteLimit := 3
+		attempts := 20
+		errs := make(chan error, attempts)
+		var wg sync.WaitGroup
+		for i := 0; i < attempts; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs <- repo.CreateVote(context.Background(), models.PostVote{
+					ID:        uuid.New(),
+					PostID:    testPost.ID,
+					BoardID:   testBoard.ID,
+					UserID:    testUser.ID,
+					CreatedAt: time.Now(),
+				}, voteLimit)
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		created := 0
+		for err := range errs {
+			if err == nil {
+				created++
+			} else {
+				assert.ErrorIs(t, err, ErrVoteLimitReached)
+			}
+		}
+		assert.Equal(t, voteLimit, created)
+		counts, err := repo.ListVoteCounts(context.Background(), testBoard.ID, testUser.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, VoteCount{Votes: voteLimit, UserVotes: voteLimit}, counts[testPost.ID])
+
+		err = repo.DeletePostGroup(context.Background(), postGroup.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create, list, and delete reactions", func(t *testing.T) {
+		postGroup := test.NewPostGroup(testBoard.ID)
+		err := repo.CreatePostGroup(context.Background(), postGroup)
+		if err != nil {
+			assert.FailNow(t, "Failed to create post group")
+		}
+		testPost := test.NewPost(testUser.ID, postGroup.ID)
+		err = repo.CreatePost(context.Background(), testPost)
+		if err != nil {
+			assert.FailNow(t, "Failed to create post")
+		}
+		reaction := models.PostReaction{
+			ID:        uuid.New(),
+			PostID:    testPost.ID,
+			BoardID:   testBoard.ID,
+			UserID:    testUser.ID,
+			Emoji:     "👍",
+			CreatedAt: time.Now(),
+		}
+
+		// Create and create again with the same emoji
+		err = repo.CreateReaction(context.Background(), reaction)
+		assert.NoError(t, err)
+		reaction.ID = uuid.New()
+		err = repo.CreateReaction(context.Background(), reaction)
+		assert.ErrorIs(t, err, ErrReactionExists)
+
+		// List
+		reactions, err := repo.ListReactions(context.Background(), testBoard.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []ReactionSummary{{Emoji: "👍", Count: 1, UserIDs: []uuid.UUID{testUser.ID}}}, reactions[testPost.ID])
+
+		// Delete
+		err = repo.DeleteReaction(context.Background(), testPost.ID, testUser.ID, "👍")
+		assert.NoError(t, err)
+		err = repo.DeleteReaction(context.Background(), testPost.ID, testUser.ID, "👍")
+		assert.ErrorIs(t, err, ErrReactionNotFound)
+
+		err = repo.DeletePostGroup(context.Background(), postGroup.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create, get, list, update, and delete comments", func(t *testing.T) {
+		postGroup := test.NewPostGroup(testBoard.ID)
+		err := repo.CreatePostGroup(context.Background(), postGroup)
+		if err != nil {
+			assert.FailNow(t, "Failed to create post group")
+		}
+		testPost := test.NewPost(testUser.ID, postGroup.ID)
+		err = repo.CreatePost(context.Background(), testPost)
+		if err != nil {
+			assert.FailNow(t, "Failed to create post")
+		}
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		comment := models.PostComment{
+			ID:        uuid.New(),
+			PostID:    testPost.ID,
+			BoardID:   testBoard.ID,
+			UserID:    testUser.ID,
+			Content:   "First!",
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		reply := comment
+		reply.ID = uuid.New()
+		reply.ParentID = &comment.ID
+		reply.CreatedAt = now.Add(time.Second)
+
+		// Create
+		err = repo.CreateComment(context.Background(), comment)
+		assert.NoError(t, err)
+		err = repo.CreateComment(context.Background(), reply)
+		assert.NoError(t, err)
+
+		// Get and list
+		got, err := repo.GetComment(context.Background(), reply.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &comment.ID, got.ParentID)
+		comments, err := repo.ListComments(context.Background(), testPost.ID)
+		assert.NoError(t, err)
+		if assert.Len(t, comments, 2) {
+			assert.Equal(t, comment.ID, comments[0].ID)
+			assert.Nil(t, comments[0].ParentID)
+		}
+
+		// Update
+		comment.Content = "Edited"
+		err = repo.UpdateComment(context.Background(), comment)
+		assert.NoError(t, err)
+		got, err = repo.GetComment(context.Background(), comment.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "Edited", got.Content)
+
+		// Deleting a comment deletes its replies
+		err = repo.DeleteComment(context.Background(), comment.ID)
+		assert.NoError(t, err)
+		_, err = repo.GetComment(context.Background(), reply.ID)
+		assert.ErrorIs(t, err, ErrCommentNotFound)
+
+		// Deleting a post deletes its comments
+		comment.ID = uuid.New()
+		err = repo.CreateComment(context.Background(), comment)
+		assert.NoError(t, err)
+		err = repo.DeletePost(context.Background(), testPost.ID)
+		assert.NoError(t, err)
+		_, err = repo.GetComment(context.Background(), comment.ID)
+		assert.ErrorIs(t, err, ErrCommentNotFound)
+
+		err = repo.DeletePostGroup(context.Background(), postGroup.ID)
+		assert.NoError(t, err)
+	})
 }
 
 func setupUserAndBoard(t *testing.T, db *db.DB, testUser models.User, testBoard models.Board) {
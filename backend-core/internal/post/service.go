@@ -1,35 +1,107 @@
 package post
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/Wave-95/boards/backend-core/internal/board"
 	"github.com/Wave-95/boards/backend-core/internal/models"
+	"github.com/Wave-95/boards/backend-core/internal/storage"
 	"github.com/Wave-95/boards/backend-core/pkg/logger"
+	"github.com/Wave-95/boards/backend-core/pkg/markdown"
+	"github.com/Wave-95/boards/backend-notification/constants/payloads"
+	"github.com/Wave-95/boards/backend-notification/constants/queues"
+	"github.com/Wave-95/boards/backend-notification/constants/tasks"
+	"github.com/Wave-95/boards/wrappers/amqp"
 	"github.com/google/uuid"
 )
 
+var (
+	// ErrNotCommentAuthor is returned when a user tries to change a comment that someone else wrote.
+	ErrNotCommentAuthor = errors.New("Comment belongs to another user")
+	// ErrInvalidParentComment is returned when a reply refers to a comment on a different post.
+	ErrInvalidParentComment = errors.New("Parent comment belongs to another post")
+	// ErrAttachmentTooLarge is returned when an uploaded file is larger than MaxAttachmentSize.
+	ErrAttachmentTooLarge = errors.New("Attachment is too large")
+	// ErrUnsupportedFileType is returned when the content of an uploaded file is not one of the allowed types.
+	ErrUnsupportedFileType = errors.New("Unsupported file type")
+	// ErrNotAttachmentOwner is returned when a user tries to delete an attachment that someone else uploaded.
+	ErrNotAttachmentOwner = errors.New("Attachment belongs to another user")
+)
+
+// MaxAttachmentSize is the largest file in bytes that can be attached to a post.
+const MaxAttachmentSize = 10 << 20
+
+// attachmentTypes are the content types of the files that can be attached to a post. The type of a file is
+// detected from its content rather than trusted from the upload.
+var attachmentTypes = map[string]bool{
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+	"text/plain":      true,
+}
+
 // Service is an interface that represents all the post service capabilities.
 type Service interface {
 	CreatePost(ctx context.Context, input CreatePostInput) (models.Post, error)
 	GetPost(ctx context.Context, postID string) (models.Post, error)
-	ListPostGroups(ctx context.Context, boardID string) ([]GroupWithPostsDTO, error)
+	ListPostGroups(ctx context.Context, input ListPostGroupsInput) ([]GroupWithPostsDTO, error)
+	ListPosts(ctx context.Context, boardID string) ([]models.Post, error)
 	UpdatePost(ctx context.Context, input UpdatePostInput) (models.Post, error)
 	DeletePost(ctx context.Context, postID string) error
 	CreatePostGroup(ctx context.Context, input CreatePostGroupInput) (models.PostGroup, error)
 	GetPostGroup(ctx context.Context, postGroupID string) (models.PostGroup, error)
 	UpdatePostGroup(ctx context.Context, input UpdatePostGroupInput) (models.PostGroup, error)
 	DeletePostGroup(ctx context.Context, postGroupID string) error
+	Vote(ctx context.Context, input VoteInput) (VoteDTO, error)
+	Unvote(ctx context.Context, input VoteInput) (VoteDTO, error)
+	React(ctx context.Context, input ReactionInput) (ReactionDTO, error)
+	Unreact(ctx context.Context, input ReactionInput) (ReactionDTO, error)
+	Tag(ctx context.Context, input TagInput) (PostTagsDTO, error)
+	Untag(ctx context.Context, input TagInput) (PostTagsDTO, error)
+	CreateComment(ctx context.Context, input CreateCommentInput) (models.PostComment, error)
+	GetComment(ctx context.Context, commentID string) (models.PostComment, error)
+	ListComments(ctx context.Context, postID string) ([]CommentDTO, error)
+	UpdateComment(ctx context.Context, input UpdateCommentInput) (models.PostComment, error)
+	DeleteComment(ctx context.Context, input DeleteCommentInput) (models.PostComment, error)
+	CreateAttachment(ctx context.Context, input CreateAttachmentInput) (models.PostAttachment, error)
+	GetAttachment(ctx context.Context, attachmentID string) (models.PostAttachment, error)
+	ListAttachments(ctx context.Context, postID string) ([]models.PostAttachment, error)
+	OpenAttachment(ctx context.Context, attachment models.PostAttachment) (io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, input DeleteAttachmentInput) (models.PostAttachment, error)
+}
+
+// BoardService is an interface that represents the board service capabilities that the post service needs to
+// resolve mentions against the members of a board.
+type BoardService interface {
+	GetBoardWithMembers(ctx context.Context, boardID string) (board.BoardWithMembersDTO, error)
 }
 
 type service struct {
-	repo Repository
+	repo         Repository
+	storage      storage.Storage
+	boardService BoardService
+	amqp         amqp.Amqp
 }
 
-// NewService creates a service that implements the post Service interface.
-func NewService(repo Repository) *service {
-	return &service{repo: repo}
+// NewService creates a service that implements the post Service interface. The files attached to posts are kept
+// in the given storage, and the members mentioned in posts and comments are notified through amqp.
+func NewService(repo Repository, storage storage.Storage, boardService BoardService, amqp amqp.Amqp) *service {
+	return &service{repo: repo, storage: storage, boardService: boardService, amqp: amqp}
 }
 
 // CreatePost takes an input, validates it, and creates a new post
@@ -68,6 +140,11 @@ func (s *service) CreatePost(ctx context.Context, input CreatePostInput) (models
 			return models.Post{}, fmt.Errorf("service: failed to auto-generate post group: %w", err)
 		}
 		postGroupUUID = postGroup.ID
+	} else {
+		postGroupUUID, err = uuid.Parse(input.PostGroupID)
+		if err != nil {
+			return models.Post{}, fmt.Errorf("service: failed to parse post group ID: %w", err)
+		}
 	}
 
 	// Assign post order to 1 if order value is not provided
@@ -75,23 +152,31 @@ func (s *service) CreatePost(ctx context.Context, input CreatePostInput) (models
 		input.PostOrder = 1
 	}
 
+	// Posts are plain text unless a content format is provided
+	contentFormat := models.PostContentFormatPlain
+	if input.ContentFormat != "" {
+		contentFormat = models.PostContentFormat(input.ContentFormat)
+	}
+
 	// Create post
 	post := models.Post{
-		ID:          postUUID,
-		UserID:      userUUID,
-		Content:     input.Content,
-		Color:       input.Color,
-		Height:      input.Height,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-		PostOrder:   input.PostOrder,
-		PostGroupID: postGroupUUID,
+		ID:            postUUID,
+		UserID:        userUUID,
+		Content:       input.Content,
+		Color:         input.Color,
+		Height:        input.Height,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		PostOrder:     input.PostOrder,
+		PostGroupID:   postGroupUUID,
+		ContentFormat: contentFormat,
 	}
 	err = s.repo.CreatePost(ctx, post)
 	if err != nil {
 		logger.Errorf("service: failed to create post")
 		return models.Post{}, err
 	}
+	s.updatePostMentions(ctx, post)
 	return post, nil
 }
 
@@ -126,6 +211,9 @@ func (s *service) UpdatePost(ctx context.Context, input UpdatePostInput) (models
 	if input.Content != nil {
 		post.Content = *input.Content
 	}
+	if input.ContentFormat != nil {
+		post.ContentFormat = models.PostContentFormat(*input.ContentFormat)
+	}
 	if input.Color != nil {
 		post.Color = *input.Color
 	}
@@ -150,6 +238,9 @@ func (s *service) UpdatePost(ctx context.Context, input UpdatePostInput) (models
 		logger.Errorf("service: failed to update post")
 		return models.Post{}, err
 	}
+	if input.Content != nil {
+		s.updatePostMentions(ctx, post)
+	}
 	return post, nil
 }
 
@@ -161,7 +252,26 @@ func (s *service) DeletePost(ctx context.Context, postID string) error {
 		logger.Errorf("service: failed to parse post ID into UUID")
 		return err
 	}
-	return s.repo.DeletePost(ctx, postUUID)
+	attachments, err := s.repo.ListAttachments(ctx, postUUID)
+	if err != nil {
+		return fmt.Errorf("service: failed to list attachments: %w", err)
+	}
+	if err := s.repo.DeletePost(ctx, postUUID); err != nil {
+		return err
+	}
+	s.deleteAttachmentFiles(ctx, attachments)
+	return nil
+}
+
+// deleteAttachmentFiles removes the stored files of attachments whose records were deleted along with their
+// post or post group. A file that cannot be removed is only logged.
+func (s *service) deleteAttachmentFiles(ctx context.Context, attachments []models.PostAttachment) {
+	logger := logger.FromContext(ctx)
+	for _, attachment := range attachments {
+		if err := s.storage.Delete(ctx, attachment.StorageKey()); err != nil {
+			logger.Errorf("service: failed to delete attachment file %s: %v", attachment.ID, err)
+		}
+	}
 }
 
 // CreatePostGroup creates a single post group.
@@ -202,18 +312,600 @@ func (s *service) GetPostGroup(ctx context.Context, postGroupID string) (models.
 	return s.repo.GetPostGroup(ctx, postGroupUUID)
 }
 
-// ListPostGroups returns a list of post groups and their associated posts for a given board ID.
-func (s *service) ListPostGroups(ctx context.Context, boardID string) ([]GroupWithPostsDTO, error) {
+// ListPostGroups returns a list of post groups and their associated posts for a given board ID. Each post and
+// post group includes its vote count unless the vote counts are hidden. When the input has tag IDs, posts without
+// any of the tags are left out, but every post group is still listed. On anonymous boards, only the author of a
+// post can see who created it, and while posts are hidden only the author can see the content.
+func (s *service) ListPostGroups(ctx context.Context, input ListPostGroupsInput) ([]GroupWithPostsDTO, error) {
 	// Validate input
-	boardUUID, err := uuid.Parse(boardID)
+	boardUUID, err := uuid.Parse(input.BoardID)
 	if err != nil {
 		return []GroupWithPostsDTO{}, fmt.Errorf("service: failed to parse boardID into UUID: %w", err)
 	}
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return []GroupWithPostsDTO{}, fmt.Errorf("service: failed to parse userID into UUID: %w", err)
+	}
+	tagUUIDs := make([]uuid.UUID, len(input.TagIDs))
+	for i, tagID := range input.TagIDs {
+		if tagUUIDs[i], err = uuid.Parse(tagID); err != nil {
+			return []GroupWithPostsDTO{}, fmt.Errorf("service: failed to parse tagID into UUID: %w", err)
+		}
+	}
 	rows, err := s.repo.ListPostGroups(ctx, boardUUID)
 	if err != nil {
 		return []GroupWithPostsDTO{}, fmt.Errorf("service: failed to list post groups by board ID: %w", err)
 	}
-	return toDTOListPostGroups(rows), nil
+	postTags, err := s.repo.ListPostTags(ctx, boardUUID)
+	if err != nil {
+		return []GroupWithPostsDTO{}, fmt.Errorf("service: failed to list post tags by board ID: %w", err)
+	}
+	if len(tagUUIDs) > 0 {
+		rows = filterRowsByTags(rows, postTags, tagUUIDs)
+	}
+	voteCounts, err := s.repo.ListVoteCounts(ctx, boardUUID, userUUID)
+	if err != nil {
+		return []GroupWithPostsDTO{}, fmt.Errorf("service: failed to list vote counts by board ID: %w", err)
+	}
+	reactions, err := s.repo.ListReactions(ctx, boardUUID)
+	if err != nil {
+		return []GroupWithPostsDTO{}, fmt.Errorf("service: failed to list reactions by board ID: %w", err)
+	}
+	listDTO := toDTOListPostGroups(rows, voteCounts, reactions, postTags, input.HideVotes)
+	if err := renderPosts(listDTO); err != nil {
+		return []GroupWithPostsDTO{}, fmt.Errorf("service: failed to render post contents: %w", err)
+	}
+	if input.AnonymousPosts || input.HidePosts {
+		maskPosts(listDTO, userUUID, input.AnonymousPosts, input.HidePosts)
+	}
+	return listDTO, nil
+}
+
+// ListPosts returns all the posts on a board.
+func (s *service) ListPosts(ctx context.Context, boardID string) ([]models.Post, error) {
+	boardUUID, err := uuid.Parse(boardID)
+	if err != nil {
+		return []models.Post{}, fmt.Errorf("service: failed to parse boardID into UUID: %w", err)
+	}
+	rows, err := s.repo.ListPostGroups(ctx, boardUUID)
+	if err != nil {
+		return []models.Post{}, fmt.Errorf("service: failed to list post groups by board ID: %w", err)
+	}
+	posts := []models.Post{}
+	for _, row := range rows {
+		// Post groups without posts are listed with an empty post
+		if row.Post.ID != uuid.Nil {
+			posts = append(posts, row.Post)
+		}
+	}
+	return posts, nil
+}
+
+// React adds an emoji reaction to a post on behalf of a user.
+func (s *service) React(ctx context.Context, input ReactionInput) (ReactionDTO, error) {
+	if err := input.Validate(); err != nil {
+		return ReactionDTO{}, err
+	}
+	post, postGroup, userUUID, err := s.getReactionPost(ctx, input)
+	if err != nil {
+		return ReactionDTO{}, err
+	}
+	reaction := models.PostReaction{
+		ID:        uuid.New(),
+		PostID:    post.ID,
+		BoardID:   postGroup.BoardID,
+		UserID:    userUUID,
+		Emoji:     input.Emoji,
+		CreatedAt: time.Now(),
+	}
+	if err := s.repo.CreateReaction(ctx, reaction); err != nil {
+		return ReactionDTO{}, fmt.Errorf("service: failed to create reaction: %w", err)
+	}
+	return s.getReactions(ctx, post.ID, postGroup.BoardID)
+}
+
+// Unreact removes an emoji reaction that a user added to a post.
+func (s *service) Unreact(ctx context.Context, input ReactionInput) (ReactionDTO, error) {
+	if err := input.Validate(); err != nil {
+		return ReactionDTO{}, err
+	}
+	post, postGroup, userUUID, err := s.getReactionPost(ctx, input)
+	if err != nil {
+		return ReactionDTO{}, err
+	}
+	if err := s.repo.DeleteReaction(ctx, post.ID, userUUID, input.Emoji); err != nil {
+		return ReactionDTO{}, fmt.Errorf("service: failed to delete reaction: %w", err)
+	}
+	return s.getReactions(ctx, post.ID, postGroup.BoardID)
+}
+
+// Tag adds a tag from the tag catalog of a board to a post on the board.
+func (s *service) Tag(ctx context.Context, input TagInput) (PostTagsDTO, error) {
+	if err := input.Validate(); err != nil {
+		return PostTagsDTO{}, err
+	}
+	post, postGroup, tag, err := s.getTagPost(ctx, input)
+	if err != nil {
+		return PostTagsDTO{}, err
+	}
+	postTag := models.PostTag{
+		PostID:    post.ID,
+		TagID:     tag.ID,
+		BoardID:   postGroup.BoardID,
+		CreatedAt: time.Now(),
+	}
+	if err := s.repo.CreatePostTag(ctx, postTag); err != nil {
+		return PostTagsDTO{}, fmt.Errorf("service: failed to create post tag: %w", err)
+	}
+	return s.getPostTags(ctx, post.ID, postGroup.BoardID)
+}
+
+// Untag removes a tag from a post.
+func (s *service) Untag(ctx context.Context, input TagInput) (PostTagsDTO, error) {
+	if err := input.Validate(); err != nil {
+		return PostTagsDTO{}, err
+	}
+	post, postGroup, tag, err := s.getTagPost(ctx, input)
+	if err != nil {
+		return PostTagsDTO{}, err
+	}
+	if err := s.repo.DeletePostTag(ctx, post.ID, tag.ID); err != nil {
+		return PostTagsDTO{}, fmt.Errorf("service: failed to delete post tag: %w", err)
+	}
+	return s.getPostTags(ctx, post.ID, postGroup.BoardID)
+}
+
+// getTagPost returns the post that a tag input refers to along with its post group and the tag. ErrTagNotFound
+// is returned if the tag is not in the tag catalog of the board of the post.
+func (s *service) getTagPost(ctx context.Context, input TagInput) (models.Post, models.PostGroup, models.BoardTag, error) {
+	post, postGroup, _, err := s.getUserPost(ctx, input.PostID, input.UserID)
+	if err != nil {
+		return models.Post{}, models.PostGroup{}, models.BoardTag{}, err
+	}
+	tagUUID, err := uuid.Parse(input.TagID)
+	if err != nil {
+		return models.Post{}, models.PostGroup{}, models.BoardTag{}, fmt.Errorf("service: failed to parse tag ID into UUID: %w", err)
+	}
+	tag, err := s.repo.GetTag(ctx, tagUUID)
+	if err != nil {
+		return models.Post{}, models.PostGroup{}, models.BoardTag{}, fmt.Errorf("service: failed to get tag: %w", err)
+	}
+	if tag.BoardID != postGroup.BoardID {
+		return models.Post{}, models.PostGroup{}, models.BoardTag{}, ErrTagNotFound
+	}
+	return post, postGroup, tag, nil
+}
+
+// getPostTags returns the IDs of the tags on a post.
+func (s *service) getPostTags(ctx context.Context, postID uuid.UUID, boardID uuid.UUID) (PostTagsDTO, error) {
+	postTags, err := s.repo.ListPostTags(ctx, boardID)
+	if err != nil {
+		return PostTagsDTO{}, fmt.Errorf("service: failed to list post tags: %w", err)
+	}
+	tagIDs := postTags[postID]
+	if tagIDs == nil {
+		tagIDs = []uuid.UUID{}
+	}
+	return PostTagsDTO{PostID: postID, BoardID: boardID, TagIDs: tagIDs}, nil
+}
+
+// CreateComment adds a comment to a post, or a reply to another comment on the post.
+func (s *service) CreateComment(ctx context.Context, input CreateCommentInput) (models.PostComment, error) {
+	if err := input.Validate(); err != nil {
+		return models.PostComment{}, err
+	}
+	post, postGroup, userUUID, err := s.getUserPost(ctx, input.PostID, input.UserID)
+	if err != nil {
+		return models.PostComment{}, err
+	}
+	now := time.Now()
+	comment := models.PostComment{
+		ID:        uuid.New(),
+		PostID:    post.ID,
+		BoardID:   postGroup.BoardID,
+		UserID:    userUUID,
+		Content:   input.Content,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if input.ParentID != "" {
+		parent, err := s.GetComment(ctx, input.ParentID)
+		if err != nil {
+			return models.PostComment{}, err
+		}
+		if parent.PostID != post.ID {
+			return models.PostComment{}, ErrInvalidParentComment
+		}
+		comment.ParentID = &parent.ID
+	}
+	if err := s.repo.CreateComment(ctx, comment); err != nil {
+		return models.PostComment{}, fmt.Errorf("service: failed to create comment: %w", err)
+	}
+	s.updateCommentMentions(ctx, comment)
+	return comment, nil
+}
+
+// GetComment returns a single comment.
+func (s *service) GetComment(ctx context.Context, commentID string) (models.PostComment, error) {
+	commentUUID, err := uuid.Parse(commentID)
+	if err != nil {
+		return models.PostComment{}, fmt.Errorf("service: failed to parse comment ID into UUID: %w", err)
+	}
+	comment, err := s.repo.GetComment(ctx, commentUUID)
+	if err != nil {
+		return models.PostComment{}, fmt.Errorf("service: failed to get comment: %w", err)
+	}
+	return comment, nil
+}
+
+// ListComments returns the comment threads on a post, oldest first.
+func (s *service) ListComments(ctx context.Context, postID string) ([]CommentDTO, error) {
+	postUUID, err := uuid.Parse(postID)
+	if err != nil {
+		return []CommentDTO{}, fmt.Errorf("service: failed to parse post ID into UUID: %w", err)
+	}
+	comments, err := s.repo.ListComments(ctx, postUUID)
+	if err != nil {
+		return []CommentDTO{}, fmt.Errorf("service: failed to list comments: %w", err)
+	}
+	return toDTOListComments(comments), nil
+}
+
+// UpdateComment edits the content of a comment. Only the author of a comment can edit it.
+func (s *service) UpdateComment(ctx context.Context, input UpdateCommentInput) (models.PostComment, error) {
+	if err := input.Validate(); err != nil {
+		return models.PostComment{}, err
+	}
+	comment, err := s.getAuthoredComment(ctx, input.CommentID, input.UserID)
+	if err != nil {
+		return models.PostComment{}, err
+	}
+	comment.Content = input.Content
+	comment.UpdatedAt = time.Now()
+	if err := s.repo.UpdateComment(ctx, comment); err != nil {
+		return models.PostComment{}, fmt.Errorf("service: failed to update comment: %w", err)
+	}
+	s.updateCommentMentions(ctx, comment)
+	return comment, nil
+}
+
+// DeleteComment deletes a comment along with its replies and returns the deleted comment. Only the author of a
+// comment can delete it.
+func (s *service) DeleteComment(ctx context.Context, input DeleteCommentInput) (models.PostComment, error) {
+	if err := input.Validate(); err != nil {
+		return models.PostComment{}, err
+	}
+	comment, err := s.getAuthoredComment(ctx, input.CommentID, input.UserID)
+	if err != nil {
+		return models.PostComment{}, err
+	}
+	if err := s.repo.DeleteComment(ctx, comment.ID); err != nil {
+		return models.PostComment{}, fmt.Errorf("service: failed to delete comment: %w", err)
+	}
+	return comment, nil
+}
+
+// getAuthoredComment returns a comment if it was written by the user.
+func (s *service) getAuthoredComment(ctx context.Context, commentID string, userID string) (models.PostComment, error) {
+	comment, err := s.GetComment(ctx, commentID)
+	if err != nil {
+		return models.PostComment{}, err
+	}
+	if comment.UserID.String() != userID {
+		return models.PostComment{}, ErrNotCommentAuthor
+	}
+	return comment, nil
+}
+
+// CreateAttachment stores an uploaded file and attaches it to a post. The file is rejected if it is too large or
+// if its content is not one of the allowed types.
+func (s *service) CreateAttachment(ctx context.Context, input CreateAttachmentInput) (models.PostAttachment, error) {
+	if err := input.Validate(); err != nil {
+		return models.PostAttachment{}, err
+	}
+	if input.Size > MaxAttachmentSize {
+		return models.PostAttachment{}, ErrAttachmentTooLarge
+	}
+	post, postGroup, userUUID, err := s.getUserPost(ctx, input.PostID, input.UserID)
+	if err != nil {
+		return models.PostAttachment{}, err
+	}
+	// Sniff the content type from the start of the file, then put the sniffed bytes back in front of the rest
+	head := make([]byte, 512)
+	n, err := io.ReadFull(input.File, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return models.PostAttachment{}, fmt.Errorf("service: failed to read attachment: %w", err)
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil || !attachmentTypes[contentType] {
+		return models.PostAttachment{}, ErrUnsupportedFileType
+	}
+	attachment := models.PostAttachment{
+		ID:          uuid.New(),
+		PostID:      post.ID,
+		BoardID:     postGroup.BoardID,
+		UserID:      userUUID,
+		FileName:    path.Base(strings.ReplaceAll(input.FileName, "\\", "/")),
+		ContentType: contentType,
+		Size:        input.Size,
+		CreatedAt:   time.Now(),
+	}
+	file := io.MultiReader(bytes.NewReader(head[:n]), input.File)
+	if err := s.storage.Put(ctx, attachment.StorageKey(), file, attachment.Size, attachment.ContentType); err != nil {
+		return models.PostAttachment{}, fmt.Errorf("service: failed to store attachment: %w", err)
+	}
+	if err := s.repo.CreateAttachment(ctx, attachment); err != nil {
+		if err := s.storage.Delete(ctx, attachment.StorageKey()); err != nil {
+			logger.FromContext(ctx).Errorf("service: failed to delete attachment file %s: %v", attachment.ID, err)
+		}
+		return models.PostAttachment{}, fmt.Errorf("service: failed to create attachment: %w", err)
+	}
+	return attachment, nil
+}
+
+// GetAttachment returns a single attachment.
+func (s *service) GetAttachment(ctx context.Context, attachmentID string) (models.PostAttachment, error) {
+	attachmentUUID, err := uuid.Parse(attachmentID)
+	if err != nil {
+		return models.PostAttachment{}, fmt.Errorf("service: failed to parse attachment ID into UUID: %w", err)
+	}
+	attachment, err := s.repo.GetAttachment(ctx, attachmentUUID)
+	if err != nil {
+		return models.PostAttachment{}, fmt.Errorf("service: failed to get attachment: %w", err)
+	}
+	return attachment, nil
+}
+
+// ListAttachments returns the attachments of a post, oldest first.
+func (s *service) ListAttachments(ctx context.Context, postID string) ([]models.PostAttachment, error) {
+	postUUID, err := uuid.Parse(postID)
+	if err != nil {
+		return []models.PostAttachment{}, fmt.Errorf("service: failed to parse post ID into UUID: %w", err)
+	}
+	attachments, err := s.repo.ListAttachments(ctx, postUUID)
+	if err != nil {
+		return []models.PostAttachment{}, fmt.Errorf("service: failed to list attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+// OpenAttachment returns the content of an attachment. The caller must close it.
+func (s *service) OpenAttachment(ctx context.Context, attachment models.PostAttachment) (io.ReadCloser, error) {
+	file, err := s.storage.Get(ctx, attachment.StorageKey())
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to open attachment: %w", err)
+	}
+	return file, nil
+}
+
+// DeleteAttachment deletes an attachment along with its file and returns the deleted attachment. Only the
+// uploader of an attachment or an admin of the board can delete it.
+func (s *service) DeleteAttachment(ctx context.Context, input DeleteAttachmentInput) (models.PostAttachment, error) {
+	if err := input.Validate(); err != nil {
+		return models.PostAttachment{}, err
+	}
+	attachment, err := s.GetAttachment(ctx, input.AttachmentID)
+	if err != nil {
+		return models.PostAttachment{}, err
+	}
+	if attachment.UserID.String() != input.UserID && !input.IsAdmin {
+		return models.PostAttachment{}, ErrNotAttachmentOwner
+	}
+	if err := s.repo.DeleteAttachment(ctx, attachment.ID); err != nil {
+		return models.PostAttachment{}, fmt.Errorf("service: failed to delete attachment: %w", err)
+	}
+	// The attachment is gone once its record is deleted, so a file that cannot be removed is only logged
+	if err := s.storage.Delete(ctx, attachment.StorageKey()); err != nil {
+		logger.FromContext(ctx).Errorf("service: failed to delete attachment file %s: %v", attachment.ID, err)
+	}
+	return attachment, nil
+}
+
+// updatePostMentions updates the mentions in the content of a post.
+func (s *service) updatePostMentions(ctx context.Context, post models.Post) {
+	postGroup, err := s.repo.GetPostGroup(ctx, post.PostGroupID)
+	if err != nil {
+		logger.FromContext(ctx).Errorf("service: failed to get post group for mentions: %v", err)
+		return
+	}
+	s.updateMentions(ctx, models.PostMention{PostID: post.ID, BoardID: postGroup.BoardID, MentionedBy: post.UserID}, post.Content)
+}
+
+// updateCommentMentions updates the mentions in the content of a comment.
+func (s *service) updateCommentMentions(ctx context.Context, comment models.PostComment) {
+	source := models.PostMention{PostID: comment.PostID, CommentID: &comment.ID, BoardID: comment.BoardID, MentionedBy: comment.UserID}
+	s.updateMentions(ctx, source, comment.Content)
+}
+
+// updateMentions records the board members that are mentioned in the content of a post or a comment, and
+// notifies the members that were not mentioned in it before. Mentions that were removed from the content are
+// deleted. The source holds the post, comment, board, and author that the mentions are recorded with. Since the
+// post or comment has already been saved, failures are logged rather than returned.
+func (s *service) updateMentions(ctx context.Context, source models.PostMention, content string) {
+	logger := logger.FromContext(ctx)
+	existing, err := s.repo.ListMentions(ctx, source.PostID)
+	if err != nil {
+		logger.Errorf("service: failed to list mentions: %v", err)
+		return
+	}
+
+	// Only look up the members of the board when the content could mention one of them
+	var boardWithMembers board.BoardWithMembersDTO
+	var members []board.MemberDTO
+	mentioned := make(map[uuid.UUID]bool)
+	if strings.Contains(content, "@") {
+		boardWithMembers, err = s.boardService.GetBoardWithMembers(ctx, source.BoardID.String())
+		if err != nil {
+			logger.Errorf("service: failed to get board with members for mentions: %v", err)
+			return
+		}
+		for _, member := range parseMentions(content, boardWithMembers.Members) {
+			if member.ID != source.MentionedBy {
+				members = append(members, member)
+				mentioned[member.ID] = true
+			}
+		}
+	}
+
+	// Keep the mentions that are still in the content, so that members are only notified once
+	for _, mention := range existing {
+		if !sameMentionSource(mention, source) {
+			continue
+		}
+		if mentioned[mention.UserID] {
+			delete(mentioned, mention.UserID)
+			continue
+		}
+		if err := s.repo.DeleteMention(ctx, mention.ID); err != nil {
+			logger.Errorf("service: failed to delete mention: %v", err)
+		}
+	}
+
+	users := []payloads.User{}
+	now := time.Now()
+	for _, member := range members {
+		if !mentioned[member.ID] {
+			continue
+		}
+		mention := source
+		mention.ID = uuid.New()
+		mention.UserID = member.ID
+		mention.CreatedAt = now
+		if err := s.repo.CreateMention(ctx, mention); err != nil {
+			logger.Errorf("service: failed to create mention: %v", err)
+			continue
+		}
+		users = append(users, board.ToUserPayload(member))
+	}
+	if len(users) == 0 {
+		return
+	}
+
+	// The author of a post on an anonymous board is left out of the notification
+	mentionedBy := ""
+	if !boardWithMembers.AnonymousPosts || source.CommentID != nil {
+		for _, member := range boardWithMembers.Members {
+			if member.ID == source.MentionedBy {
+				mentionedBy = member.Name
+			}
+		}
+	}
+	boardName := ""
+	if boardWithMembers.Name != nil {
+		boardName = *boardWithMembers.Name
+	}
+	s.amqp.Publish(queues.Notification, tasks.EmailMention, payloads.Mention{
+		BoardID:     boardWithMembers.ID.String(),
+		BoardName:   boardName,
+		MentionedBy: mentionedBy,
+		InComment:   source.CommentID != nil,
+		Users:       users,
+	})
+}
+
+// sameMentionSource reports whether two mentions were made in the same post or comment.
+func sameMentionSource(a, b models.PostMention) bool {
+	if a.CommentID == nil || b.CommentID == nil {
+		return a.PostID == b.PostID && a.CommentID == nil && b.CommentID == nil
+	}
+	return *a.CommentID == *b.CommentID
+}
+
+// getReactionPost returns the post that a reaction input refers to along with its post group, which determines
+// the board of the reaction.
+func (s *service) getReactionPost(ctx context.Context, input ReactionInput) (models.Post, models.PostGroup, uuid.UUID, error) {
+	return s.getUserPost(ctx, input.PostID, input.UserID)
+}
+
+// getReactions returns the reaction summaries of a post.
+func (s *service) getReactions(ctx context.Context, postID uuid.UUID, boardID uuid.UUID) (ReactionDTO, error) {
+	reactions, err := s.repo.ListReactions(ctx, boardID)
+	if err != nil {
+		return ReactionDTO{}, fmt.Errorf("service: failed to list reactions: %w", err)
+	}
+	summaries := reactions[postID]
+	if summaries == nil {
+		summaries = []ReactionSummary{}
+	}
+	return ReactionDTO{PostID: postID, BoardID: boardID, Reactions: summaries}, nil
+}
+
+// Vote casts a vote on a post on behalf of a user. Users can cast several votes on the same post, but no more
+// than the vote limit across all posts of a board.
+func (s *service) Vote(ctx context.Context, input VoteInput) (VoteDTO, error) {
+	if err := input.Validate(); err != nil {
+		return VoteDTO{}, err
+	}
+	post, postGroup, userUUID, err := s.getUserPost(ctx, input.PostID, input.UserID)
+	if err != nil {
+		return VoteDTO{}, err
+	}
+	vote := models.PostVote{
+		ID:        uuid.New(),
+		PostID:    post.ID,
+		BoardID:   postGroup.BoardID,
+		UserID:    userUUID,
+		CreatedAt: time.Now(),
+	}
+	if err := s.repo.CreateVote(ctx, vote, input.VoteLimit); err != nil {
+		return VoteDTO{}, fmt.Errorf("service: failed to create vote: %w", err)
+	}
+	return s.getVotes(ctx, post.ID, postGroup.BoardID, userUUID, input.VoteLimit)
+}
+
+// Unvote takes back the latest vote that a user cast on a post.
+func (s *service) Unvote(ctx context.Context, input VoteInput) (VoteDTO, error) {
+	if err := input.Validate(); err != nil {
+		return VoteDTO{}, err
+	}
+	post, postGroup, userUUID, err := s.getUserPost(ctx, input.PostID, input.UserID)
+	if err != nil {
+		return VoteDTO{}, err
+	}
+	if err := s.repo.DeleteVote(ctx, post.ID, userUUID); err != nil {
+		return VoteDTO{}, fmt.Errorf("service: failed to delete vote: %w", err)
+	}
+	return s.getVotes(ctx, post.ID, postGroup.BoardID, userUUID, input.VoteLimit)
+}
+
+// getUserPost parses the ID of the user acting on a post and returns the post along with its post group.
+func (s *service) getUserPost(ctx context.Context, postID string, userID string) (models.Post, models.PostGroup, uuid.UUID, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return models.Post{}, models.PostGroup{}, uuid.Nil, fmt.Errorf("service: failed to parse user ID into UUID: %w", err)
+	}
+	post, err := s.GetPost(ctx, postID)
+	if err != nil {
+		return models.Post{}, models.PostGroup{}, uuid.Nil, fmt.Errorf("service: failed to get post: %w", err)
+	}
+	postGroup, err := s.repo.GetPostGroup(ctx, post.PostGroupID)
+	if err != nil {
+		return models.Post{}, models.PostGroup{}, uuid.Nil, fmt.Errorf("service: failed to get post group: %w", err)
+	}
+	return post, postGroup, userUUID, nil
+}
+
+// getVotes returns the vote counts of a post along with the number of votes that a user has left on the board.
+func (s *service) getVotes(ctx context.Context, postID uuid.UUID, boardID uuid.UUID, userID uuid.UUID, voteLimit int) (VoteDTO, error) {
+	voteCounts, err := s.repo.ListVoteCounts(ctx, boardID, userID)
+	if err != nil {
+		return VoteDTO{}, fmt.Errorf("service: failed to list vote counts: %w", err)
+	}
+	usedVotes := 0
+	for _, count := range voteCounts {
+		usedVotes += count.UserVotes
+	}
+	remainingVotes := voteLimit - usedVotes
+	if remainingVotes < 0 {
+		remainingVotes = 0
+	}
+	return VoteDTO{
+		PostID:         postID,
+		BoardID:        boardID,
+		Votes:          voteCounts[postID].Votes,
+		UserVotes:      voteCounts[postID].UserVotes,
+		RemainingVotes: remainingVotes,
+	}, nil
 }
 
 // UpdatePostGroup takes an update request and applies the updates to an existing post group.
@@ -260,11 +952,44 @@ func (s *service) DeletePostGroup(ctx context.Context, postGroupID string) error
 	if err != nil {
 		return fmt.Errorf("service: failed to parse ID into UUID: %w", err)
 	}
-	return s.repo.DeletePostGroup(ctx, postGroupUUID)
+	attachments, err := s.repo.ListAttachmentsByPostGroup(ctx, postGroupUUID)
+	if err != nil {
+		return fmt.Errorf("service: failed to list attachments: %w", err)
+	}
+	if err := s.repo.DeletePostGroup(ctx, postGroupUUID); err != nil {
+		return err
+	}
+	s.deleteAttachmentFiles(ctx, attachments)
+	return nil
 }
 
-// toDTOListPostGroups converts the repository data structure into a nested DTO structure.
-func toDTOListPostGroups(rows []GroupAndPost) []GroupWithPostsDTO {
+// filterRowsByTags leaves out the posts that have none of the given tags. The post groups of the posts that are
+// left out stay in the rows, so that they are still listed.
+func filterRowsByTags(rows []GroupAndPost, postTags map[uuid.UUID][]uuid.UUID, tagIDs []uuid.UUID) []GroupAndPost {
+	wanted := make(map[uuid.UUID]bool)
+	for _, tagID := range tagIDs {
+		wanted[tagID] = true
+	}
+	filtered := make([]GroupAndPost, len(rows))
+	for i, row := range rows {
+		filtered[i] = row
+		hasTag := false
+		for _, tagID := range postTags[row.Post.ID] {
+			if wanted[tagID] {
+				hasTag = true
+				break
+			}
+		}
+		if !hasTag {
+			filtered[i].Post = models.Post{}
+		}
+	}
+	return filtered
+}
+
+// toDTOListPostGroups converts the repository data structure into a nested DTO structure. Vote counts are left
+// out when hideVotes is true.
+func toDTOListPostGroups(rows []GroupAndPost, voteCounts map[uuid.UUID]VoteCount, reactions map[uuid.UUID][]ReactionSummary, postTags map[uuid.UUID][]uuid.UUID, hideVotes bool) []GroupWithPostsDTO {
 	listDTO := []GroupWithPostsDTO{}
 	parentIndex := make(map[uuid.UUID]int)
 	for _, row := range rows {
@@ -278,15 +1003,175 @@ func toDTOListPostGroups(rows []GroupAndPost) []GroupWithPostsDTO {
 				PosX:      row.PostGroup.PosX,
 				PosY:      row.PostGroup.PosY,
 				ZIndex:    row.PostGroup.ZIndex,
-				Posts:     []models.Post{},
+				Posts:     []PostDTO{},
 				CreatedAt: row.PostGroup.CreatedAt,
 				UpdatedAt: row.PostGroup.UpdatedAt,
 			}
+			if !hideVotes {
+				item.Votes = new(int)
+			}
 			listDTO = append(listDTO, item)
 		}
+		// Post groups without posts are listed with an empty post
+		if row.Post.ID == uuid.Nil {
+			continue
+		}
 		// Nest child into parent
 		index := parentIndex[row.PostGroup.ID]
-		listDTO[index].Posts = append(listDTO[index].Posts, row.Post)
+		userID, content := row.Post.UserID, row.Post.Content
+		post := PostDTO{Post: row.Post, UserID: &userID, Content: &content, UserVotes: voteCounts[row.Post.ID].UserVotes, Reactions: reactions[row.Post.ID]}
+		if post.Reactions == nil {
+			post.Reactions = []ReactionSummary{}
+		}
+		post.TagIDs = postTags[row.Post.ID]
+		if post.TagIDs == nil {
+			post.TagIDs = []uuid.UUID{}
+		}
+		if !hideVotes {
+			votes := voteCounts[row.Post.ID].Votes
+			post.Votes = &votes
+			*listDTO[index].Votes += votes
+		}
+		listDTO[index].Posts = append(listDTO[index].Posts, post)
 	}
 	return listDTO
 }
+
+// renderPosts renders the content of every listed post as HTML.
+func renderPosts(listDTO []GroupWithPostsDTO) error {
+	for i := range listDTO {
+		for j := range listDTO[i].Posts {
+			post := &listDTO[i].Posts[j]
+			contentHTML, err := renderContent(post.Post)
+			if err != nil {
+				return err
+			}
+			post.ContentHTML = &contentHTML
+		}
+	}
+	return nil
+}
+
+// renderContent renders the content of a post as sanitized HTML. Markdown is rendered as such, while plain text
+// is escaped and its line breaks are kept.
+func renderContent(post models.Post) (string, error) {
+	if post.ContentFormat == models.PostContentFormatMarkdown {
+		return markdown.ToHTML(post.Content)
+	}
+	if post.Content == "" {
+		return "", nil
+	}
+	return "<p>" + strings.ReplaceAll(html.EscapeString(post.Content), "\n", "<br>\n") + "</p>\n", nil
+}
+
+// maskPosts leaves out the author, the content, or both of every listed post that was not created by the given
+// user.
+func maskPosts(listDTO []GroupWithPostsDTO, userID uuid.UUID, hideAuthor, hideContent bool) {
+	for i := range listDTO {
+		for j := range listDTO[i].Posts {
+			post := &listDTO[i].Posts[j]
+			if post.Post.UserID == userID {
+				continue
+			}
+			if hideAuthor {
+				post.UserID = nil
+			}
+			if hideContent {
+				post.Content = nil
+				post.ContentHTML = nil
+			}
+		}
+	}
+}
+
+// toDTOListComments arranges a list of comments, ordered oldest first, into threads of replies.
+func toDTOListComments(comments []models.PostComment) []CommentDTO {
+	replies := make(map[uuid.UUID][]models.PostComment)
+	roots := []models.PostComment{}
+	for _, comment := range comments {
+		if comment.ParentID == nil {
+			roots = append(roots, comment)
+			continue
+		}
+		replies[*comment.ParentID] = append(replies[*comment.ParentID], comment)
+	}
+	var toThread func(comment models.PostComment) CommentDTO
+	toThread = func(comment models.PostComment) CommentDTO {
+		thread := CommentDTO{PostComment: comment, Replies: []CommentDTO{}}
+		for _, reply := range replies[comment.ID] {
+			thread.Replies = append(thread.Replies, toThread(reply))
+		}
+		return thread
+	}
+	threads := make([]CommentDTO, len(roots))
+	for i, root := range roots {
+		threads[i] = toThread(root)
+	}
+	return threads
+}
+
+// parseMentions returns the board members that are mentioned in some content with an @ followed by their name,
+// such as "@Ann Lee". Names are matched case-insensitively and must not run into a letter or digit, and an @ that
+// follows a letter or digit, as in an email address, does not start a mention.
+func parseMentions(content string, members []board.MemberDTO) []board.MemberDTO {
+	// Longer names are matched first so that "@Ann Lee" mentions Ann Lee rather than Ann
+	candidates := make([]board.MemberDTO, 0, len(members))
+	for _, member := range members {
+		if member.Name != "" {
+			candidates = append(candidates, member)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return utf8.RuneCountInString(candidates[i].Name) > utf8.RuneCountInString(candidates[j].Name)
+	})
+
+	mentioned := []board.MemberDTO{}
+	seen := make(map[uuid.UUID]bool)
+	for i := 0; i < len(content); i++ {
+		if content[i] != '@' {
+			continue
+		}
+		if before, _ := utf8.DecodeLastRuneInString(content[:i]); i > 0 && isNameRune(before) {
+			continue
+		}
+		rest := content[i+1:]
+		for _, member := range candidates {
+			n, ok := matchName(rest, member.Name)
+			if !ok {
+				continue
+			}
+			if after, _ := utf8.DecodeRuneInString(rest[n:]); len(rest) > n && isNameRune(after) {
+				continue
+			}
+			if !seen[member.ID] {
+				seen[member.ID] = true
+				mentioned = append(mentioned, member)
+			}
+			break
+		}
+	}
+	return mentioned
+}
+
+// matchName reports whether content starts with the name under case folding and returns the number of bytes
+// of content that the name spans. Runes are compared one by one since a case folded rune can be encoded with a
+// different number of bytes than the rune in the name.
+func matchName(content string, name string) (int, bool) {
+	n := 0
+	for _, nameRune := range name {
+		if n >= len(content) {
+			return 0, false
+		}
+		r, size := utf8.DecodeRuneInString(content[n:])
+		if !strings.EqualFold(string(r), string(nameRune)) {
+			return 0, false
+		}
+		n += size
+	}
+	return n, true
+}
+
+// isNameRune reports whether a rune can be part of a name or an email address.
+func isNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
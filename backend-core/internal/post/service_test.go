@@ -1,17 +1,45 @@
 package post
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/Wave-95/boards/backend-core/internal/board"
 	"github.com/Wave-95/boards/backend-core/internal/models"
+	"github.com/Wave-95/boards/backend-core/internal/storage"
+	"github.com/Wave-95/boards/backend-core/internal/test"
+	"github.com/Wave-95/boards/backend-core/pkg/validator"
+	"github.com/Wave-95/boards/backend-notification/constants/payloads"
+	"github.com/Wave-95/boards/backend-notification/constants/tasks"
+	"github.com/Wave-95/boards/wrappers/amqp"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
+// publishRecorder is an amqp mock that records the tasks that are published.
+type publishRecorder struct {
+	amqp.Amqp
+	tasks    []string
+	payloads []any
+}
+
+func (p *publishRecorder) Publish(queue string, task string, v any) error {
+	p.tasks = append(p.tasks, task)
+	p.payloads = append(p.payloads, v)
+	return nil
+}
+
 func TestService(t *testing.T) {
 	mockPostRepo := NewMockRepository()
-	service := NewService(mockPostRepo)
+	attachmentStorage := storage.NewLocal(t.TempDir())
+	mockBoardRepo := board.NewMockRepository()
+	boardService := board.NewService(mockBoardRepo, attachmentStorage, amqp.NewMock(), validator.New(), time.Hour)
+	publisher := &publishRecorder{Amqp: amqp.NewMock()}
+	service := NewService(mockPostRepo, attachmentStorage, boardService, publisher)
 	assert.NotNil(t, service)
 
 	t.Run("Create, get, update, and delete post", func(t *testing.T) {
@@ -47,4 +75,724 @@ func TestService(t *testing.T) {
 		err = service.DeletePost(context.Background(), post.ID.String())
 		assert.NoError(t, err)
 	})
+
+	t.Run("Create post in existing post group", func(t *testing.T) {
+		boardID := uuid.New().String()
+		postGroup, err := service.CreatePostGroup(context.Background(), CreatePostGroupInput{BoardID: boardID, PosX: 10, PosY: 10, ZIndex: 1})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test post group")
+		}
+		createInput := CreatePostInput{
+			UserID:      uuid.New().String(),
+			BoardID:     boardID,
+			Content:     "This post joins an existing group",
+			PosX:        10,
+			PosY:        10,
+			Color:       models.PostColorLightPink,
+			ZIndex:      1,
+			PostGroupID: postGroup.ID.String(),
+		}
+		post, err := service.CreatePost(context.Background(), createInput)
+		assert.NoError(t, err)
+		assert.Equal(t, postGroup.ID, post.PostGroupID)
+	})
+
+	t.Run("Markdown posts", func(t *testing.T) {
+		boardID := uuid.New().String()
+		userID := uuid.New().String()
+		createInput := CreatePostInput{
+			UserID:        userID,
+			BoardID:       boardID,
+			Content:       "**Ship it** <script>alert(1)</script>",
+			ContentFormat: string(models.PostContentFormatMarkdown),
+			PosX:          10,
+			PosY:          10,
+			Color:         models.PostColorLightPink,
+			ZIndex:        1,
+		}
+		post, err := service.CreatePost(context.Background(), createInput)
+		assert.NoError(t, err)
+		assert.Equal(t, models.PostContentFormatMarkdown, post.ContentFormat)
+
+		t.Run("list rendered html", func(t *testing.T) {
+			postGroups, err := service.ListPostGroups(context.Background(), ListPostGroupsInput{BoardID: boardID, UserID: userID})
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) && assert.Len(t, postGroups[0].Posts, 1) {
+				contentHTML := *postGroups[0].Posts[0].ContentHTML
+				assert.Contains(t, contentHTML, "<strong>Ship it</strong>")
+				assert.NotContains(t, contentHTML, "<script>")
+			}
+		})
+
+		t.Run("switch to plain text", func(t *testing.T) {
+			plain := string(models.PostContentFormatPlain)
+			updatedPost, err := service.UpdatePost(context.Background(), UpdatePostInput{ID: post.ID.String(), ContentFormat: &plain})
+			assert.NoError(t, err)
+			assert.Equal(t, models.PostContentFormatPlain, updatedPost.ContentFormat)
+
+			postGroups, err := service.ListPostGroups(context.Background(), ListPostGroupsInput{BoardID: boardID, UserID: userID})
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) && assert.Len(t, postGroups[0].Posts, 1) {
+				assert.Equal(t, "<p>**Ship it** &lt;script&gt;alert(1)&lt;/script&gt;</p>\n", *postGroups[0].Posts[0].ContentHTML)
+			}
+		})
+
+		t.Run("invalid format and content length", func(t *testing.T) {
+			invalidInput := createInput
+			invalidInput.ContentFormat = "HTML"
+			_, err := service.CreatePost(context.Background(), invalidInput)
+			assert.True(t, validator.IsValidationError(err))
+
+			longContent := strings.Repeat("a", 2001)
+			_, err = service.UpdatePost(context.Background(), UpdatePostInput{ID: post.ID.String(), Content: &longContent})
+			assert.True(t, validator.IsValidationError(err))
+		})
+	})
+
+	t.Run("List post groups without posts", func(t *testing.T) {
+		boardID := uuid.New().String()
+		postGroup, err := service.CreatePostGroup(context.Background(), CreatePostGroupInput{BoardID: boardID, PosX: 10, PosY: 10, ZIndex: 1})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test post group")
+		}
+		postGroups, err := service.ListPostGroups(context.Background(), ListPostGroupsInput{BoardID: boardID, UserID: uuid.New().String()})
+		assert.NoError(t, err)
+		if assert.Len(t, postGroups, 1) {
+			assert.Equal(t, postGroup.ID, postGroups[0].ID)
+			assert.Empty(t, postGroups[0].Posts)
+		}
+	})
+
+	t.Run("List post groups on anonymous board", func(t *testing.T) {
+		boardID := uuid.New().String()
+		authorID := uuid.New().String()
+		createInput := CreatePostInput{
+			UserID:  authorID,
+			BoardID: boardID,
+			Content: "Anonymous post",
+			PosX:    10,
+			PosY:    10,
+			Color:   models.PostColorLightPink,
+			ZIndex:  1,
+		}
+		post, err := service.CreatePost(context.Background(), createInput)
+		if err != nil {
+			assert.FailNow(t, "Failed to create test post")
+		}
+
+		t.Run("as author", func(t *testing.T) {
+			input := ListPostGroupsInput{BoardID: boardID, UserID: authorID, AnonymousPosts: true}
+			postGroups, err := service.ListPostGroups(context.Background(), input)
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) && assert.Len(t, postGroups[0].Posts, 1) {
+				assert.Equal(t, post.UserID, *postGroups[0].Posts[0].UserID)
+			}
+		})
+
+		t.Run("as another member", func(t *testing.T) {
+			input := ListPostGroupsInput{BoardID: boardID, UserID: uuid.New().String(), AnonymousPosts: true}
+			postGroups, err := service.ListPostGroups(context.Background(), input)
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) && assert.Len(t, postGroups[0].Posts, 1) {
+				assert.Nil(t, postGroups[0].Posts[0].UserID)
+			}
+		})
+	})
+
+	t.Run("List hidden posts", func(t *testing.T) {
+		boardID := uuid.New().String()
+		authorID := uuid.New().String()
+		createInput := CreatePostInput{
+			UserID:  authorID,
+			BoardID: boardID,
+			Content: "Hidden post",
+			PosX:    10,
+			PosY:    10,
+			Color:   models.PostColorLightPink,
+			ZIndex:  1,
+		}
+		post, err := service.CreatePost(context.Background(), createInput)
+		if err != nil {
+			assert.FailNow(t, "Failed to create test post")
+		}
+
+		t.Run("as author", func(t *testing.T) {
+			input := ListPostGroupsInput{BoardID: boardID, UserID: authorID, HidePosts: true}
+			postGroups, err := service.ListPostGroups(context.Background(), input)
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) && assert.Len(t, postGroups[0].Posts, 1) {
+				assert.Equal(t, post.Content, *postGroups[0].Posts[0].Content)
+			}
+		})
+
+		t.Run("as another member", func(t *testing.T) {
+			input := ListPostGroupsInput{BoardID: boardID, UserID: uuid.New().String(), HidePosts: true}
+			postGroups, err := service.ListPostGroups(context.Background(), input)
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) && assert.Len(t, postGroups[0].Posts, 1) {
+				assert.Nil(t, postGroups[0].Posts[0].Content)
+				assert.Equal(t, post.Color, postGroups[0].Posts[0].Color)
+			}
+		})
+
+		t.Run("after reveal", func(t *testing.T) {
+			posts, err := service.ListPosts(context.Background(), boardID)
+			assert.NoError(t, err)
+			if assert.Len(t, posts, 1) {
+				assert.Equal(t, post.Content, posts[0].Content)
+			}
+		})
+	})
+
+	t.Run("Vote on posts", func(t *testing.T) {
+		boardID := uuid.New().String()
+		userID := uuid.New().String()
+		createInput := CreatePostInput{
+			UserID:  userID,
+			BoardID: boardID,
+			Content: "Let's vote on this",
+			PosX:    10,
+			PosY:    10,
+			Color:   models.PostColorLightPink,
+			ZIndex:  1,
+		}
+		post, err := service.CreatePost(context.Background(), createInput)
+		if err != nil {
+			assert.FailNow(t, "Failed to create test post")
+		}
+		voteInput := VoteInput{PostID: post.ID.String(), UserID: userID, VoteLimit: 2}
+
+		t.Run("within vote limit", func(t *testing.T) {
+			votes, err := service.Vote(context.Background(), voteInput)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, votes.Votes)
+			assert.Equal(t, 1, votes.RemainingVotes)
+
+			votes, err = service.Vote(context.Background(), voteInput)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, votes.Votes)
+			assert.Equal(t, 2, votes.UserVotes)
+			assert.Equal(t, 0, votes.RemainingVotes)
+		})
+
+		t.Run("over vote limit", func(t *testing.T) {
+			_, err := service.Vote(context.Background(), voteInput)
+			assert.ErrorIs(t, err, ErrVoteLimitReached)
+		})
+
+		t.Run("list post groups with vote counts", func(t *testing.T) {
+			otherUserID := uuid.New().String()
+			_, err := service.Vote(context.Background(), VoteInput{PostID: post.ID.String(), UserID: otherUserID, VoteLimit: 2})
+			assert.NoError(t, err)
+
+			postGroups, err := service.ListPostGroups(context.Background(), ListPostGroupsInput{BoardID: boardID, UserID: userID})
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) && assert.Len(t, postGroups[0].Posts, 1) {
+				assert.Equal(t, 3, *postGroups[0].Votes)
+				assert.Equal(t, 3, *postGroups[0].Posts[0].Votes)
+				assert.Equal(t, 2, postGroups[0].Posts[0].UserVotes)
+			}
+
+			postGroups, err = service.ListPostGroups(context.Background(), ListPostGroupsInput{BoardID: boardID, UserID: userID, HideVotes: true})
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) && assert.Len(t, postGroups[0].Posts, 1) {
+				assert.Nil(t, postGroups[0].Votes)
+				assert.Nil(t, postGroups[0].Posts[0].Votes)
+				assert.Equal(t, 2, postGroups[0].Posts[0].UserVotes)
+			}
+		})
+
+		t.Run("take back votes", func(t *testing.T) {
+			votes, err := service.Unvote(context.Background(), voteInput)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, votes.Votes)
+			assert.Equal(t, 1, votes.RemainingVotes)
+
+			_, err = service.Unvote(context.Background(), voteInput)
+			assert.NoError(t, err)
+			_, err = service.Unvote(context.Background(), voteInput)
+			assert.ErrorIs(t, err, ErrVoteNotFound)
+		})
+	})
+
+	t.Run("React to posts", func(t *testing.T) {
+		boardID := uuid.New().String()
+		userID := uuid.New().String()
+		otherUserID := uuid.New().String()
+		createInput := CreatePostInput{
+			UserID:  userID,
+			BoardID: boardID,
+			Content: "React to this",
+			PosX:    10,
+			PosY:    10,
+			Color:   models.PostColorLightPink,
+			ZIndex:  1,
+		}
+		post, err := service.CreatePost(context.Background(), createInput)
+		if err != nil {
+			assert.FailNow(t, "Failed to create test post")
+		}
+		reactionInput := ReactionInput{PostID: post.ID.String(), UserID: userID, Emoji: "🎉"}
+
+		t.Run("add reactions", func(t *testing.T) {
+			_, err := service.React(context.Background(), reactionInput)
+			assert.NoError(t, err)
+			_, err = service.React(context.Background(), ReactionInput{PostID: post.ID.String(), UserID: userID, Emoji: "👍🏽"})
+			assert.NoError(t, err)
+
+			reactions, err := service.React(context.Background(), ReactionInput{PostID: post.ID.String(), UserID: otherUserID, Emoji: "🎉"})
+			assert.NoError(t, err)
+			if assert.Len(t, reactions.Reactions, 2) {
+				assert.Equal(t, "🎉", reactions.Reactions[0].Emoji)
+				assert.Equal(t, 2, reactions.Reactions[0].Count)
+				assert.Equal(t, "👍🏽", reactions.Reactions[1].Emoji)
+				assert.Equal(t, 1, reactions.Reactions[1].Count)
+			}
+		})
+
+		t.Run("react twice with the same emoji", func(t *testing.T) {
+			_, err := service.React(context.Background(), reactionInput)
+			assert.ErrorIs(t, err, ErrReactionExists)
+		})
+
+		t.Run("react with something other than an emoji", func(t *testing.T) {
+			_, err := service.React(context.Background(), ReactionInput{PostID: post.ID.String(), UserID: userID, Emoji: "ok"})
+			assert.True(t, validator.IsValidationError(err))
+		})
+
+		t.Run("list post groups with reactions", func(t *testing.T) {
+			postGroups, err := service.ListPostGroups(context.Background(), ListPostGroupsInput{BoardID: boardID, UserID: userID})
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) && assert.Len(t, postGroups[0].Posts, 1) {
+				assert.Len(t, postGroups[0].Posts[0].Reactions, 2)
+			}
+		})
+
+		t.Run("remove reactions", func(t *testing.T) {
+			reactions, err := service.Unreact(context.Background(), reactionInput)
+			assert.NoError(t, err)
+			if assert.Len(t, reactions.Reactions, 2) {
+				assert.Equal(t, "🎉", reactions.Reactions[1].Emoji)
+				assert.Equal(t, []uuid.UUID{uuid.MustParse(otherUserID)}, reactions.Reactions[1].UserIDs)
+			}
+
+			_, err = service.Unreact(context.Background(), reactionInput)
+			assert.ErrorIs(t, err, ErrReactionNotFound)
+		})
+	})
+
+	t.Run("Tag posts", func(t *testing.T) {
+		boardID := uuid.New().String()
+		userID := uuid.New().String()
+		createInput := CreatePostInput{
+			UserID:  userID,
+			BoardID: boardID,
+			Content: "Tag this",
+			PosX:    10,
+			PosY:    10,
+			Color:   models.PostColorLightPink,
+			ZIndex:  1,
+		}
+		post, err := service.CreatePost(context.Background(), createInput)
+		if err != nil {
+			assert.FailNow(t, "Failed to create test post")
+		}
+		createInput.Content = "Leave this untagged"
+		createInput.PostGroupID = post.PostGroupID.String()
+		if _, err := service.CreatePost(context.Background(), createInput); err != nil {
+			assert.FailNow(t, "Failed to create test post")
+		}
+		tag := models.BoardTag{ID: uuid.New(), BoardID: uuid.MustParse(boardID), Name: "Process", Color: "#FFD700"}
+		otherBoardTag := models.BoardTag{ID: uuid.New(), BoardID: uuid.New(), Name: "Process", Color: "#FFD700"}
+		mockPostRepo.tags[tag.ID] = tag
+		mockPostRepo.tags[otherBoardTag.ID] = otherBoardTag
+		tagInput := TagInput{PostID: post.ID.String(), UserID: userID, TagID: tag.ID.String()}
+
+		t.Run("add tag", func(t *testing.T) {
+			postTags, err := service.Tag(context.Background(), tagInput)
+			assert.NoError(t, err)
+			assert.Equal(t, []uuid.UUID{tag.ID}, postTags.TagIDs)
+
+			_, err = service.Tag(context.Background(), tagInput)
+			assert.ErrorIs(t, err, ErrPostTagExists)
+		})
+
+		t.Run("add tag from another board", func(t *testing.T) {
+			_, err := service.Tag(context.Background(), TagInput{PostID: post.ID.String(), UserID: userID, TagID: otherBoardTag.ID.String()})
+			assert.ErrorIs(t, err, ErrTagNotFound)
+		})
+
+		t.Run("list post groups filtered by tag", func(t *testing.T) {
+			postGroups, err := service.ListPostGroups(context.Background(), ListPostGroupsInput{BoardID: boardID, UserID: userID})
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) {
+				assert.Len(t, postGroups[0].Posts, 2)
+			}
+
+			input := ListPostGroupsInput{BoardID: boardID, UserID: userID, TagIDs: []string{tag.ID.String()}}
+			postGroups, err = service.ListPostGroups(context.Background(), input)
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) && assert.Len(t, postGroups[0].Posts, 1) {
+				assert.Equal(t, post.ID, postGroups[0].Posts[0].ID)
+				assert.Equal(t, []uuid.UUID{tag.ID}, postGroups[0].Posts[0].TagIDs)
+			}
+
+			input.TagIDs = []string{otherBoardTag.ID.String()}
+			postGroups, err = service.ListPostGroups(context.Background(), input)
+			assert.NoError(t, err)
+			if assert.Len(t, postGroups, 1) {
+				assert.Empty(t, postGroups[0].Posts)
+			}
+		})
+
+		t.Run("remove tag", func(t *testing.T) {
+			postTags, err := service.Untag(context.Background(), tagInput)
+			assert.NoError(t, err)
+			assert.Empty(t, postTags.TagIDs)
+
+			_, err = service.Untag(context.Background(), tagInput)
+			assert.ErrorIs(t, err, ErrPostTagNotFound)
+		})
+	})
+
+	t.Run("Comment on posts", func(t *testing.T) {
+		boardID := uuid.New().String()
+		userID := uuid.New().String()
+		otherUserID := uuid.New().String()
+		createInput := CreatePostInput{
+			UserID:  userID,
+			BoardID: boardID,
+			Content: "Discuss this",
+			PosX:    10,
+			PosY:    10,
+			Color:   models.PostColorLightPink,
+			ZIndex:  1,
+		}
+		post, err := service.CreatePost(context.Background(), createInput)
+		if err != nil {
+			assert.FailNow(t, "Failed to create test post")
+		}
+		comment, err := service.CreateComment(context.Background(), CreateCommentInput{PostID: post.ID.String(), UserID: userID, Content: "Why?"})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test comment")
+		}
+
+		t.Run("reply to a comment", func(t *testing.T) {
+			reply, err := service.CreateComment(context.Background(), CreateCommentInput{
+				PostID:   post.ID.String(),
+				UserID:   otherUserID,
+				ParentID: comment.ID.String(),
+				Content:  "Because",
+			})
+			assert.NoError(t, err)
+			if assert.NotNil(t, reply.ParentID) {
+				assert.Equal(t, comment.ID, *reply.ParentID)
+			}
+
+			threads, err := service.ListComments(context.Background(), post.ID.String())
+			assert.NoError(t, err)
+			if assert.Len(t, threads, 1) && assert.Len(t, threads[0].Replies, 1) {
+				assert.Equal(t, comment.ID, threads[0].ID)
+				assert.Equal(t, reply.ID, threads[0].Replies[0].ID)
+			}
+		})
+
+		t.Run("reply to a comment on another post", func(t *testing.T) {
+			otherPost, err := service.CreatePost(context.Background(), createInput)
+			if err != nil {
+				assert.FailNow(t, "Failed to create test post")
+			}
+			_, err = service.CreateComment(context.Background(), CreateCommentInput{
+				PostID:   otherPost.ID.String(),
+				UserID:   userID,
+				ParentID: comment.ID.String(),
+				Content:  "Wrong thread",
+			})
+			assert.ErrorIs(t, err, ErrInvalidParentComment)
+		})
+
+		t.Run("only the author can edit or delete", func(t *testing.T) {
+			_, err := service.UpdateComment(context.Background(), UpdateCommentInput{CommentID: comment.ID.String(), UserID: otherUserID, Content: "Edited"})
+			assert.ErrorIs(t, err, ErrNotCommentAuthor)
+			_, err = service.DeleteComment(context.Background(), DeleteCommentInput{CommentID: comment.ID.String(), UserID: otherUserID})
+			assert.ErrorIs(t, err, ErrNotCommentAuthor)
+
+			updated, err := service.UpdateComment(context.Background(), UpdateCommentInput{CommentID: comment.ID.String(), UserID: userID, Content: "Edited"})
+			assert.NoError(t, err)
+			assert.Equal(t, "Edited", updated.Content)
+		})
+
+		t.Run("delete comment with replies", func(t *testing.T) {
+			_, err := service.DeleteComment(context.Background(), DeleteCommentInput{CommentID: comment.ID.String(), UserID: userID})
+			assert.NoError(t, err)
+			threads, err := service.ListComments(context.Background(), post.ID.String())
+			assert.NoError(t, err)
+			assert.Empty(t, threads)
+		})
+
+		t.Run("delete post with comments", func(t *testing.T) {
+			comment, err := service.CreateComment(context.Background(), CreateCommentInput{PostID: post.ID.String(), UserID: userID, Content: "Still here?"})
+			assert.NoError(t, err)
+			err = service.DeletePost(context.Background(), post.ID.String())
+			assert.NoError(t, err)
+			_, err = service.GetComment(context.Background(), comment.ID.String())
+			assert.ErrorIs(t, err, ErrCommentNotFound)
+		})
+	})
+
+	t.Run("Attach files to posts", func(t *testing.T) {
+		userID := uuid.New().String()
+		otherUserID := uuid.New().String()
+		post, err := service.CreatePost(context.Background(), CreatePostInput{
+			UserID:  userID,
+			BoardID: uuid.New().String(),
+			Content: "See attached",
+			PosX:    10,
+			PosY:    10,
+			Color:   models.PostColorLightPink,
+			ZIndex:  1,
+		})
+		if err != nil {
+			assert.FailNow(t, "Failed to create test post")
+		}
+		png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 600)...)
+		attachInput := func(fileName string, content []byte) CreateAttachmentInput {
+			return CreateAttachmentInput{
+				PostID:   post.ID.String(),
+				UserID:   userID,
+				FileName: fileName,
+				Size:     int64(len(content)),
+				File:     bytes.NewReader(content),
+			}
+		}
+		attachment, err := service.CreateAttachment(context.Background(), attachInput("C:\\Users\\me\\chart.png", png))
+		if err != nil {
+			assert.FailNow(t, "Failed to create test attachment", err)
+		}
+
+		t.Run("detect type from content", func(t *testing.T) {
+			assert.Equal(t, "image/png", attachment.ContentType)
+			assert.Equal(t, "chart.png", attachment.FileName)
+			assert.Equal(t, int64(len(png)), attachment.Size)
+
+			notes, err := service.CreateAttachment(context.Background(), attachInput("notes.png", []byte("just some notes")))
+			assert.NoError(t, err)
+			assert.Equal(t, "text/plain", notes.ContentType)
+		})
+
+		t.Run("reject unsupported and large files", func(t *testing.T) {
+			_, err := service.CreateAttachment(context.Background(), attachInput("page.html", []byte("<html><body>hi</body></html>")))
+			assert.ErrorIs(t, err, ErrUnsupportedFileType)
+
+			input := attachInput("big.png", png)
+			input.Size = MaxAttachmentSize + 1
+			_, err = service.CreateAttachment(context.Background(), input)
+			assert.ErrorIs(t, err, ErrAttachmentTooLarge)
+
+			_, err = service.CreateAttachment(context.Background(), attachInput("", png))
+			assert.Error(t, err)
+		})
+
+		t.Run("list and open attachments", func(t *testing.T) {
+			attachments, err := service.ListAttachments(context.Background(), post.ID.String())
+			assert.NoError(t, err)
+			if assert.Len(t, attachments, 2) {
+				assert.Equal(t, attachment.ID, attachments[0].ID)
+			}
+
+			file, err := service.OpenAttachment(context.Background(), attachment)
+			if assert.NoError(t, err) {
+				defer file.Close()
+				content, err := io.ReadAll(file)
+				assert.NoError(t, err)
+				assert.Equal(t, png, content)
+			}
+		})
+
+		t.Run("only the uploader or an admin can delete", func(t *testing.T) {
+			_, err := service.DeleteAttachment(context.Background(), DeleteAttachmentInput{AttachmentID: attachment.ID.String(), UserID: otherUserID})
+			assert.ErrorIs(t, err, ErrNotAttachmentOwner)
+
+			_, err = service.DeleteAttachment(context.Background(), DeleteAttachmentInput{AttachmentID: attachment.ID.String(), UserID: otherUserID, IsAdmin: true})
+			assert.NoError(t, err)
+			_, err = service.GetAttachment(context.Background(), attachment.ID.String())
+			assert.ErrorIs(t, err, ErrAttachmentNotFound)
+			_, err = attachmentStorage.Get(context.Background(), attachment.StorageKey())
+			assert.ErrorIs(t, err, storage.ErrNotFound)
+		})
+
+		t.Run("delete post with attachments", func(t *testing.T) {
+			attachments, err := service.ListAttachments(context.Background(), post.ID.String())
+			if err != nil || len(attachments) == 0 {
+				assert.FailNow(t, "Failed to list test attachments")
+			}
+			err = service.DeletePost(context.Background(), post.ID.String())
+			assert.NoError(t, err)
+			_, err = attachmentStorage.Get(context.Background(), attachments[0].StorageKey())
+			assert.ErrorIs(t, err, storage.ErrNotFound)
+		})
+
+		t.Run("delete post group with attachments", func(t *testing.T) {
+			groupedPost, err := service.CreatePost(context.Background(), CreatePostInput{
+				UserID:  userID,
+				BoardID: uuid.New().String(),
+				Content: "Also attached",
+				PosX:    10,
+				PosY:    10,
+				Color:   models.PostColorLightPink,
+				ZIndex:  1,
+			})
+			if err != nil {
+				assert.FailNow(t, "Failed to create test post")
+			}
+			input := attachInput("chart.png", png)
+			input.PostID = groupedPost.ID.String()
+			groupedAttachment, err := service.CreateAttachment(context.Background(), input)
+			if err != nil {
+				assert.FailNow(t, "Failed to create test attachment", err)
+			}
+
+			err = service.DeletePostGroup(context.Background(), groupedPost.PostGroupID.String())
+			assert.NoError(t, err)
+			_, err = service.GetAttachment(context.Background(), groupedAttachment.ID.String())
+			assert.ErrorIs(t, err, ErrAttachmentNotFound)
+			_, err = attachmentStorage.Get(context.Background(), groupedAttachment.StorageKey())
+			assert.ErrorIs(t, err, storage.ErrNotFound)
+		})
+	})
+
+	t.Run("Mention board members", func(t *testing.T) {
+		owner := test.NewUser()
+		owner.Name = "Ann"
+		member := test.NewUser()
+		member.Name = "Ann Lee"
+		guest := test.NewUser()
+		guest.Name = "Bob"
+		guest.Email = nil
+		testBoard := test.NewBoard(owner.ID)
+		if err := mockBoardRepo.CreateBoard(context.Background(), testBoard); err != nil {
+			assert.FailNow(t, "Failed to create test board")
+		}
+		for _, user := range []models.User{owner, member, guest} {
+			mockBoardRepo.AddUser(user)
+		}
+		for _, user := range []models.User{member, guest} {
+			membership := models.BoardMembership{ID: uuid.New(), BoardID: testBoard.ID, UserID: user.ID, Role: models.RoleMember}
+			if err := mockBoardRepo.CreateMembership(context.Background(), membership); err != nil {
+				assert.FailNow(t, "Failed to create test membership")
+			}
+		}
+		lastMention := func() payloads.Mention {
+			if len(publisher.tasks) == 0 || publisher.tasks[len(publisher.tasks)-1] != tasks.EmailMention {
+				assert.FailNow(t, "Expected a mention to be published")
+			}
+			return publisher.payloads[len(publisher.payloads)-1].(payloads.Mention)
+		}
+		mentionedUsers := func(postID uuid.UUID, commentID *uuid.UUID) []uuid.UUID {
+			mentions, err := mockPostRepo.ListMentions(context.Background(), postID)
+			assert.NoError(t, err)
+			userIDs := []uuid.UUID{}
+			for _, mention := range mentions {
+				if sameMentionSource(mention, models.PostMention{PostID: postID, CommentID: commentID}) {
+					userIDs = append(userIDs, mention.UserID)
+				}
+			}
+			return userIDs
+		}
+
+		t.Run("parse mentions", func(t *testing.T) {
+			members := []board.MemberDTO{{ID: owner.ID, Name: owner.Name}, {ID: member.ID, Name: member.Name}, {ID: guest.ID, Name: guest.Name}}
+			content := "Thanks @ann lee and @Bob! cc @Ann, but not ann@example.com, @Annie, or @Bob again"
+			mentioned := parseMentions(content, members)
+			if assert.Len(t, mentioned, 3) {
+				assert.Equal(t, member.ID, mentioned[0].ID)
+				assert.Equal(t, guest.ID, mentioned[1].ID)
+				assert.Equal(t, owner.ID, mentioned[2].ID)
+			}
+		})
+
+		t.Run("parse mentions with case folded runes of a different byte length", func(t *testing.T) {
+			members := []board.MemberDTO{{ID: owner.ID, Name: "Strauß"}, {ID: member.ID, Name: "Kai"}}
+			content := "Thanks @STRAUẞ and @\u212Aai"
+			mentioned := parseMentions(content, members)
+			if assert.Len(t, mentioned, 2) {
+				assert.Equal(t, owner.ID, mentioned[0].ID)
+				assert.Equal(t, member.ID, mentioned[1].ID)
+			}
+		})
+
+		var post models.Post
+		t.Run("mention in post", func(t *testing.T) {
+			var err error
+			post, err = service.CreatePost(context.Background(), CreatePostInput{
+				UserID:  owner.ID.String(),
+				BoardID: testBoard.ID.String(),
+				Content: "@Ann Lee and @Bob, can you take this? Ping me @Ann",
+				Color:   models.PostColorLightPink,
+			})
+			if err != nil {
+				assert.FailNow(t, "Failed to create test post")
+			}
+			assert.ElementsMatch(t, []uuid.UUID{member.ID, guest.ID}, mentionedUsers(post.ID, nil))
+
+			mention := lastMention()
+			assert.Equal(t, testBoard.ID.String(), mention.BoardID)
+			assert.Equal(t, *testBoard.Name, mention.BoardName)
+			assert.Equal(t, owner.Name, mention.MentionedBy)
+			assert.False(t, mention.InComment)
+			if assert.Len(t, mention.Users, 2) {
+				assert.Equal(t, *member.Email, mention.Users[0].Email)
+				assert.Empty(t, mention.Users[1].Email)
+			}
+		})
+
+		t.Run("only notify new mentions on edit", func(t *testing.T) {
+			published := len(publisher.tasks)
+			content := "@Bob can you take this?"
+			_, err := service.UpdatePost(context.Background(), UpdatePostInput{ID: post.ID.String(), Content: &content})
+			assert.NoError(t, err)
+			assert.Len(t, publisher.tasks, published)
+			assert.Equal(t, []uuid.UUID{guest.ID}, mentionedUsers(post.ID, nil))
+
+			content = "@Bob can you take this with @Ann Lee?"
+			_, err = service.UpdatePost(context.Background(), UpdatePostInput{ID: post.ID.String(), Content: &content})
+			assert.NoError(t, err)
+			assert.Len(t, publisher.tasks, published+1)
+			if users := lastMention().Users; assert.Len(t, users, 1) {
+				assert.Equal(t, member.ID.String(), users[0].ID)
+			}
+		})
+
+		t.Run("mention in comment", func(t *testing.T) {
+			comment, err := service.CreateComment(context.Background(), CreateCommentInput{PostID: post.ID.String(), UserID: member.ID.String(), Content: "On it, @ann"})
+			if err != nil {
+				assert.FailNow(t, "Failed to create test comment")
+			}
+			assert.Equal(t, []uuid.UUID{owner.ID}, mentionedUsers(post.ID, &comment.ID))
+			mention := lastMention()
+			assert.True(t, mention.InComment)
+			assert.Equal(t, member.Name, mention.MentionedBy)
+
+			_, err = service.DeleteComment(context.Background(), DeleteCommentInput{CommentID: comment.ID.String(), UserID: member.ID.String()})
+			assert.NoError(t, err)
+			assert.Empty(t, mentionedUsers(post.ID, &comment.ID))
+			assert.Len(t, mentionedUsers(post.ID, nil), 2)
+		})
+
+		t.Run("mention in anonymous post", func(t *testing.T) {
+			anonymousBoard := testBoard
+			anonymousBoard.AnonymousPosts = true
+			if err := mockBoardRepo.UpdateBoard(context.Background(), anonymousBoard); err != nil {
+				assert.FailNow(t, "Failed to update test board")
+			}
+			_, err := service.CreatePost(context.Background(), CreatePostInput{
+				UserID:  member.ID.String(),
+				BoardID: testBoard.ID.String(),
+				Content: "@Bob should own this",
+				Color:   models.PostColorLightPink,
+			})
+			assert.NoError(t, err)
+			assert.Empty(t, lastMention().MentionedBy)
+		})
+	})
 }
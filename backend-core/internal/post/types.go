@@ -1,6 +1,7 @@
 package post
 
 import (
+	"io"
 	"time"
 
 	"github.com/Wave-95/boards/backend-core/internal/models"
@@ -8,18 +9,20 @@ import (
 	"github.com/google/uuid"
 )
 
-// CreatePostInput defines the structure of a request to create a post
+// CreatePostInput defines the structure of a request to create a post. Posts are created as plain text unless a
+// ContentFormat is given.
 type CreatePostInput struct {
-	UserID      string  `json:"user_id" validate:"required,uuid"`
-	BoardID     string  `json:"board_id" validate:"required,uuid"`
-	Content     string  `json:"content"`
-	PosX        int     `json:"pos_x"`
-	PosY        int     `json:"pos_y"`
-	Color       string  `json:"color" validate:"required,min=7,max=7"`
-	Height      int     `json:"height" validate:"min=0"`
-	ZIndex      int     `json:"z_index"`
-	PostOrder   float64 `json:"post_order"`
-	PostGroupID string  `json:"post_group_id"`
+	UserID        string  `json:"user_id" validate:"required,uuid"`
+	BoardID       string  `json:"board_id" validate:"required,uuid"`
+	Content       string  `json:"content" validate:"max=2000"`
+	ContentFormat string  `json:"content_format" validate:"omitempty,oneof=PLAIN MARKDOWN"`
+	PosX          int     `json:"pos_x"`
+	PosY          int     `json:"pos_y"`
+	Color         string  `json:"color" validate:"required,min=7,max=7"`
+	Height        int     `json:"height" validate:"min=0"`
+	ZIndex        int     `json:"z_index"`
+	PostOrder     float64 `json:"post_order"`
+	PostGroupID   string  `json:"post_group_id"`
 }
 
 // Validate validates the create post input.
@@ -30,12 +33,13 @@ func (i *CreatePostInput) Validate() error {
 
 // UpdatePostInput defines the structure of a request to update a post.
 type UpdatePostInput struct {
-	ID          string   `json:"id" validate:"required,uuid"`
-	Content     *string  `json:"content"`
-	Color       *string  `json:"color" validate:"omitempty,min=7,max=7"`
-	Height      *int     `json:"height" validate:"omitempty,min=0"`
-	PostOrder   *float64 `json:"post_order"`
-	PostGroupID *string  `json:"post_group_id" validate:"omitempty,uuid"`
+	ID            string   `json:"id" validate:"required,uuid"`
+	Content       *string  `json:"content" validate:"omitempty,max=2000"`
+	ContentFormat *string  `json:"content_format" validate:"omitempty,oneof=PLAIN MARKDOWN"`
+	Color         *string  `json:"color" validate:"omitempty,min=7,max=7"`
+	Height        *int     `json:"height" validate:"omitempty,min=0"`
+	PostOrder     *float64 `json:"post_order"`
+	PostGroupID   *string  `json:"post_group_id" validate:"omitempty,uuid"`
 }
 
 // Validate validates the update post payload.
@@ -73,6 +77,180 @@ func (i *UpdatePostGroupInput) Validate() error {
 	return validator.Struct(i)
 }
 
+// VoteInput defines the structure of a request to cast or take back a vote on a post. VoteLimit is the number of
+// votes that each member can cast on the board of the post.
+type VoteInput struct {
+	PostID    string `json:"post_id" validate:"required,uuid"`
+	UserID    string `json:"user_id" validate:"required,uuid"`
+	VoteLimit int    `json:"vote_limit" validate:"min=1"`
+}
+
+// Validate validates the vote input.
+func (i *VoteInput) Validate() error {
+	validator := validator.New()
+	return validator.Struct(i)
+}
+
+// ReactionInput defines the structure of a request to add or remove an emoji reaction on a post.
+type ReactionInput struct {
+	PostID string `json:"post_id" validate:"required,uuid"`
+	UserID string `json:"user_id" validate:"required,uuid"`
+	Emoji  string `json:"emoji" validate:"required,max=32,emoji"`
+}
+
+// Validate validates the reaction input.
+func (i *ReactionInput) Validate() error {
+	validator := validator.New()
+	return validator.Struct(i)
+}
+
+// TagInput defines the structure of a request to add a tag to a post or remove a tag from a post. The tag must
+// be in the tag catalog of the board of the post.
+type TagInput struct {
+	PostID string `json:"post_id" validate:"required,uuid"`
+	UserID string `json:"user_id" validate:"required,uuid"`
+	TagID  string `json:"tag_id" validate:"required,uuid"`
+}
+
+// Validate validates the tag input.
+func (i *TagInput) Validate() error {
+	validator := validator.New()
+	return validator.Struct(i)
+}
+
+// CreateCommentInput defines the structure of a request to comment on a post. ParentID is set when replying to
+// another comment on the post.
+type CreateCommentInput struct {
+	PostID   string `json:"post_id" validate:"required,uuid"`
+	UserID   string `json:"user_id" validate:"required,uuid"`
+	ParentID string `json:"parent_id" validate:"omitempty,uuid"`
+	Content  string `json:"content" validate:"required,max=2000"`
+}
+
+// Validate validates the create comment input.
+func (i *CreateCommentInput) Validate() error {
+	validator := validator.New()
+	return validator.Struct(i)
+}
+
+// UpdateCommentInput defines the structure of a request to edit a comment.
+type UpdateCommentInput struct {
+	CommentID string `json:"comment_id" validate:"required,uuid"`
+	UserID    string `json:"user_id" validate:"required,uuid"`
+	Content   string `json:"content" validate:"required,max=2000"`
+}
+
+// Validate validates the update comment input.
+func (i *UpdateCommentInput) Validate() error {
+	validator := validator.New()
+	return validator.Struct(i)
+}
+
+// DeleteCommentInput defines the structure of a request to delete a comment.
+type DeleteCommentInput struct {
+	CommentID string `json:"comment_id" validate:"required,uuid"`
+	UserID    string `json:"user_id" validate:"required,uuid"`
+}
+
+// Validate validates the delete comment input.
+func (i *DeleteCommentInput) Validate() error {
+	validator := validator.New()
+	return validator.Struct(i)
+}
+
+// CreateAttachmentInput defines the structure of a request to attach a file to a post. Size is the size of File
+// in bytes.
+type CreateAttachmentInput struct {
+	PostID   string    `validate:"required,uuid"`
+	UserID   string    `validate:"required,uuid"`
+	FileName string    `validate:"required,max=255"`
+	Size     int64     `validate:"min=1"`
+	File     io.Reader `validate:"required"`
+}
+
+// Validate validates the create attachment input.
+func (i *CreateAttachmentInput) Validate() error {
+	validator := validator.New()
+	return validator.Struct(i)
+}
+
+// DeleteAttachmentInput defines the structure of a request to delete an attachment. Admins of the board can
+// delete attachments that were uploaded by other users.
+type DeleteAttachmentInput struct {
+	AttachmentID string `validate:"required,uuid"`
+	UserID       string `validate:"required,uuid"`
+	IsAdmin      bool
+}
+
+// Validate validates the delete attachment input.
+func (i *DeleteAttachmentInput) Validate() error {
+	validator := validator.New()
+	return validator.Struct(i)
+}
+
+// ListPostGroupsInput defines the structure of a request to list the post groups of a board. UserID is used to
+// count the votes that the requesting user cast, and HideVotes leaves out the vote counts of every member. When
+// TagIDs is set, only the posts that have at least one of the tags are listed. AnonymousPosts and HidePosts
+// leave out the author and the content of every post that was not created by the requesting user.
+type ListPostGroupsInput struct {
+	BoardID        string
+	UserID         string
+	HideVotes      bool
+	TagIDs         []string
+	AnonymousPosts bool
+	HidePosts      bool
+}
+
+// VoteCount is a struct that encapsulates the number of votes on a post and how many of them were cast by a user.
+type VoteCount struct {
+	Votes     int
+	UserVotes int
+}
+
+// ReactionSummary is a formatted response representing the users who reacted to a post with an emoji.
+type ReactionSummary struct {
+	Emoji   string      `json:"emoji"`
+	Count   int         `json:"count"`
+	UserIDs []uuid.UUID `json:"user_ids"`
+}
+
+// ReactionDTO is a formatted response representing the reactions on a post after a reaction is added or removed.
+type ReactionDTO struct {
+	PostID    uuid.UUID         `json:"post_id"`
+	BoardID   uuid.UUID         `json:"board_id"`
+	Reactions []ReactionSummary `json:"reactions"`
+}
+
+// PostTagsDTO is a formatted response representing the tags on a post after a tag is added or removed.
+type PostTagsDTO struct {
+	PostID  uuid.UUID   `json:"post_id"`
+	BoardID uuid.UUID   `json:"board_id"`
+	TagIDs  []uuid.UUID `json:"tag_ids"`
+}
+
+// CommentDTO is a formatted response representing a comment and the thread of replies beneath it.
+type CommentDTO struct {
+	models.PostComment
+	Replies []CommentDTO `json:"replies"`
+}
+
+// AttachmentDTO is a formatted response representing an attachment along with the URL to download it from.
+// UserID is nil when the uploader is hidden from the requesting user.
+type AttachmentDTO struct {
+	models.PostAttachment
+	UserID *uuid.UUID `json:"user_id"`
+	URL    string     `json:"url"`
+}
+
+// VoteDTO is a formatted response representing the votes on a post after a vote is cast or taken back.
+type VoteDTO struct {
+	PostID         uuid.UUID `json:"post_id"`
+	BoardID        uuid.UUID `json:"board_id"`
+	Votes          int       `json:"votes"`
+	UserVotes      int       `json:"user_votes"`
+	RemainingVotes int       `json:"remaining_votes"`
+}
+
 // GroupAndPost is a struct that encapsulates data returned from a joined post group and child post.
 type GroupAndPost struct {
 	PostGroup models.PostGroup
@@ -81,13 +259,29 @@ type GroupAndPost struct {
 
 // GroupWithPostsDTO is a nested struct describing a post group with associated child posts.
 type GroupWithPostsDTO struct {
-	ID        uuid.UUID     `json:"id"`
-	BoardID   uuid.UUID     `json:"board_id"`
-	Title     string        `json:"title"`
-	PosX      int           `json:"pos_x"`
-	PosY      int           `json:"pos_y"`
-	ZIndex    int           `json:"z_index"`
-	Posts     []models.Post `json:"posts"`
-	CreatedAt time.Time     `json:"created_at"`
-	UpdatedAt time.Time     `json:"updated_at"`
+	ID        uuid.UUID `json:"id"`
+	BoardID   uuid.UUID `json:"board_id"`
+	Title     string    `json:"title"`
+	PosX      int       `json:"pos_x"`
+	PosY      int       `json:"pos_y"`
+	ZIndex    int       `json:"z_index"`
+	Posts     []PostDTO `json:"posts"`
+	Votes     *int      `json:"votes"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PostDTO is a formatted response representing a post with its vote counts, reactions, and tags. Votes is nil
+// while vote counts are hidden, but UserVotes always holds the number of votes that the requesting user cast on
+// the post. ContentHTML is the content rendered as sanitized HTML. UserID, Content, and ContentHTML are nil when
+// the author or the content of the post is hidden from the requesting user.
+type PostDTO struct {
+	models.Post
+	UserID      *uuid.UUID        `json:"user_id"`
+	Content     *string           `json:"content"`
+	ContentHTML *string           `json:"content_html"`
+	Votes       *int              `json:"votes"`
+	UserVotes   int               `json:"user_votes"`
+	Reactions   []ReactionSummary `json:"reactions"`
+	TagIDs      []uuid.UUID       `json:"tag_ids"`
 }
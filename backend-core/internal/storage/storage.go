@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Wave-95/boards/backend-core/internal/config"
+)
+
+// ErrNotFound is returned when no file is stored under a key.
+var ErrNotFound = errors.New("File not found")
+
+// Storage is an interface that represents the capabilities of a file store. Files are addressed by slash
+// separated keys.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// New creates the storage that is selected by the storage config.
+func New(cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Driver {
+	case config.StorageDriverLocal:
+		return NewLocal(cfg.LocalDir), nil
+	case config.StorageDriverS3:
+		return NewS3(cfg.S3)
+	default:
+		return nil, fmt.Errorf("storage: unsupported driver: %v", cfg.Driver)
+	}
+}
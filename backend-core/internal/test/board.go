@@ -16,6 +16,8 @@ func NewBoard(userID uuid.UUID) models.Board {
 		Name:        &name,
 		Description: &description,
 		UserID:      userID,
+		Phase:       models.PhaseBrainstorm,
+		VoteLimit:   models.DefaultVoteLimit,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/Wave-95/boards/backend-core/db"
 	"github.com/Wave-95/boards/backend-core/internal/models"
@@ -26,6 +28,7 @@ var (
 type Repository interface {
 	CreateUser(ctx context.Context, user models.User) error
 	CreateEmailVerification(ctx context.Context, verification models.Verification) error
+	ConvertEmailInvites(ctx context.Context, userID uuid.UUID, email string, now time.Time) ([]models.Invite, error)
 
 	GetUser(ctx context.Context, userID uuid.UUID) (models.User, error)
 	GetUserByEmail(ctx context.Context, email string) (models.User, error)
@@ -100,6 +103,74 @@ func (r *repository) CreateEmailVerification(ctx context.Context, verification m
 	return nil
 }
 
+// ConvertEmailInvites uses a db tx to convert the pending email invites addressed to an email into board
+// invites for the user that registered that email. It returns the board invites that were created.
+func (r *repository) ConvertEmailInvites(ctx context.Context, userID uuid.UUID, email string, now time.Time) ([]models.Invite, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("repository: failed to rollback tx: %v", rbErr)
+			}
+		}
+	}()
+	qtx := r.q.WithTx(tx)
+
+	arg := db.ListEmailInvitesByEmailParams{
+		Email:  email,
+		Status: pgtype.Text{String: string(models.InviteStatusPending), Valid: true},
+	}
+	emailInvites, err := qtx.ListEmailInvitesByEmail(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list email invites by email: %w", err)
+	}
+
+	invites := []models.Invite{}
+	expiresAt := now.Add(models.DefaultInviteExpiry)
+	for _, emailInvite := range emailInvites {
+		invite := models.Invite{
+			ID:         uuid.New(),
+			BoardID:    emailInvite.BoardID.Bytes,
+			SenderID:   emailInvite.SenderID.Bytes,
+			ReceiverID: userID,
+			Status:     models.InviteStatusPending,
+			CreatedAt:  emailInvite.CreatedAt.Time,
+			UpdatedAt:  now,
+			ExpiresAt:  &expiresAt,
+		}
+		err = qtx.CreateInvite(ctx, db.CreateInviteParams{
+			ID:         pgtype.UUID{Bytes: invite.ID, Valid: true},
+			BoardID:    emailInvite.BoardID,
+			SenderID:   emailInvite.SenderID,
+			ReceiverID: pgtype.UUID{Bytes: userID, Valid: true},
+			Status:     pgtype.Text{String: string(invite.Status), Valid: true},
+			CreatedAt:  emailInvite.CreatedAt,
+			UpdatedAt:  pgtype.Timestamp{Time: now, Valid: true},
+			ExpiresAt:  pgtype.Timestamp{Time: expiresAt, Valid: true},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("repository: failed to create invite from email invite: %w", err)
+		}
+
+		updateArg := db.UpdateEmailInviteParams(emailInvite)
+		updateArg.Status = pgtype.Text{String: string(models.InviteStatusConverted), Valid: true}
+		updateArg.UpdatedAt = pgtype.Timestamp{Time: now, Valid: true}
+		err = qtx.UpdateEmailInvite(ctx, updateArg)
+		if err != nil {
+			return nil, fmt.Errorf("repository: failed to update email invite: %w", err)
+		}
+		invites = append(invites, invite)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("repository: failed to commit tx: %w", err)
+	}
+	return invites, nil
+}
+
 // GetUser queries and returns a single user for a given user ID.
 func (r *repository) GetUser(ctx context.Context, userID uuid.UUID) (models.User, error) {
 	userDB, err := r.q.GetUser(ctx, pgtype.UUID{Bytes: userID, Valid: true})
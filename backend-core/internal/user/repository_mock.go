@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/Wave-95/boards/backend-core/internal/models"
 	"github.com/google/uuid"
@@ -10,13 +11,20 @@ import (
 type mockRepository struct {
 	users         map[uuid.UUID]models.User
 	verifications map[uuid.UUID]models.Verification
+	emailInvites  map[uuid.UUID]models.EmailInvite
 }
 
 // NewMockRepository returns a mock user repository with initialized fields
 func NewMockRepository() *mockRepository {
 	users := make(map[uuid.UUID]models.User)
 	verifications := make(map[uuid.UUID]models.Verification)
-	return &mockRepository{users, verifications}
+	emailInvites := make(map[uuid.UUID]models.EmailInvite)
+	return &mockRepository{users, verifications, emailInvites}
+}
+
+// AddEmailInvite is a mock specific function to store an email invite that has not been converted yet.
+func (r *mockRepository) AddEmailInvite(invite models.EmailInvite) {
+	r.emailInvites[invite.ID] = invite
 }
 
 func (r *mockRepository) CreateUser(ctx context.Context, user models.User) error {
@@ -29,6 +37,30 @@ func (r *mockRepository) CreateEmailVerification(ctx context.Context, verificati
 	return nil
 }
 
+func (r *mockRepository) ConvertEmailInvites(ctx context.Context, userID uuid.UUID, email string, now time.Time) ([]models.Invite, error) {
+	invites := []models.Invite{}
+	expiresAt := now.Add(models.DefaultInviteExpiry)
+	for id, emailInvite := range r.emailInvites {
+		if emailInvite.Email != email || emailInvite.Status != models.InviteStatusPending {
+			continue
+		}
+		invites = append(invites, models.Invite{
+			ID:         uuid.New(),
+			BoardID:    emailInvite.BoardID,
+			SenderID:   emailInvite.SenderID,
+			ReceiverID: userID,
+			Status:     models.InviteStatusPending,
+			CreatedAt:  emailInvite.CreatedAt,
+			UpdatedAt:  now,
+			ExpiresAt:  &expiresAt,
+		})
+		emailInvite.Status = models.InviteStatusConverted
+		emailInvite.UpdatedAt = now
+		r.emailInvites[id] = emailInvite
+	}
+	return invites, nil
+}
+
 func (r *mockRepository) GetUser(ctx context.Context, userID uuid.UUID) (models.User, error) {
 	if user, ok := r.users[userID]; ok {
 		return user, nil
@@ -46,7 +46,7 @@ func NewService(repo Repository, amqp amqp.Amqp, validator validator.Validate) *
 }
 
 // CreateUser takes a user input and standardizes the user name, hashes the password (if provided), and stores the
-// user details into the database.
+// user details into the database. Any pending email invites sent to the user's email are converted into board invites.
 func (s *service) CreateUser(ctx context.Context, input CreateUserInput) (models.User, error) {
 	// Validate input
 	if err := s.validator.Struct(input); err != nil {
@@ -79,12 +79,16 @@ func (s *service) CreateUser(ctx context.Context, input CreateUserInput) (models
 		return models.User{}, fmt.Errorf("service: failed to create user: %w", err)
 	}
 
-	// Publish email verification task
+	// Publish email verification task and convert any board invites sent to the email
 	if input.Email != nil {
 		_, err := s.CreateEmailVerification(ctx, id.String())
 		if err != nil {
 			return models.User{}, fmt.Errorf("service: faled to create email verification: %w", err)
 		}
+		_, err = s.userRepo.ConvertEmailInvites(ctx, id, *input.Email, now)
+		if err != nil {
+			return models.User{}, fmt.Errorf("service: failed to convert email invites: %w", err)
+		}
 	}
 
 	// Hide password
@@ -3,11 +3,14 @@ package user
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/Wave-95/boards/backend-core/internal/jwt"
+	"github.com/Wave-95/boards/backend-core/internal/models"
 	"github.com/Wave-95/boards/backend-core/internal/test"
 	"github.com/Wave-95/boards/backend-core/pkg/validator"
 	"github.com/Wave-95/boards/wrappers/amqp"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,6 +35,32 @@ func TestService(t *testing.T) {
 			assert.Equal(t, newUser.ID, user.ID)
 		})
 
+		t.Run("with pending email invites", func(t *testing.T) {
+			mockUserRepo := NewMockRepository()
+			userService := NewService(mockUserRepo, amqp, validate)
+			email := "jane@example.com"
+			password := "password123"
+			emailInvite := models.EmailInvite{
+				ID:        uuid.New(),
+				BoardID:   uuid.New(),
+				SenderID:  uuid.New(),
+				Email:     email,
+				Status:    models.InviteStatusPending,
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			}
+			mockUserRepo.AddEmailInvite(emailInvite)
+
+			input := CreateUserInput{
+				Name:     "jane doe",
+				Email:    &email,
+				Password: &password,
+			}
+			_, err := userService.CreateUser(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, models.InviteStatusConverted, mockUserRepo.emailInvites[emailInvite.ID].Status)
+		})
+
 		t.Run("using invalid user input", func(t *testing.T) {
 			input := CreateUserInput{}
 			_, err := userService.CreateUser(context.Background(), input)
@@ -0,0 +1,172 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Wave-95/boards/backend-core/internal/board"
+	"github.com/Wave-95/boards/backend-core/internal/models"
+)
+
+// BroadcastBoardUpdate publishes a board update event to all clients connected to the board.
+func (ws *WebSocket) BroadcastBoardUpdate(ctx context.Context, board models.Board) error {
+	msgRes := ResponseBoardUpdate{
+		ResponseBase: ResponseBase{
+			Event:   EventBoardUpdate,
+			Success: true,
+		},
+		Result: board,
+	}
+	return ws.publish(ctx, board.ID.String(), msgRes)
+}
+
+// BroadcastBoardDelete publishes a board delete event to all clients connected to the board and clears
+// the board's list of connected users and timer. Clients unsubscribe from the board once the event is received.
+func (ws *WebSocket) BroadcastBoardDelete(ctx context.Context, boardID string) error {
+	msgRes := ResponseBoardDelete{
+		ResponseBase: ResponseBase{
+			Event:   EventBoardDelete,
+			Success: true,
+		},
+		Result: ResultBoardDelete{
+			BoardID: boardID,
+		},
+	}
+	if err := ws.publish(ctx, boardID, msgRes); err != nil {
+		return err
+	}
+	if err := ws.rdb.Del(ctx, boardID).Err(); err != nil {
+		return fmt.Errorf("ws: failed to clear connected users: %w", err)
+	}
+	if err := delTimer(ws.rdb, boardID); err != nil {
+		return fmt.Errorf("ws: failed to clear board timer: %w", err)
+	}
+	return nil
+}
+
+// BroadcastBoardPhase publishes a board phase change to all clients connected to the board. Clients keep track
+// of the phase to reject actions that are not allowed in it.
+func (ws *WebSocket) BroadcastBoardPhase(ctx context.Context, board models.Board) error {
+	msgRes := ResponseBoardPhase{
+		ResponseBase: ResponseBase{
+			Event:   EventBoardPhase,
+			Success: true,
+		},
+		Result: ResultBoardPhase{
+			BoardID: board.ID.String(),
+			Phase:   board.Phase,
+		},
+	}
+	return ws.publish(ctx, board.ID.String(), msgRes)
+}
+
+// BroadcastBoardReveal publishes the full contents of the posts on a board to all clients connected to the
+// board once its hidden posts are revealed.
+func (ws *WebSocket) BroadcastBoardReveal(ctx context.Context, board models.Board, posts []models.Post) error {
+	msgRes := ResponseBoardReveal{
+		ResponseBase: ResponseBase{
+			Event:   EventBoardReveal,
+			Success: true,
+		},
+		Result: ResultBoardReveal{
+			BoardID: board.ID.String(),
+			Posts:   posts,
+		},
+	}
+	return ws.publish(ctx, board.ID.String(), msgRes)
+}
+
+// BroadcastMemberUpdate publishes a member role change to all clients connected to the board.
+func (ws *WebSocket) BroadcastMemberUpdate(ctx context.Context, boardID string, member board.MemberDTO) error {
+	msgRes := ResponseBoardMemberUpdate{
+		ResponseBase: ResponseBase{
+			Event:   EventBoardMemberUpdate,
+			Success: true,
+		},
+		Result: ResultBoardMemberUpdate{
+			BoardID: boardID,
+			UserID:  member.ID.String(),
+			Member:  member,
+		},
+	}
+	return ws.publish(ctx, boardID, msgRes)
+}
+
+// BroadcastMemberRemove publishes a member removal to all clients connected to the board. Clients that belong
+// to the removed user unsubscribe from the board once the event is received.
+func (ws *WebSocket) BroadcastMemberRemove(ctx context.Context, boardID string, userID string) error {
+	msgRes := ResponseBoardMemberRemove{
+		ResponseBase: ResponseBase{
+			Event:   EventBoardMemberRemove,
+			Success: true,
+		},
+		Result: ResultBoardMemberRemove{
+			BoardID: boardID,
+			UserID:  userID,
+		},
+	}
+	return ws.publish(ctx, boardID, msgRes)
+}
+
+// BroadcastActionItemCreate publishes a new action item to all clients connected to its board.
+func (ws *WebSocket) BroadcastActionItemCreate(ctx context.Context, item models.ActionItem) error {
+	return ws.publishActionItem(ctx, EventActionItemCreate, item)
+}
+
+// BroadcastActionItemUpdate publishes an updated action item to all clients connected to its board.
+func (ws *WebSocket) BroadcastActionItemUpdate(ctx context.Context, item models.ActionItem) error {
+	return ws.publishActionItem(ctx, EventActionItemUpdate, item)
+}
+
+// BroadcastActionItemDelete publishes a deleted action item to all clients connected to its board.
+func (ws *WebSocket) BroadcastActionItemDelete(ctx context.Context, item models.ActionItem) error {
+	return ws.publishActionItem(ctx, EventActionItemDelete, item)
+}
+
+// publishActionItem publishes an action item event to the action item's board channel.
+func (ws *WebSocket) publishActionItem(ctx context.Context, event string, item models.ActionItem) error {
+	msgRes := ResponseActionItem{
+		ResponseBase: ResponseBase{
+			Event:   event,
+			Success: true,
+		},
+		Result: item,
+	}
+	return ws.publish(ctx, item.BoardID.String(), msgRes)
+}
+
+// BroadcastTagCreate publishes a new tag in the tag catalog of a board to all clients connected to the board.
+func (ws *WebSocket) BroadcastTagCreate(ctx context.Context, tag models.BoardTag) error {
+	return ws.publishTag(ctx, EventTagCreate, tag)
+}
+
+// BroadcastTagDelete publishes a tag that was removed from the tag catalog of a board to all clients connected
+// to the board. The tag has also been removed from every post on the board.
+func (ws *WebSocket) BroadcastTagDelete(ctx context.Context, tag models.BoardTag) error {
+	return ws.publishTag(ctx, EventTagDelete, tag)
+}
+
+// publishTag publishes a tag event to the tag's board channel.
+func (ws *WebSocket) publishTag(ctx context.Context, event string, tag models.BoardTag) error {
+	msgRes := ResponseTag{
+		ResponseBase: ResponseBase{
+			Event:   event,
+			Success: true,
+		},
+		Result: tag,
+	}
+	return ws.publish(ctx, tag.BoardID.String(), msgRes)
+}
+
+// publish marshals a message response and publishes it to a board channel.
+func (ws *WebSocket) publish(ctx context.Context, boardID string, msgRes interface{}) error {
+	msgResBytes, err := json.Marshal(msgRes)
+	if err != nil {
+		return fmt.Errorf("ws: failed to marshal message response: %w", err)
+	}
+	if err := ws.rdb.Publish(ctx, boardID, msgResBytes).Err(); err != nil {
+		return fmt.Errorf("ws: failed to publish message response: %w", err)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/Wave-95/boards/backend-core/internal/models"
@@ -25,23 +26,33 @@ const (
 	// Send pings to peer with this period. Must be less than pongWait.
 	pingPeriod = (pongWait * 9) / 10
 
-	// Maximum message size allowed from peer.
-	maxMessageSize = 512
+	// Maximum message size allowed from peer. Leaves room for 2000 characters of multi-byte post or comment
+	// content once escaped as JSON.
+	maxMessageSize = 16384
 )
 
 var (
 	newline = []byte{'\n'}
 )
 
-// Board is a thin wrapper that encapsulates write permissions for a client.
+// Board is a thin wrapper that encapsulates write and comment permissions, the current retro phase, and whether
+// the authors and contents of posts are hidden for a client.
 type Board struct {
-	canWrite bool
+	canWrite   bool
+	canComment bool
+	phase      models.BoardPhase
+	anonymous  bool
+	hidePosts  bool
 }
 
 // Client is a middleman between the websocket connection and the hub.
 type Client struct {
 	user *models.User
 
+	// Guards the boards and subscriptions maps, which are accessed from the read pump as well as from
+	// each subscription goroutine.
+	mu sync.Mutex
+
 	// A map of board IDs to Board.
 	boards map[string]Board
 
@@ -64,16 +75,57 @@ func (c *Client) subscribe(boardID string) {
 	pubsub := rdb.Subscribe(context.Background(), boardID)
 	defer pubsub.Close()
 
-	cancel := make(chan bool)
+	// Buffer the cancel channel so that cancelling never blocks on a subscription that has already ended.
+	cancel := make(chan bool, 1)
+	c.mu.Lock()
 	c.subscriptions[boardID] = cancel
+	c.mu.Unlock()
 
 	ch := pubsub.Channel()
 	fmt.Printf("Channel created for board %v\n", boardID)
 	for {
 		select {
 		case msg := <-ch:
-			// Forward messages received from pubsub channel to client
-			c.send <- []byte(msg.Payload)
+			event := parseBoardEvent(msg.Payload)
+			// Revealed posts are sent with their contents
+			if event.Event == EventBoardReveal {
+				c.setHidePosts(boardID, false)
+			}
+			// Forward messages received from pubsub channel to client, leaving out the authors of other users'
+			// posts on anonymous boards and their contents while posts are hidden
+			payload := []byte(msg.Payload)
+			if isPostEvent(event.Event) {
+				if hideAuthor, hideContent := c.postMasks(boardID); hideAuthor || hideContent {
+					payload = maskPost(payload, c.user.ID.String(), hideAuthor, hideContent)
+				}
+			}
+			c.send <- payload
+			// Stop listening to a board that no longer exists
+			if event.Event == EventBoardDelete {
+				fmt.Printf("Board deleted, removing subscription %v\n", boardID)
+				c.removeBoard(boardID)
+				return
+			}
+			// Keep write and comment access in sync with the user's role
+			if event.Event == EventBoardMemberUpdate && event.Result.UserID == c.user.ID.String() {
+				c.setRole(boardID, models.BoardMembershipRole(event.Result.Member.Membership.Role))
+			}
+			// Keep the phase in sync so that actions are checked against the current phase
+			if event.Event == EventBoardPhase {
+				c.setPhase(boardID, models.BoardPhase(event.Result.Phase))
+			}
+			// Keep the anonymous and hidden post settings in sync so that posts are masked as soon as they change
+			if event.Event == EventBoardUpdate {
+				c.setPostMasks(boardID, event.Result.AnonymousPosts, event.Result.HidePosts)
+			}
+			// Stop listening to a board that the user is no longer a member of
+			if event.Event == EventBoardMemberRemove && event.Result.UserID == c.user.ID.String() {
+				fmt.Printf("User removed from board, removing subscription %v\n", boardID)
+				c.removeBoard(boardID)
+				delUser(rdb, boardID, c.user.ID.String())
+				rdb.Publish(context.Background(), boardID, buildDisconnectMsg(c))
+				return
+			}
 		case <-cancel:
 			fmt.Printf("Cancelling subscription %v\n", boardID)
 			return
@@ -171,7 +223,107 @@ func (c *Client) writePump() {
 	}
 }
 
+// setBoard adds or replaces a board connected to the client.
+func (c *Client) setBoard(boardID string, board Board) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.boards[boardID] = board
+}
+
+// setRole updates the write and comment access of a board connected to the client to match a role.
+func (c *Client) setRole(boardID string, role models.BoardMembershipRole) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if board, ok := c.boards[boardID]; ok {
+		board.canWrite = role.CanWrite()
+		board.canComment = role.CanComment()
+		c.boards[boardID] = board
+	}
+}
+
+// setPhase updates the phase of a board connected to the client.
+func (c *Client) setPhase(boardID string, phase models.BoardPhase) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if board, ok := c.boards[boardID]; ok {
+		board.phase = phase
+		c.boards[boardID] = board
+	}
+}
+
+// setPostMasks updates whether the authors and contents of posts are hidden on a board connected to the client.
+func (c *Client) setPostMasks(boardID string, anonymous, hidePosts bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if board, ok := c.boards[boardID]; ok {
+		board.anonymous = anonymous
+		board.hidePosts = hidePosts
+		c.boards[boardID] = board
+	}
+}
+
+// setHidePosts updates whether the contents of posts are hidden on a board connected to the client.
+func (c *Client) setHidePosts(boardID string, hidePosts bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if board, ok := c.boards[boardID]; ok {
+		board.hidePosts = hidePosts
+		c.boards[boardID] = board
+	}
+}
+
+// postMasks reports whether the authors and contents of other users' posts are hidden on a board connected to
+// the client.
+func (c *Client) postMasks(boardID string) (hideAuthor, hideContent bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	board := c.boards[boardID]
+	return board.anonymous, board.hidePosts
+}
+
+// phase returns the phase of a board connected to the client.
+func (c *Client) phase(boardID string) (models.BoardPhase, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	board, ok := c.boards[boardID]
+	return board.phase, ok
+}
+
+// isConnected reports whether the client is connected to a board.
+func (c *Client) isConnected(boardID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.boards[boardID]
+	return ok
+}
+
+// canWrite reports whether the client is connected to a board with write access.
+func (c *Client) canWrite(boardID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	board, ok := c.boards[boardID]
+	return ok && board.canWrite
+}
+
+// canComment reports whether the client is connected to a board with comment access.
+func (c *Client) canComment(boardID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	board, ok := c.boards[boardID]
+	return ok && board.canComment
+}
+
+// removeBoard removes a board and its subscription from the client.
+func (c *Client) removeBoard(boardID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.subscriptions, boardID)
+	delete(c.boards, boardID)
+}
+
 func (c *Client) closeSubscriptions() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	for boardID, cancel := range c.subscriptions {
 		cancel <- true
 		rdb := c.ws.rdb
@@ -198,6 +350,18 @@ func handleMessage(c *Client, msg []byte) {
 		handleUserAuthenticate(c, msgReq)
 	case EventBoardConnect:
 		handleBoardConnect(c, msgReq)
+	case EventBoardPhase:
+		handleBoardPhase(c, msgReq)
+	case EventBoardReveal:
+		handleBoardReveal(c, msgReq)
+	case EventTimerStart:
+		handleTimerStart(c, msgReq)
+	case EventTimerPause:
+		handleTimerPause(c, msgReq)
+	case EventTimerExtend:
+		handleTimerExtend(c, msgReq)
+	case EventTimerReset:
+		handleTimerReset(c, msgReq)
 	case EventPostCreate:
 		handlePostCreate(c, msgReq)
 	case EventPostFocus:
@@ -212,12 +376,117 @@ func handleMessage(c *Client, msg []byte) {
 		handlePostGroupDelete(c, msgReq)
 	case EventPostDelete:
 		handlePostDelete(c, msgReq)
+	case EventPostVote, EventPostUnvote:
+		handlePostVote(c, msgReq)
+	case EventPostReact, EventPostUnreact:
+		handlePostReaction(c, msgReq)
+	case EventPostTag, EventPostUntag:
+		handlePostTag(c, msgReq)
+	case EventCommentCreate:
+		handleCommentCreate(c, msgReq)
+	case EventCommentUpdate:
+		handleCommentUpdate(c, msgReq)
+	case EventCommentDelete:
+		handleCommentDelete(c, msgReq)
 	default:
 		closeConnection(c, websocket.CloseInvalidFramePayloadData, CloseReasonUnsupportedEvent)
 		return
 	}
 }
 
+// boardEvent is the subset of a board channel message needed to manage a client's subscriptions.
+type boardEvent struct {
+	Event  string `json:"event"`
+	Result struct {
+		UserID         string `json:"user_id"`
+		Phase          string `json:"phase"`
+		AnonymousPosts bool   `json:"anonymous_posts"`
+		HidePosts      bool   `json:"hide_posts"`
+		Member         struct {
+			Membership struct {
+				Role string `json:"role"`
+			} `json:"membership"`
+		} `json:"member"`
+	} `json:"result"`
+}
+
+// parseBoardEvent parses a message published to a board channel. An empty boardEvent is returned if the
+// message cannot be parsed.
+func parseBoardEvent(payload string) boardEvent {
+	var event boardEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		return boardEvent{}
+	}
+	return event
+}
+
+// isPostEvent reports whether a board channel event carries posts along with their authors and contents.
+func isPostEvent(event string) bool {
+	switch event {
+	case EventPostCreate, EventPostUpdate, EventPostDetach, EventPostDelete, EventPostFocus, EventBoardReveal:
+		return true
+	}
+	return false
+}
+
+// maskPost leaves out the author, the content, or both of the posts in a post event unless they were created by
+// the given user. The payload is returned unchanged if it cannot be parsed.
+func maskPost(payload []byte, userID string, hideAuthor, hideContent bool) []byte {
+	var msg map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		return payload
+	}
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(msg["result"], &result); err != nil {
+		return payload
+	}
+	mask := func(post map[string]json.RawMessage) {
+		var author string
+		if err := json.Unmarshal(post["user_id"], &author); err != nil || author == userID {
+			return
+		}
+		if hideAuthor {
+			post["user_id"] = json.RawMessage("null")
+		}
+		if hideContent {
+			post["content"] = json.RawMessage("null")
+		}
+	}
+	// Deleted posts are sent as the result itself, while other events nest the posts under their own keys
+	if _, ok := result["user_id"]; ok {
+		mask(result)
+	} else {
+		for _, key := range []string{"post", "updated_post", "old_post"} {
+			var post map[string]json.RawMessage
+			if err := json.Unmarshal(result[key], &post); err != nil {
+				continue
+			}
+			mask(post)
+			if b, err := json.Marshal(post); err == nil {
+				result[key] = b
+			}
+		}
+		var posts []map[string]json.RawMessage
+		if err := json.Unmarshal(result["posts"], &posts); err == nil {
+			for _, post := range posts {
+				mask(post)
+			}
+			if b, err := json.Marshal(posts); err == nil {
+				result["posts"] = b
+			}
+		}
+	}
+	b, err := json.Marshal(result)
+	if err != nil {
+		return payload
+	}
+	msg["result"] = b
+	if b, err = json.Marshal(msg); err != nil {
+		return payload
+	}
+	return b
+}
+
 func buildDisconnectMsg(client *Client) []byte {
 	msgRes := ResponseUserDisconnect{
 		ResponseBase: ResponseBase{
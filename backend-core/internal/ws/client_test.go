@@ -0,0 +1,69 @@
+package ws
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskPost(t *testing.T) {
+	authorID := uuid.New().String()
+	memberID := uuid.New().String()
+
+	t.Run("posts nested in the result", func(t *testing.T) {
+		payload := []byte(`{"event":"post.update","success":true,"result":{"updated_post":{"id":"post","user_id":"` + authorID + `"},"old_post":{"id":"post","user_id":"` + authorID + `"}}}`)
+		var author, member struct {
+			Result map[string]map[string]any `json:"result"`
+		}
+
+		assert.NoError(t, json.Unmarshal(maskPost(payload, authorID, true, false), &author))
+		assert.Equal(t, authorID, author.Result["updated_post"]["user_id"])
+
+		assert.NoError(t, json.Unmarshal(maskPost(payload, memberID, true, false), &member))
+		assert.Nil(t, member.Result["updated_post"]["user_id"])
+		assert.Nil(t, member.Result["old_post"]["user_id"])
+		assert.Equal(t, "post", member.Result["updated_post"]["id"])
+	})
+
+	t.Run("post as the result", func(t *testing.T) {
+		payload := []byte(`{"event":"post.delete","success":true,"result":{"id":"post","user_id":"` + authorID + `"}}`)
+		var member struct {
+			Result map[string]any `json:"result"`
+		}
+		assert.NoError(t, json.Unmarshal(maskPost(payload, memberID, true, false), &member))
+		assert.Nil(t, member.Result["user_id"])
+		assert.Equal(t, "post", member.Result["id"])
+	})
+
+	t.Run("hidden content", func(t *testing.T) {
+		payload := []byte(`{"event":"post.create","success":true,"result":{"post":{"id":"post","user_id":"` + authorID + `","content":"Idea","color":"#F5E6E8"}}}`)
+		var member struct {
+			Result map[string]map[string]any `json:"result"`
+		}
+		assert.NoError(t, json.Unmarshal(maskPost(payload, memberID, false, true), &member))
+		assert.Nil(t, member.Result["post"]["content"])
+		assert.Equal(t, authorID, member.Result["post"]["user_id"])
+		assert.Equal(t, "#F5E6E8", member.Result["post"]["color"])
+	})
+
+	t.Run("revealed posts", func(t *testing.T) {
+		payload := []byte(`{"event":"board.reveal","success":true,"result":{"board_id":"board","posts":[{"id":"post","user_id":"` + authorID + `","content":"Idea"}]}}`)
+		var member struct {
+			Result struct {
+				Posts []map[string]any `json:"posts"`
+			} `json:"result"`
+		}
+		assert.NoError(t, json.Unmarshal(maskPost(payload, memberID, true, false), &member))
+		if assert.Len(t, member.Result.Posts, 1) {
+			assert.Nil(t, member.Result.Posts[0]["user_id"])
+			assert.Equal(t, "Idea", member.Result.Posts[0]["content"])
+		}
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		payload := []byte(`not json`)
+		assert.Equal(t, payload, maskPost(payload, memberID, true, false))
+	})
+}
@@ -3,6 +3,7 @@ package ws
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -114,6 +115,15 @@ func handleBoardConnect(c *Client, msgReq Request) {
 		}
 	} else {
 		rdb := c.ws.rdb
+		canWrite := board.UserCanWrite(boardWithMembers, user.ID.String())
+		canComment := board.UserCanComment(boardWithMembers, user.ID.String())
+		c.setBoard(boardID, Board{
+			canWrite:   canWrite,
+			canComment: canComment,
+			phase:      boardWithMembers.Phase,
+			anonymous:  boardWithMembers.AnonymousPosts,
+			hidePosts:  boardWithMembers.HidePosts,
+		})
 
 		go c.subscribe(boardID)
 
@@ -134,6 +144,15 @@ func handleBoardConnect(c *Client, msgReq Request) {
 			closeConnection(c, websocket.CloseProtocolError, CloseReasonInternalServer)
 		}
 
+		// Late joiners receive the current timer so that every client shows the same remaining time
+		var boardTimer *Timer
+		if timer, ok, err := getTimer(rdb, boardID); err != nil {
+			fmt.Printf("Issue getting board timer: %v", err)
+		} else if ok {
+			timer = timer.withRemaining(time.Now())
+			boardTimer = &timer
+		}
+
 		// Broacast successful message response
 		msgRes = ResponseBoardConnect{
 			ResponseBase: ResponseBase{
@@ -144,6 +163,10 @@ func handleBoardConnect(c *Client, msgReq Request) {
 				BoardID:        boardID,
 				NewUser:        *user,
 				ConnectedUsers: connectedUsers,
+				CanWrite:       canWrite,
+				CanComment:     canComment,
+				Phase:          boardWithMembers.Phase,
+				Timer:          boardTimer,
 			},
 		}
 	}
@@ -157,6 +180,167 @@ func handleBoardConnect(c *Client, msgReq Request) {
 	c.send <- msgResBytes
 }
 
+// handleBoardPhase moves a board to another retro phase and broadcasts the new phase to all subscribers. Only
+// board admins can change the phase.
+func handleBoardPhase(c *Client, msgReq Request) {
+	// Authenticate user
+	user := c.user
+	if user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	// Unmarshal message request
+	var params ParamsBoardPhase
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	boardID := params.BoardID
+	if !authorizeRead(c, msgReq, boardID) {
+		return
+	}
+	// Check that the user is an admin and that the board can move to the phase
+	boardWithMembers, ok := authorizeAdmin(c, msgReq, boardID)
+	if !ok {
+		return
+	}
+	if !boardWithMembers.Phase.CanTransitionTo(models.BoardPhase(params.Phase)) {
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInvalidPhaseTransition))
+		return
+	}
+	// Update phase
+	updatePhaseInput := board.UpdatePhaseInput{
+		BoardID: boardID,
+		UserID:  user.ID.String(),
+		Phase:   params.Phase,
+	}
+	updatedBoard, err := c.ws.boardService.UpdatePhase(context.Background(), updatePhaseInput)
+	if err != nil {
+		log.Printf("handler: failed to update board phase: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+		return
+	}
+	// Broadcast message response
+	if err := c.ws.BroadcastBoardPhase(context.Background(), updatedBoard); err != nil {
+		log.Printf("handler: failed to broadcast board phase: %v", err)
+	}
+}
+
+// handleBoardReveal reveals the hidden posts of a board and broadcasts their full contents to all subscribers.
+// Only board admins can reveal posts.
+func handleBoardReveal(c *Client, msgReq Request) {
+	// Authenticate user
+	user := c.user
+	if user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	// Unmarshal message request
+	var params ParamsBoardReveal
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	boardID := params.BoardID
+	if !authorizeRead(c, msgReq, boardID) {
+		return
+	}
+	if _, ok := authorizeAdmin(c, msgReq, boardID); !ok {
+		return
+	}
+	// Reveal posts
+	revealPostsInput := board.RevealPostsInput{
+		BoardID: boardID,
+		UserID:  user.ID.String(),
+	}
+	updatedBoard, err := c.ws.boardService.RevealPosts(context.Background(), revealPostsInput)
+	if err != nil {
+		log.Printf("handler: failed to reveal board posts: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+		return
+	}
+	posts, err := c.ws.postService.ListPosts(context.Background(), boardID)
+	if err != nil {
+		log.Printf("handler: failed to list board posts: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+		return
+	}
+	// Broadcast message response
+	if err := c.ws.BroadcastBoardReveal(context.Background(), updatedBoard, posts); err != nil {
+		log.Printf("handler: failed to broadcast board reveal: %v", err)
+	}
+}
+
+// handleTimerStart starts a board timer, or resumes a paused timer when no duration is given. Only board admins
+// can control the timer.
+func handleTimerStart(c *Client, msgReq Request) {
+	if c.user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	var params ParamsTimerStart
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	duration := time.Duration(params.Duration) * time.Second
+	if params.Duration != 0 && !validTimerDuration(duration) {
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInvalidTimerDuration))
+		return
+	}
+	updateTimer(c, msgReq, params.BoardID, func(timer Timer, now time.Time) (Timer, error) {
+		if params.Duration == 0 {
+			return timer.resume(now)
+		}
+		return timer.start(now, duration), nil
+	})
+}
+
+// handleTimerPause pauses a running board timer.
+func handleTimerPause(c *Client, msgReq Request) {
+	if c.user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	var params ParamsTimer
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	updateTimer(c, msgReq, params.BoardID, Timer.pause)
+}
+
+// handleTimerExtend adds time to a running or paused board timer.
+func handleTimerExtend(c *Client, msgReq Request) {
+	if c.user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	var params ParamsTimerExtend
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	duration := time.Duration(params.Duration) * time.Second
+	if !validTimerDuration(duration) {
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInvalidTimerDuration))
+		return
+	}
+	updateTimer(c, msgReq, params.BoardID, func(timer Timer, now time.Time) (Timer, error) {
+		return timer.extend(now, duration)
+	})
+}
+
+// handleTimerReset stops a board timer and clears its remaining time.
+func handleTimerReset(c *Client, msgReq Request) {
+	if c.user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	var params ParamsTimer
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	updateTimer(c, msgReq, params.BoardID, func(timer Timer, now time.Time) (Timer, error) {
+		return Timer{BoardID: timer.BoardID, Status: TimerStatusStopped}, nil
+	})
+}
+
 func handlePostCreate(c *Client, msgReq Request) {
 	// Authenticate user
 	user := c.user
@@ -169,18 +353,36 @@ func handlePostCreate(c *Client, msgReq Request) {
 	if err := unmarshalParams(msgReq, &params, c); err != nil {
 		return
 	}
+	if !authorizeWrite(c, msgReq, params.BoardID) {
+		return
+	}
+	if !authorizePhase(c, msgReq, params.BoardID, models.BoardPhase.CanAddPosts) {
+		return
+	}
+	// Posts can only be added to a post group that belongs to the same board
+	if params.PostGroupID != "" {
+		postGroup, ok := resolvePostGroup(c, msgReq, params.PostGroupID)
+		if !ok {
+			return
+		}
+		if postGroup.BoardID.String() != params.BoardID {
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgUnauthorized))
+			return
+		}
+	}
 	// Prepare create post input
 	createPostInput := post.CreatePostInput{
-		UserID:      user.ID.String(),
-		BoardID:     params.BoardID,
-		Content:     params.Content,
-		PosX:        params.PosX,
-		PosY:        params.PosY,
-		Color:       params.Color,
-		Height:      params.Height,
-		ZIndex:      params.ZIndex,
-		PostOrder:   params.PostOrder,
-		PostGroupID: params.PostGroupID,
+		UserID:        user.ID.String(),
+		BoardID:       params.BoardID,
+		Content:       params.Content,
+		ContentFormat: params.ContentFormat,
+		PosX:          params.PosX,
+		PosY:          params.PosY,
+		Color:         params.Color,
+		Height:        params.Height,
+		ZIndex:        params.ZIndex,
+		PostOrder:     params.PostOrder,
+		PostGroupID:   params.PostGroupID,
 	}
 	post, err := c.ws.postService.CreatePost(context.Background(), createPostInput)
 	if err != nil {
@@ -229,18 +431,14 @@ func handlePostFocus(c *Client, msgReq Request) {
 	if err := unmarshalParams(msgReq, &params, c); err != nil {
 		return
 	}
-	postID := params.ID
-	post, err := c.ws.postService.GetPost(context.Background(), postID)
-	if err != nil {
-		log.Printf("handler: failed to get post during post focus request: %v", err)
-		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
-	}
-	postGroup, err := c.ws.postService.GetPostGroup(context.Background(), post.PostGroupID.String())
-	if err != nil {
-		log.Printf("handler: failed to get post group during post focus request: %v", err)
-		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+	post, postGroup, ok := resolvePost(c, msgReq, params.ID)
+	if !ok {
+		return
 	}
 	boardID := postGroup.BoardID.String()
+	if !authorizeRead(c, msgReq, boardID) {
+		return
+	}
 	msgRes := ResponsePostFocus{
 		ResponseBase: ResponseBase{
 			Event:   msgReq.Event,
@@ -271,23 +469,43 @@ func handlePostUpdate(c *Client, msgReq Request) {
 	if err := unmarshalParams(msgReq, &params, c); err != nil {
 		return
 	}
-	existingPost, err := c.ws.postService.GetPost(context.Background(), params.UpdatePostInput.ID)
-	if err != nil {
-		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
-	}
-	postGroup, err := c.ws.postService.GetPostGroup(context.Background(), existingPost.PostGroupID.String())
-	if err != nil {
-		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+	existingPost, postGroup, ok := resolvePost(c, msgReq, params.UpdatePostInput.ID)
+	if !ok {
+		return
 	}
 	boardID := postGroup.BoardID.String()
+	if !authorizeWrite(c, msgReq, boardID) {
+		return
+	}
+	// Editing the post and moving it between post groups are allowed in different phases
+	if (params.Content != nil || params.ContentFormat != nil || params.Color != nil || params.Height != nil) &&
+		!authorizePhase(c, msgReq, boardID, models.BoardPhase.CanEditPosts) {
+		return
+	}
+	if (params.PostOrder != nil || params.PostGroupID != nil) &&
+		!authorizePhase(c, msgReq, boardID, models.BoardPhase.CanGroupPosts) {
+		return
+	}
+	// Posts can only be moved between post groups of the same board
+	if params.PostGroupID != nil {
+		targetPostGroup, ok := resolvePostGroup(c, msgReq, *params.PostGroupID)
+		if !ok {
+			return
+		}
+		if targetPostGroup.BoardID != postGroup.BoardID {
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgUnauthorized))
+			return
+		}
+	}
 	// Prepare update post input
 	updatePostInput := post.UpdatePostInput{
-		ID:          params.ID,
-		Content:     params.Content,
-		Color:       params.Color,
-		Height:      params.Height,
-		PostOrder:   params.PostOrder,
-		PostGroupID: params.PostGroupID,
+		ID:            params.ID,
+		Content:       params.Content,
+		ContentFormat: params.ContentFormat,
+		Color:         params.Color,
+		Height:        params.Height,
+		PostOrder:     params.PostOrder,
+		PostGroupID:   params.PostGroupID,
 	}
 	updatedPost, err := c.ws.postService.UpdatePost(context.Background(), updatePostInput)
 	if err != nil {
@@ -334,18 +552,17 @@ func handlePostDetach(c *Client, msgReq Request) {
 		return
 	}
 
-	postID := params.ID
-	existingPost, err := c.ws.postService.GetPost(context.Background(), postID)
-	if err != nil {
-		log.Printf("handler: failed to get post during post detach request: %v", err)
-		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
-	}
-	existingPostGroup, err := c.ws.postService.GetPostGroup(context.Background(), existingPost.PostGroupID.String())
-	if err != nil {
-		log.Printf("handler: failed to get post group during post detach request: %v", err)
-		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+	existingPost, existingPostGroup, ok := resolvePost(c, msgReq, params.ID)
+	if !ok {
+		return
 	}
 	boardID := existingPostGroup.BoardID.String()
+	if !authorizeWrite(c, msgReq, boardID) {
+		return
+	}
+	if !authorizePhase(c, msgReq, boardID, models.BoardPhase.CanGroupPosts) {
+		return
+	}
 	// Create new post group
 	createPostGroupInput := post.CreatePostGroupInput{
 		BoardID: boardID,
@@ -357,6 +574,7 @@ func handlePostDetach(c *Client, msgReq Request) {
 	if err != nil {
 		log.Printf("handler: failed to create a new post group during post detach request: %v", err)
 		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+		return
 	}
 	newPostGroupID := newPostGroup.ID.String()
 	updatePostInput := post.UpdatePostInput{
@@ -405,17 +623,17 @@ func handlePostDelete(c *Client, msgReq Request) {
 		return
 	}
 	postID := params.PostID
-	post, err := c.ws.postService.GetPost(context.Background(), postID)
-	if err != nil {
-		log.Printf("handler: failed to get post during post delete request: %v", err)
-		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
-	}
-	postGroup, err := c.ws.postService.GetPostGroup(context.Background(), post.PostGroupID.String())
-	if err != nil {
-		log.Printf("handler: failed to get post group during post delete request: %v", err)
-		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+	post, postGroup, ok := resolvePost(c, msgReq, postID)
+	if !ok {
+		return
 	}
 	boardID := postGroup.BoardID.String()
+	if !authorizeWrite(c, msgReq, boardID) {
+		return
+	}
+	if !authorizePhase(c, msgReq, boardID, models.BoardPhase.CanEditPosts) {
+		return
+	}
 	if err := c.ws.postService.DeletePost(context.Background(), postID); err != nil {
 		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
 		return
@@ -434,6 +652,329 @@ func handlePostDelete(c *Client, msgReq Request) {
 	c.ws.rdb.Publish(context.Background(), boardID, msgResBytes)
 }
 
+// handlePostVote casts or takes back a vote on a post. The new vote count is broadcast to all subscribers unless
+// the vote counts of the board are hidden, and the user who voted is sent the number of votes they have left.
+func handlePostVote(c *Client, msgReq Request) {
+	// Authenticate user
+	user := c.user
+	if user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	// Unmarshal message request
+	var params ParamsPostVote
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	_, postGroup, ok := resolvePost(c, msgReq, params.PostID)
+	if !ok {
+		return
+	}
+	boardID := postGroup.BoardID.String()
+	if !authorizeWrite(c, msgReq, boardID) {
+		return
+	}
+	if !authorizePhase(c, msgReq, boardID, models.BoardPhase.CanVote) {
+		return
+	}
+	// Look up the vote settings of the board
+	boardWithMembers, err := c.ws.boardService.GetBoardWithMembers(context.Background(), boardID)
+	if err != nil {
+		log.Printf("handler: failed to get board for vote: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgBoardNotFound))
+		return
+	}
+	voteInput := post.VoteInput{
+		PostID:    params.PostID,
+		UserID:    user.ID.String(),
+		VoteLimit: boardWithMembers.VoteLimit,
+	}
+	var votes post.VoteDTO
+	if msgReq.Event == EventPostVote {
+		votes, err = c.ws.postService.Vote(context.Background(), voteInput)
+	} else {
+		votes, err = c.ws.postService.Unvote(context.Background(), voteInput)
+	}
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			validationErrMsg := validator.GetValidationErrMsg(voteInput, err)
+			sendErrorMessage(c, buildErrorResponse(msgReq, validationErrMsg))
+		case errors.Is(err, post.ErrVoteLimitReached):
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgVoteLimitReached))
+		case errors.Is(err, post.ErrVoteNotFound):
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgVoteNotFound))
+		default:
+			log.Printf("handler: failed to update vote: %v", err)
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+		}
+		return
+	}
+	// Broadcast the new vote count unless vote counts are hidden
+	msgRes := ResponsePostVote{
+		ResponseBase: ResponseBase{
+			Event:   msgReq.Event,
+			Success: true,
+		},
+		Result: ResultPostVote{
+			PostID: params.PostID,
+		},
+	}
+	if !boardWithMembers.VotesHidden() {
+		msgRes.Result.Votes = &votes.Votes
+		if err := c.ws.publish(context.Background(), boardID, msgRes); err != nil {
+			log.Printf("handler: failed to broadcast vote: %v", err)
+		}
+	}
+	// Send the user their own votes
+	msgRes.Result.UserVotes = &votes.UserVotes
+	msgRes.Result.RemainingVotes = &votes.RemainingVotes
+	msgResBytes, err := json.Marshal(msgRes)
+	if err := handleMarshalError(err, "handlePostVote", c); err != nil {
+		return
+	}
+	c.send <- msgResBytes
+}
+
+// handlePostReaction adds or removes an emoji reaction on a post and broadcasts the reaction summaries of the
+// post to all subscribers. Reactions are allowed in every phase.
+func handlePostReaction(c *Client, msgReq Request) {
+	// Authenticate user
+	user := c.user
+	if user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	// Unmarshal message request
+	var params ParamsPostReaction
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	_, postGroup, ok := resolvePost(c, msgReq, params.PostID)
+	if !ok {
+		return
+	}
+	boardID := postGroup.BoardID.String()
+	if !authorizeWrite(c, msgReq, boardID) {
+		return
+	}
+	reactionInput := post.ReactionInput{
+		PostID: params.PostID,
+		UserID: user.ID.String(),
+		Emoji:  params.Emoji,
+	}
+	var reactions post.ReactionDTO
+	var err error
+	if msgReq.Event == EventPostReact {
+		reactions, err = c.ws.postService.React(context.Background(), reactionInput)
+	} else {
+		reactions, err = c.ws.postService.Unreact(context.Background(), reactionInput)
+	}
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			validationErrMsg := validator.GetValidationErrMsg(reactionInput, err)
+			sendErrorMessage(c, buildErrorResponse(msgReq, validationErrMsg))
+		case errors.Is(err, post.ErrReactionExists):
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgReactionExists))
+		case errors.Is(err, post.ErrReactionNotFound):
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgReactionNotFound))
+		default:
+			log.Printf("handler: failed to update reaction: %v", err)
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+		}
+		return
+	}
+	// Broadcast the reaction summaries of the post
+	msgRes := ResponsePostReaction{
+		ResponseBase: ResponseBase{
+			Event:   msgReq.Event,
+			Success: true,
+		},
+		Result: ResultPostReaction{
+			PostID:    params.PostID,
+			UserID:    user.ID.String(),
+			Emoji:     params.Emoji,
+			Reactions: reactions.Reactions,
+		},
+	}
+	if err := c.ws.publish(context.Background(), boardID, msgRes); err != nil {
+		log.Printf("handler: failed to broadcast reaction: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+	}
+}
+
+// handlePostTag adds a tag to a post or removes a tag from a post and broadcasts the tags of the post to all
+// subscribers. Tags can be changed in every phase.
+func handlePostTag(c *Client, msgReq Request) {
+	// Authenticate user
+	user := c.user
+	if user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	// Unmarshal message request
+	var params ParamsPostTag
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	_, postGroup, ok := resolvePost(c, msgReq, params.PostID)
+	if !ok {
+		return
+	}
+	boardID := postGroup.BoardID.String()
+	if !authorizeWrite(c, msgReq, boardID) {
+		return
+	}
+	tagInput := post.TagInput{
+		PostID: params.PostID,
+		UserID: user.ID.String(),
+		TagID:  params.TagID,
+	}
+	var postTags post.PostTagsDTO
+	var err error
+	if msgReq.Event == EventPostTag {
+		postTags, err = c.ws.postService.Tag(context.Background(), tagInput)
+	} else {
+		postTags, err = c.ws.postService.Untag(context.Background(), tagInput)
+	}
+	if err != nil {
+		switch {
+		case validator.IsValidationError(err):
+			validationErrMsg := validator.GetValidationErrMsg(tagInput, err)
+			sendErrorMessage(c, buildErrorResponse(msgReq, validationErrMsg))
+		case errors.Is(err, post.ErrTagNotFound):
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgTagNotFound))
+		case errors.Is(err, post.ErrPostTagExists):
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgPostTagExists))
+		case errors.Is(err, post.ErrPostTagNotFound):
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgPostTagNotFound))
+		default:
+			log.Printf("handler: failed to update post tags: %v", err)
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+		}
+		return
+	}
+	// Broadcast the tags of the post
+	msgRes := ResponsePostTag{
+		ResponseBase: ResponseBase{
+			Event:   msgReq.Event,
+			Success: true,
+		},
+		Result: ResultPostTag{
+			PostID: params.PostID,
+			TagID:  params.TagID,
+			TagIDs: postTags.TagIDs,
+		},
+	}
+	if err := c.ws.publish(context.Background(), boardID, msgRes); err != nil {
+		log.Printf("handler: failed to broadcast post tags: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+	}
+}
+
+// handleCommentCreate adds a comment to a post and broadcasts it to all subscribers. Comments are allowed in
+// every phase.
+func handleCommentCreate(c *Client, msgReq Request) {
+	// Authenticate user
+	user := c.user
+	if user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	// Unmarshal message request
+	var params ParamsCommentCreate
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	_, postGroup, ok := resolvePost(c, msgReq, params.PostID)
+	if !ok {
+		return
+	}
+	boardID := postGroup.BoardID.String()
+	if !authorizeComment(c, msgReq, boardID) {
+		return
+	}
+	input := post.CreateCommentInput{
+		PostID:   params.PostID,
+		UserID:   user.ID.String(),
+		ParentID: params.ParentID,
+		Content:  params.Content,
+	}
+	comment, err := c.ws.postService.CreateComment(context.Background(), input)
+	if err != nil {
+		sendCommentError(c, msgReq, input, err)
+		return
+	}
+	publishComment(c, msgReq, comment)
+}
+
+// handleCommentUpdate edits a comment and broadcasts the edited comment to all subscribers.
+func handleCommentUpdate(c *Client, msgReq Request) {
+	// Authenticate user
+	user := c.user
+	if user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	// Unmarshal message request
+	var params ParamsCommentUpdate
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	existing, ok := resolveComment(c, msgReq, params.CommentID)
+	if !ok {
+		return
+	}
+	if !authorizeComment(c, msgReq, existing.BoardID.String()) {
+		return
+	}
+	input := post.UpdateCommentInput{
+		CommentID: params.CommentID,
+		UserID:    user.ID.String(),
+		Content:   params.Content,
+	}
+	comment, err := c.ws.postService.UpdateComment(context.Background(), input)
+	if err != nil {
+		sendCommentError(c, msgReq, input, err)
+		return
+	}
+	publishComment(c, msgReq, comment)
+}
+
+// handleCommentDelete deletes a comment along with its replies and broadcasts the deleted comment to all
+// subscribers.
+func handleCommentDelete(c *Client, msgReq Request) {
+	// Authenticate user
+	user := c.user
+	if user == nil {
+		closeConnection(c, websocket.ClosePolicyViolation, CloseReasonUnauthorized)
+		return
+	}
+	// Unmarshal message request
+	var params ParamsCommentDelete
+	if err := unmarshalParams(msgReq, &params, c); err != nil {
+		return
+	}
+	existing, ok := resolveComment(c, msgReq, params.CommentID)
+	if !ok {
+		return
+	}
+	if !authorizeComment(c, msgReq, existing.BoardID.String()) {
+		return
+	}
+	input := post.DeleteCommentInput{
+		CommentID: params.CommentID,
+		UserID:    user.ID.String(),
+	}
+	comment, err := c.ws.postService.DeleteComment(context.Background(), input)
+	if err != nil {
+		sendCommentError(c, msgReq, input, err)
+		return
+	}
+	publishComment(c, msgReq, comment)
+}
+
 // handlePostGroupUpdate handles a message request to update a post group.
 func handlePostGroupUpdate(c *Client, msgReq Request) {
 	user := c.user
@@ -445,7 +986,18 @@ func handlePostGroupUpdate(c *Client, msgReq Request) {
 	if err := unmarshalParams(msgReq, &params, c); err != nil {
 		return
 	}
-	boardID := params.BoardID
+	// Broadcast to the post group's board rather than the board ID supplied by the client
+	existingPostGroup, ok := resolvePostGroup(c, msgReq, params.ID)
+	if !ok {
+		return
+	}
+	boardID := existingPostGroup.BoardID.String()
+	if !authorizeWrite(c, msgReq, boardID) {
+		return
+	}
+	if !authorizePhase(c, msgReq, boardID, models.BoardPhase.CanGroupPosts) {
+		return
+	}
 	updatePostInput := post.UpdatePostGroupInput{
 		ID:     params.ID,
 		Title:  params.Title,
@@ -494,15 +1046,19 @@ func handlePostGroupDelete(c *Client, msgReq Request) {
 	}
 	// Get post group and check if user has write permissions
 	postGroupID := params.PostGroupID
-	postGroup, err := c.ws.postService.GetPostGroup(context.Background(), postGroupID)
-	if err != nil {
-		log.Printf("handler: failed to get post group: %v", err)
-		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+	postGroup, ok := resolvePostGroup(c, msgReq, postGroupID)
+	if !ok {
 		return
 	}
 	boardID := postGroup.BoardID.String()
+	if !authorizeWrite(c, msgReq, boardID) {
+		return
+	}
+	if !authorizePhase(c, msgReq, boardID, models.BoardPhase.CanGroupPosts) {
+		return
+	}
 	// Delete post group
-	err = c.ws.postService.DeletePostGroup(context.Background(), postGroupID)
+	err := c.ws.postService.DeletePostGroup(context.Background(), postGroupID)
 	if err != nil {
 		log.Printf("handler: failed to delete post group: %v", err)
 		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
@@ -526,6 +1082,192 @@ func handlePostGroupDelete(c *Client, msgReq Request) {
 	c.ws.rdb.Publish(context.Background(), boardID, msgResBytes)
 }
 
+// authorizeRead is a helper function that checks if a client is connected to a board. If the client is
+// not, an error response is sent to the client and false is returned.
+func authorizeRead(c *Client, msgReq Request, boardID string) bool {
+	if !c.isConnected(boardID) {
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgUnauthorized))
+		return false
+	}
+	return true
+}
+
+// authorizeWrite is a helper function that checks if a client has write access to a board. If the client
+// does not, an error response is sent to the client and false is returned.
+func authorizeWrite(c *Client, msgReq Request, boardID string) bool {
+	if !c.canWrite(boardID) {
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgReadOnly))
+		return false
+	}
+	return true
+}
+
+// authorizeComment is a helper function that checks if a client is allowed to comment on the posts of a board.
+// If the client is not, an error response is sent to the client and false is returned.
+func authorizeComment(c *Client, msgReq Request, boardID string) bool {
+	if !c.canComment(boardID) {
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgCannotComment))
+		return false
+	}
+	return true
+}
+
+// authorizeAdmin is a helper function that checks if the client's user is an admin of a board. If the user is
+// not, an error response is sent to the client and false is returned.
+func authorizeAdmin(c *Client, msgReq Request, boardID string) (board.BoardWithMembersDTO, bool) {
+	boardWithMembers, err := c.ws.boardService.GetBoardWithMembers(context.Background(), boardID)
+	if err != nil {
+		log.Printf("handler: failed to get board: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgBoardNotFound))
+		return board.BoardWithMembersDTO{}, false
+	}
+	if !board.UserIsAdmin(boardWithMembers, c.user.ID.String()) {
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgUnauthorized))
+		return board.BoardWithMembersDTO{}, false
+	}
+	return boardWithMembers, true
+}
+
+// updateTimer is a helper function that applies a change to a board timer on behalf of a board admin, stores
+// the result in Redis, and broadcasts it to all subscribers. If the change fails, an error response is sent to
+// the client instead.
+func updateTimer(c *Client, msgReq Request, boardID string, change func(Timer, time.Time) (Timer, error)) {
+	if !authorizeRead(c, msgReq, boardID) {
+		return
+	}
+	if _, ok := authorizeAdmin(c, msgReq, boardID); !ok {
+		return
+	}
+	rdb := c.ws.rdb
+	timer, ok, err := getTimer(rdb, boardID)
+	if err != nil {
+		log.Printf("handler: failed to get board timer: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+		return
+	}
+	if !ok {
+		timer = Timer{BoardID: boardID, Status: TimerStatusStopped}
+	}
+	updatedTimer, err := change(timer, time.Now())
+	if err != nil {
+		switch {
+		case errors.Is(err, errTimerNotRunning):
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgTimerNotRunning))
+		case errors.Is(err, errTimerNotStarted):
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgTimerNotStarted))
+		default:
+			sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+		}
+		return
+	}
+	if updatedTimer.Status == TimerStatusStopped {
+		err = delTimer(rdb, boardID)
+	} else {
+		err = setTimer(rdb, updatedTimer)
+	}
+	if err != nil {
+		log.Printf("handler: failed to store board timer: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+		return
+	}
+	msgRes := ResponseTimer{
+		ResponseBase: ResponseBase{
+			Event:   msgReq.Event,
+			Success: true,
+		},
+		Result: updatedTimer,
+	}
+	if err := c.ws.publish(context.Background(), boardID, msgRes); err != nil {
+		log.Printf("handler: failed to broadcast board timer: %v", err)
+	}
+}
+
+// authorizePhase is a helper function that checks if the board's current phase allows an action. If it does
+// not, an error response is sent to the client and false is returned.
+func authorizePhase(c *Client, msgReq Request, boardID string, allowed func(models.BoardPhase) bool) bool {
+	phase, ok := c.phase(boardID)
+	if !ok || !allowed(phase) {
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgPhase))
+		return false
+	}
+	return true
+}
+
+// resolvePost is a helper function that looks up a post along with its post group, which determines the
+// board that the post belongs to. If either cannot be found, an error response is sent to the client and
+// false is returned.
+func resolvePost(c *Client, msgReq Request, postID string) (models.Post, models.PostGroup, bool) {
+	post, err := c.ws.postService.GetPost(context.Background(), postID)
+	if err != nil {
+		log.Printf("handler: failed to get post: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgPostNotFound))
+		return models.Post{}, models.PostGroup{}, false
+	}
+	postGroup, ok := resolvePostGroup(c, msgReq, post.PostGroupID.String())
+	if !ok {
+		return models.Post{}, models.PostGroup{}, false
+	}
+	return post, postGroup, true
+}
+
+// resolveComment is a helper function that looks up a comment. If the comment cannot be found, an error response
+// is sent to the client and false is returned.
+func resolveComment(c *Client, msgReq Request, commentID string) (models.PostComment, bool) {
+	comment, err := c.ws.postService.GetComment(context.Background(), commentID)
+	if err != nil {
+		log.Printf("handler: failed to get comment: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgCommentNotFound))
+		return models.PostComment{}, false
+	}
+	return comment, true
+}
+
+// sendCommentError is a helper function that sends the client the error response matching a failed comment
+// request.
+func sendCommentError(c *Client, msgReq Request, input any, err error) {
+	switch {
+	case validator.IsValidationError(err):
+		sendErrorMessage(c, buildErrorResponse(msgReq, validator.GetValidationErrMsg(input, err)))
+	case errors.Is(err, post.ErrCommentNotFound):
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgCommentNotFound))
+	case errors.Is(err, post.ErrNotCommentAuthor):
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgNotCommentAuthor))
+	case errors.Is(err, post.ErrInvalidParentComment):
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInvalidParentComment))
+	default:
+		log.Printf("handler: failed to %v: %v", msgReq.Event, err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+	}
+}
+
+// publishComment is a helper function that broadcasts a created, edited, or deleted comment to all subscribers
+// of the comment's board.
+func publishComment(c *Client, msgReq Request, comment models.PostComment) {
+	msgRes := ResponseComment{
+		ResponseBase: ResponseBase{
+			Event:   msgReq.Event,
+			Success: true,
+		},
+		Result: comment,
+	}
+	if err := c.ws.publish(context.Background(), comment.BoardID.String(), msgRes); err != nil {
+		log.Printf("handler: failed to broadcast comment: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgInternalServer))
+	}
+}
+
+// resolvePostGroup is a helper function that looks up a post group. If the post group cannot be found, an
+// error response is sent to the client and false is returned.
+func resolvePostGroup(c *Client, msgReq Request, postGroupID string) (models.PostGroup, bool) {
+	postGroup, err := c.ws.postService.GetPostGroup(context.Background(), postGroupID)
+	if err != nil {
+		log.Printf("handler: failed to get post group: %v", err)
+		sendErrorMessage(c, buildErrorResponse(msgReq, ErrMsgPostGroupNotFound))
+		return models.PostGroup{}, false
+	}
+	return postGroup, true
+}
+
 // unmarshalParams is a helper function that unmarshals a message request's params and sends
 // out a close connection message if any errors are encountered.
 func unmarshalParams(msgReq Request, v any, c *Client) error {
@@ -1,24 +1,28 @@
 package ws
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Wave-95/boards/backend-core/internal/board"
 	"github.com/Wave-95/boards/backend-core/internal/config"
 	"github.com/Wave-95/boards/backend-core/internal/jwt"
 	"github.com/Wave-95/boards/backend-core/internal/models"
 	"github.com/Wave-95/boards/backend-core/internal/post"
+	"github.com/Wave-95/boards/backend-core/internal/storage"
 	"github.com/Wave-95/boards/backend-core/internal/test"
 	"github.com/Wave-95/boards/backend-core/internal/user"
 	"github.com/Wave-95/boards/backend-core/pkg/validator"
 	"github.com/Wave-95/boards/wrappers/amqp"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,8 +36,8 @@ func TestHandleWebSocket(t *testing.T) {
 	// Set up mock services
 	validator := validator.New()
 	mockUserService := user.NewService(mockUserRepo, mockAmqp, validator)
-	mockBoardService := board.NewService(mockBoardRepo, mockAmqp, validator)
-	mockPostService := post.NewService(mockPostRepo)
+	mockBoardService := board.NewService(mockBoardRepo, storage.NewLocal(t.TempDir()), mockAmqp, validator, time.Hour)
+	mockPostService := post.NewService(mockPostRepo, storage.NewLocal(t.TempDir()), mockBoardService, mockAmqp)
 	jwtService := jwt.New("jwt_secret", 1)
 
 	// Set up server
@@ -201,6 +205,118 @@ func TestHandleWebSocket(t *testing.T) {
 			assert.Equal(t, ErrMsgBoardNotFound, resBoardConnect.ErrorMessage)
 		})
 	})
+
+	t.Run("post.update", func(t *testing.T) {
+		testUser := test.NewUser()
+		if err := mockUserRepo.CreateUser(context.Background(), testUser); err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+		testBoard := test.NewBoard(testUser.ID)
+		if err := mockBoardRepo.CreateBoard(context.Background(), testBoard); err != nil {
+			t.Fatalf("Failed to create test board: %v", err)
+		}
+		testPost, err := mockPostService.CreatePost(context.Background(), post.CreatePostInput{
+			UserID:  testUser.ID.String(),
+			BoardID: testBoard.ID.String(),
+			Color:   models.PostColorLightPink,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create test post: %v", err)
+		}
+
+		c := setupConnection(t, server)
+		authenticateUser(t, c, jwtService, testUser)
+		connectToBoard(t, c, rdb, testBoard.ID.String())
+
+		t.Run("update content and content format", func(t *testing.T) {
+			// Multi-byte content at the maximum post length must fit in a single message
+			content := "**" + strings.Repeat("é", 1996) + "**"
+			contentFormat := string(models.PostContentFormatMarkdown)
+			msgReq := RequestPostUpdate{
+				Event: EventPostUpdate,
+				Params: ParamsPostUpdate{post.UpdatePostInput{
+					ID:            testPost.ID.String(),
+					Content:       &content,
+					ContentFormat: &contentFormat,
+				}},
+			}
+			if err := c.WriteJSON(msgReq); err != nil {
+				t.Fatalf("Failed to write JSON for message request: %v", err)
+			}
+			var resPostUpdate ResponsePostUpdate
+			readResponse(t, c, EventPostUpdate, &resPostUpdate)
+			assert.True(t, resPostUpdate.Success, resPostUpdate.ErrorMessage)
+			assert.Equal(t, content, resPostUpdate.Result.UpdatedPost.Content)
+			assert.Equal(t, models.PostContentFormatMarkdown, resPostUpdate.Result.UpdatedPost.ContentFormat)
+			assert.Equal(t, models.PostContentFormatPlain, resPostUpdate.Result.OldPost.ContentFormat)
+		})
+	})
+
+	t.Run("commenter role", func(t *testing.T) {
+		owner := test.NewUser()
+		commenter := test.NewUser()
+		if err := mockUserRepo.CreateUser(context.Background(), commenter); err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+		testBoard := test.NewBoard(owner.ID)
+		if err := mockBoardRepo.CreateBoard(context.Background(), testBoard); err != nil {
+			t.Fatalf("Failed to create test board: %v", err)
+		}
+		mockBoardRepo.AddUser(commenter)
+		membership := models.BoardMembership{ID: uuid.New(), BoardID: testBoard.ID, UserID: commenter.ID, Role: models.RoleCommenter}
+		if err := mockBoardRepo.CreateMembership(context.Background(), membership); err != nil {
+			t.Fatalf("Failed to create test membership: %v", err)
+		}
+		testPost, err := mockPostService.CreatePost(context.Background(), post.CreatePostInput{
+			UserID:  owner.ID.String(),
+			BoardID: testBoard.ID.String(),
+			Color:   models.PostColorLightPink,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create test post: %v", err)
+		}
+
+		c := setupConnection(t, server)
+		authenticateUser(t, c, jwtService, commenter)
+		resBoardConnect := connectToBoard(t, c, rdb, testBoard.ID.String())
+		assert.False(t, resBoardConnect.Result.CanWrite)
+		assert.True(t, resBoardConnect.Result.CanComment)
+
+		t.Run("can comment on posts", func(t *testing.T) {
+			msgReq := RequestCommentCreate{
+				Event:  EventCommentCreate,
+				Params: ParamsCommentCreate{PostID: testPost.ID.String(), Content: "Agreed"},
+			}
+			if err := c.WriteJSON(msgReq); err != nil {
+				t.Fatalf("Failed to write JSON for message request: %v", err)
+			}
+			var resComment ResponseComment
+			readResponse(t, c, EventCommentCreate, &resComment)
+			assert.True(t, resComment.Success, resComment.ErrorMessage)
+			assert.Equal(t, commenter.ID, resComment.Result.UserID)
+			assert.Equal(t, "Agreed", resComment.Result.Content)
+		})
+
+		t.Run("cannot create posts", func(t *testing.T) {
+			msgReq := RequestPostCreate{
+				Event: EventPostCreate,
+				Params: ParamsPostCreate{
+					BoardID: testBoard.ID.String(),
+					PosX:    10,
+					PosY:    10,
+					Color:   models.PostColorLightPink,
+					ZIndex:  1,
+				},
+			}
+			if err := c.WriteJSON(msgReq); err != nil {
+				t.Fatalf("Failed to write JSON for message request: %v", err)
+			}
+			var resPostCreate ResponsePostCreate
+			readResponse(t, c, EventPostCreate, &resPostCreate)
+			assert.False(t, resPostCreate.Success)
+			assert.Equal(t, ErrMsgReadOnly, resPostCreate.ErrorMessage)
+		})
+	})
 }
 
 func setupConnection(t *testing.T, server *httptest.Server) *websocket.Conn {
@@ -235,3 +351,61 @@ func authenticateUser(t *testing.T, c *websocket.Conn, jwtService jwt.Service, t
 		t.Fatalf("Could not authenticate user: %v", err)
 	}
 }
+
+// connectToBoard connects an authenticated connection to a board and returns the board connect response. It waits
+// for the board subscription to be active so that the responses published to the board are not missed.
+func connectToBoard(t *testing.T, c *websocket.Conn, rdb *redis.Client, boardID string) ResponseBoardConnect {
+	msgReq := RequestBoardConnect{
+		Event:  EventBoardConnect,
+		Params: ParamsBoardConnect{BoardID: boardID},
+	}
+	if err := c.WriteJSON(msgReq); err != nil {
+		t.Fatalf("Failed to write JSON for message request: %v", err)
+	}
+	var resBoardConnect ResponseBoardConnect
+	readResponse(t, c, EventBoardConnect, &resBoardConnect)
+	if !resBoardConnect.Success {
+		t.Fatalf("Could not connect user to board: %v", resBoardConnect.ErrorMessage)
+	}
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		subscribers, err := rdb.PubSubNumSub(context.Background(), boardID).Result()
+		if err != nil {
+			t.Fatalf("Failed to get board subscribers: %v", err)
+		}
+		if subscribers[boardID] > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Board subscription for %v did not become active", boardID)
+		}
+	}
+	return resBoardConnect
+}
+
+// readResponse reads messages until it receives one for the given event and unmarshals it into v. Messages for
+// other events, such as broadcasts from the board subscription, are skipped. Queued messages can be sent together
+// in a single websocket message separated by newlines, so each line is handled as its own message.
+func readResponse(t *testing.T, c *websocket.Conn, event string, v any) {
+	if err := c.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatalf("Failed to set read deadline: %v", err)
+	}
+	for {
+		_, data, err := c.ReadMessage()
+		if err != nil {
+			t.Fatalf("Failed to read %v response: %v", event, err)
+		}
+		for _, msgRes := range bytes.Split(data, newline) {
+			var res ResponseBase
+			if err := json.Unmarshal(msgRes, &res); err != nil {
+				t.Fatalf("Failed to unmarshal JSON: %v", err)
+			}
+			if res.Event != event {
+				continue
+			}
+			if err := json.Unmarshal(msgRes, v); err != nil {
+				t.Fatalf("Failed to unmarshal %v response: %v", event, err)
+			}
+			return
+		}
+	}
+}
@@ -3,7 +3,9 @@ package ws
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Wave-95/boards/backend-core/internal/config"
 	"github.com/Wave-95/boards/backend-core/internal/models"
@@ -50,3 +52,72 @@ func delUser(rdb *redis.Client, boardID string, userID string) error {
 
 	return nil
 }
+
+// timersKey is the sorted set of running board timers scored by the unix time in milliseconds that they end at.
+const timersKey = "timers"
+
+// claimExpiredTimersScript removes the running timers that have ended, along with their state, and returns their
+// board IDs. Running it as a script makes the claim atomic, so each expired timer is only returned to one replica.
+var claimExpiredTimersScript = redis.NewScript(`
+local boardIDs = redis.call("ZRANGEBYSCORE", KEYS[1], "-inf", ARGV[1])
+for _, boardID in ipairs(boardIDs) do
+	redis.call("ZREM", KEYS[1], boardID)
+	redis.call("DEL", "timer:" .. boardID)
+end
+return boardIDs
+`)
+
+// timerKey returns the key that stores the timer of a board.
+func timerKey(boardID string) string {
+	return "timer:" + boardID
+}
+
+// setTimer stores the timer of a board. Running timers are also added to the timers sorted set so that they
+// are picked up once they end.
+func setTimer(rdb *redis.Client, timer Timer) error {
+	timerBytes, err := json.Marshal(timer)
+	if err != nil {
+		return err
+	}
+	_, err = rdb.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.Set(context.Background(), timerKey(timer.BoardID), timerBytes, 0)
+		if timer.Status == TimerStatusRunning && timer.EndsAt != nil {
+			pipe.ZAdd(context.Background(), timersKey, redis.Z{Score: float64(timer.EndsAt.UnixMilli()), Member: timer.BoardID})
+		} else {
+			pipe.ZRem(context.Background(), timersKey, timer.BoardID)
+		}
+		return nil
+	})
+	return err
+}
+
+// getTimer returns the timer of a board. False is returned if the board does not have a timer.
+func getTimer(rdb *redis.Client, boardID string) (Timer, bool, error) {
+	timerBytes, err := rdb.Get(context.Background(), timerKey(boardID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return Timer{}, false, nil
+	}
+	if err != nil {
+		return Timer{}, false, err
+	}
+	var timer Timer
+	if err := json.Unmarshal(timerBytes, &timer); err != nil {
+		return Timer{}, false, err
+	}
+	return timer, true, nil
+}
+
+// delTimer deletes the timer of a board.
+func delTimer(rdb *redis.Client, boardID string) error {
+	_, err := rdb.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.Del(context.Background(), timerKey(boardID))
+		pipe.ZRem(context.Background(), timersKey, boardID)
+		return nil
+	})
+	return err
+}
+
+// claimExpiredTimers removes the board timers that have ended by the given time and returns their board IDs.
+func claimExpiredTimers(rdb *redis.Client, now time.Time) ([]string, error) {
+	return claimExpiredTimersScript.Run(context.Background(), rdb, []string{timersKey}, now.UnixMilli()).StringSlice()
+}
@@ -0,0 +1,155 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+const (
+	// How often each replica checks Redis for board timers that have run out.
+	timerCheckInterval = time.Second
+
+	// Longest duration that a board timer can be started with or extended by.
+	maxTimerDuration = 2 * time.Hour
+)
+
+var (
+	errTimerNotRunning = errors.New("ws: timer is not running")
+	errTimerNotStarted = errors.New("ws: timer has not been started")
+)
+
+// TimerStatus is a custom string type to represent the status of a board timer.
+type TimerStatus string
+
+const (
+	// TimerStatusRunning represents a timer that is counting down.
+	TimerStatusRunning TimerStatus = "RUNNING"
+	// TimerStatusPaused represents a timer that has been paused with time remaining.
+	TimerStatusPaused TimerStatus = "PAUSED"
+	// TimerStatusStopped represents a timer that has been reset.
+	TimerStatusStopped TimerStatus = "STOPPED"
+	// TimerStatusFinished represents a timer that has run out.
+	TimerStatusFinished TimerStatus = "FINISHED"
+)
+
+// Timer is the countdown timer of a board. The authoritative state is stored in Redis so that every replica
+// reports the same remaining time. EndsAt is only set while the timer is running, and Remaining is the time
+// left when the timer was last paused or, for running timers, the time left when the timer was sent.
+type Timer struct {
+	BoardID   string      `json:"board_id"`
+	Status    TimerStatus `json:"status"`
+	Duration  int64       `json:"duration_ms"`
+	Remaining int64       `json:"remaining_ms"`
+	EndsAt    *time.Time  `json:"ends_at"`
+}
+
+// start returns a running timer that ends after the duration.
+func (t Timer) start(now time.Time, duration time.Duration) Timer {
+	endsAt := now.Add(duration)
+	return Timer{
+		BoardID:   t.BoardID,
+		Status:    TimerStatusRunning,
+		Duration:  duration.Milliseconds(),
+		Remaining: duration.Milliseconds(),
+		EndsAt:    &endsAt,
+	}
+}
+
+// resume returns a running timer that ends after the remaining time of a paused timer.
+func (t Timer) resume(now time.Time) (Timer, error) {
+	if t.Status != TimerStatusPaused {
+		return Timer{}, errTimerNotStarted
+	}
+	endsAt := now.Add(time.Duration(t.Remaining) * time.Millisecond)
+	t.Status = TimerStatusRunning
+	t.EndsAt = &endsAt
+	return t, nil
+}
+
+// pause returns a paused timer that keeps the time remaining on a running timer.
+func (t Timer) pause(now time.Time) (Timer, error) {
+	if t.Status != TimerStatusRunning {
+		return Timer{}, errTimerNotRunning
+	}
+	t = t.withRemaining(now)
+	if t.Remaining == 0 {
+		return Timer{}, errTimerNotRunning
+	}
+	t.Status = TimerStatusPaused
+	t.EndsAt = nil
+	return t, nil
+}
+
+// extend returns a running or paused timer with more time added to it.
+func (t Timer) extend(now time.Time, duration time.Duration) (Timer, error) {
+	switch t.Status {
+	case TimerStatusRunning:
+		if !t.EndsAt.After(now) {
+			return Timer{}, errTimerNotRunning
+		}
+		endsAt := t.EndsAt.Add(duration)
+		t.EndsAt = &endsAt
+	case TimerStatusPaused:
+		t.Remaining += duration.Milliseconds()
+	default:
+		return Timer{}, errTimerNotStarted
+	}
+	t.Duration += duration.Milliseconds()
+	return t.withRemaining(now), nil
+}
+
+// withRemaining returns the timer with Remaining set to the time left on a running timer at the given time.
+func (t Timer) withRemaining(now time.Time) Timer {
+	if t.Status == TimerStatusRunning && t.EndsAt != nil {
+		t.Remaining = t.EndsAt.Sub(now).Milliseconds()
+		if t.Remaining < 0 {
+			t.Remaining = 0
+		}
+	}
+	return t
+}
+
+// validTimerDuration checks if a duration can be used to start or extend a board timer.
+func validTimerDuration(duration time.Duration) bool {
+	return duration > 0 && duration <= maxTimerDuration
+}
+
+// RunTimers is a blocking operation that emits a timer.finished event for every board timer that runs out.
+// Every replica runs it, and Redis hands each expired timer to exactly one of them so the event is only
+// published once.
+func (ws *WebSocket) RunTimers() {
+	ticker := time.NewTicker(timerCheckInterval)
+	defer ticker.Stop()
+	for {
+		<-ticker.C
+		if err := ws.finishTimers(context.Background()); err != nil {
+			log.Printf("ws: failed to finish timers: %v", err)
+		}
+	}
+}
+
+// finishTimers claims the board timers that have run out and publishes a timer.finished event for each of them.
+func (ws *WebSocket) finishTimers(ctx context.Context) error {
+	boardIDs, err := claimExpiredTimers(ws.rdb, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, boardID := range boardIDs {
+		msgRes := ResponseTimer{
+			ResponseBase: ResponseBase{
+				Event:   EventTimerFinished,
+				Success: true,
+			},
+			Result: Timer{
+				BoardID: boardID,
+				Status:  TimerStatusFinished,
+			},
+		}
+		if err := ws.publish(ctx, boardID, msgRes); err != nil {
+			log.Printf("ws: failed to publish finished timer for board %v: %v", boardID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package ws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimer(t *testing.T) {
+	now := time.Now()
+	timer := Timer{BoardID: "board", Status: TimerStatusStopped}
+
+	t.Run("pause or resume a timer that has not been started", func(t *testing.T) {
+		_, err := timer.pause(now)
+		assert.ErrorIs(t, err, errTimerNotRunning)
+		_, err = timer.resume(now)
+		assert.ErrorIs(t, err, errTimerNotStarted)
+		_, err = timer.extend(now, time.Minute)
+		assert.ErrorIs(t, err, errTimerNotStarted)
+	})
+
+	t.Run("start, pause, extend, and resume a timer", func(t *testing.T) {
+		running := timer.start(now, 5*time.Minute)
+		assert.Equal(t, TimerStatusRunning, running.Status)
+		assert.Equal(t, now.Add(5*time.Minute), *running.EndsAt)
+
+		paused, err := running.pause(now.Add(2 * time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, TimerStatusPaused, paused.Status)
+		assert.Nil(t, paused.EndsAt)
+		assert.Equal(t, (3 * time.Minute).Milliseconds(), paused.Remaining)
+
+		extended, err := paused.extend(now.Add(10*time.Minute), time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, (4 * time.Minute).Milliseconds(), extended.Remaining)
+		assert.Equal(t, (6 * time.Minute).Milliseconds(), extended.Duration)
+
+		resumed, err := extended.resume(now.Add(10 * time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, now.Add(14*time.Minute), *resumed.EndsAt)
+		assert.Equal(t, (time.Minute).Milliseconds(), resumed.withRemaining(now.Add(13*time.Minute)).Remaining)
+	})
+
+	t.Run("pause or extend a timer that has run out", func(t *testing.T) {
+		running := timer.start(now, time.Minute)
+		_, err := running.pause(now.Add(2 * time.Minute))
+		assert.ErrorIs(t, err, errTimerNotRunning)
+		_, err = running.extend(now.Add(2*time.Minute), time.Minute)
+		assert.ErrorIs(t, err, errTimerNotRunning)
+		assert.Equal(t, int64(0), running.withRemaining(now.Add(2*time.Minute)).Remaining)
+	})
+
+	t.Run("validate timer duration", func(t *testing.T) {
+		assert.False(t, validTimerDuration(0))
+		assert.True(t, validTimerDuration(time.Second))
+		assert.False(t, validTimerDuration(3*time.Hour))
+	})
+}
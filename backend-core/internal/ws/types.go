@@ -3,6 +3,7 @@ package ws
 import (
 	"encoding/json"
 
+	"github.com/Wave-95/boards/backend-core/internal/board"
 	"github.com/Wave-95/boards/backend-core/internal/models"
 	"github.com/Wave-95/boards/backend-core/internal/post"
 	"github.com/google/uuid"
@@ -20,6 +21,24 @@ const (
 	// EventBoardDisconnect is when a board is disconnected.
 	EventBoardDisconnect = "board.disconnect"
 
+	// EventBoardUpdate is when a board is updated.
+	EventBoardUpdate = "board.update"
+
+	// EventBoardDelete is when a board is deleted.
+	EventBoardDelete = "board.delete"
+
+	// EventBoardPhase is when a board moves to another retro phase.
+	EventBoardPhase = "board.phase"
+
+	// EventBoardReveal is when the hidden posts of a board are revealed.
+	EventBoardReveal = "board.reveal"
+
+	// EventBoardMemberUpdate is when a board member's role is changed.
+	EventBoardMemberUpdate = "board.member_update"
+
+	// EventBoardMemberRemove is when a member is removed from a board or leaves a board.
+	EventBoardMemberRemove = "board.member_remove"
+
 	// EventPostCreate is when a post is created.
 	EventPostCreate = "post.create"
 
@@ -35,12 +54,69 @@ const (
 	// EventPostFocus is when a post receives focus.
 	EventPostFocus = "post.focus"
 
+	// EventPostVote is when a vote is cast on a post.
+	EventPostVote = "post.vote"
+
+	// EventPostUnvote is when a vote on a post is taken back.
+	EventPostUnvote = "post.unvote"
+
+	// EventPostReact is when an emoji reaction is added to a post.
+	EventPostReact = "post.react"
+
+	// EventPostUnreact is when an emoji reaction on a post is removed.
+	EventPostUnreact = "post.unreact"
+
+	// EventPostTag is when a tag is added to a post.
+	EventPostTag = "post.tag"
+
+	// EventPostUntag is when a tag is removed from a post.
+	EventPostUntag = "post.untag"
+
 	// EventPostGroupUpdate is when a post group is updated.
 	EventPostGroupUpdate = "post_group.update"
 
 	// EventPostGroupDelete is when a post group is deleted.
 	EventPostGroupDelete = "post_group.delete"
 
+	// EventActionItemCreate is when an action item is created on a board.
+	EventActionItemCreate = "action_item.create"
+
+	// EventActionItemUpdate is when an action item is updated.
+	EventActionItemUpdate = "action_item.update"
+
+	// EventActionItemDelete is when an action item is deleted.
+	EventActionItemDelete = "action_item.delete"
+
+	// EventTagCreate is when a tag is added to the tag catalog of a board.
+	EventTagCreate = "tag.create"
+
+	// EventTagDelete is when a tag is removed from the tag catalog of a board.
+	EventTagDelete = "tag.delete"
+
+	// EventCommentCreate is when a comment is added to a post.
+	EventCommentCreate = "comment.create"
+
+	// EventCommentUpdate is when a comment is edited.
+	EventCommentUpdate = "comment.update"
+
+	// EventCommentDelete is when a comment and its replies are deleted.
+	EventCommentDelete = "comment.delete"
+
+	// EventTimerStart is when a board timer is started or resumed.
+	EventTimerStart = "timer.start"
+
+	// EventTimerPause is when a board timer is paused.
+	EventTimerPause = "timer.pause"
+
+	// EventTimerExtend is when time is added to a board timer.
+	EventTimerExtend = "timer.extend"
+
+	// EventTimerReset is when a board timer is reset.
+	EventTimerReset = "timer.reset"
+
+	// EventTimerFinished is when a board timer runs out.
+	EventTimerFinished = "timer.finished"
+
 	// Close Reasons
 
 	// CloseReasonMissingEvent indicates that the event field is missing.
@@ -72,6 +148,62 @@ const (
 	// ErrMsgUnauthorized indicates an unauthorized request.
 	ErrMsgUnauthorized = "Unauthorized."
 
+	// ErrMsgPostNotFound indicates that a post was not found.
+	ErrMsgPostNotFound = "Post not found."
+
+	// ErrMsgPostGroupNotFound indicates that a post group was not found.
+	ErrMsgPostGroupNotFound = "Post group not found."
+
+	// ErrMsgReadOnly indicates that the user does not have write access to the board.
+	ErrMsgReadOnly = "You do not have write access to this board."
+	// ErrMsgCannotComment indicates that the user is not allowed to comment on the posts of the board.
+	ErrMsgCannotComment = "You do not have permission to comment on this board."
+
+	// ErrMsgPhase indicates that the action is not allowed in the board's current phase.
+	ErrMsgPhase = "This action is not allowed in the board's current phase."
+
+	// ErrMsgInvalidPhaseTransition indicates that the board cannot move to the requested phase.
+	ErrMsgInvalidPhaseTransition = "Board can only move to the phase right before or after its current phase."
+
+	// ErrMsgVoteLimitReached indicates that the user has no votes left on the board.
+	ErrMsgVoteLimitReached = "You have used all of your votes on this board."
+
+	// ErrMsgVoteNotFound indicates that the user has no votes on the post to take back.
+	ErrMsgVoteNotFound = "You have not voted on this post."
+
+	// ErrMsgReactionExists indicates that the user has already reacted to the post with the emoji.
+	ErrMsgReactionExists = "You have already reacted to this post with this emoji."
+
+	// ErrMsgReactionNotFound indicates that the user has not reacted to the post with the emoji.
+	ErrMsgReactionNotFound = "You have not reacted to this post with this emoji."
+
+	// ErrMsgTagNotFound indicates that a tag is not in the tag catalog of the board.
+	ErrMsgTagNotFound = "Tag not found."
+
+	// ErrMsgPostTagExists indicates that the tag has already been added to the post.
+	ErrMsgPostTagExists = "This post already has this tag."
+
+	// ErrMsgPostTagNotFound indicates that the tag has not been added to the post.
+	ErrMsgPostTagNotFound = "This post does not have this tag."
+
+	// ErrMsgCommentNotFound indicates that a comment was not found.
+	ErrMsgCommentNotFound = "Comment not found."
+
+	// ErrMsgNotCommentAuthor indicates that only the author of a comment can change it.
+	ErrMsgNotCommentAuthor = "Only the author of a comment can change it."
+
+	// ErrMsgInvalidParentComment indicates that a reply refers to a comment on a different post.
+	ErrMsgInvalidParentComment = "Replies must belong to the same post as the comment they reply to."
+
+	// ErrMsgInvalidTimerDuration indicates that a timer duration is out of range.
+	ErrMsgInvalidTimerDuration = "Timer duration must be between 1 second and 2 hours."
+
+	// ErrMsgTimerNotRunning indicates that the board timer is not running.
+	ErrMsgTimerNotRunning = "The timer is not running."
+
+	// ErrMsgTimerNotStarted indicates that the board timer has not been started.
+	ErrMsgTimerNotStarted = "The timer has not been started."
+
 	// ErrMsgInternalServer indicates an internal server error.
 	ErrMsgInternalServer = "Internal server error."
 )
@@ -93,6 +225,29 @@ type ParamsBoardConnect struct {
 	BoardID string `json:"board_id"`
 }
 
+// RequestBoardPhase represents a request to move a board to another retro phase.
+type RequestBoardPhase struct {
+	Event  string           `json:"event"`
+	Params ParamsBoardPhase `json:"params"`
+}
+
+// ParamsBoardPhase contains the parameters for a board phase change.
+type ParamsBoardPhase struct {
+	BoardID string `json:"board_id"`
+	Phase   string `json:"phase"`
+}
+
+// RequestBoardReveal represents a request to reveal the hidden posts of a board.
+type RequestBoardReveal struct {
+	Event  string            `json:"event"`
+	Params ParamsBoardReveal `json:"params"`
+}
+
+// ParamsBoardReveal contains the parameters for revealing the hidden posts of a board.
+type ParamsBoardReveal struct {
+	BoardID string `json:"board_id"`
+}
+
 // RequestUserAuthenticate represents a request to authenticate a user.
 type RequestUserAuthenticate struct {
 	Event  string                 `json:"event"`
@@ -112,15 +267,16 @@ type RequestPostCreate struct {
 
 // ParamsPostCreate contains the parameters for post creation.
 type ParamsPostCreate struct {
-	BoardID     string  `json:"board_id" validate:"required,uuid"`
-	Content     string  `json:"content"`
-	PosX        int     `json:"pos_x" validate:"required,min=0"`
-	PosY        int     `json:"pos_y" validate:"required,min=0"`
-	Color       string  `json:"color" validate:"required,min=7,max=7"`
-	Height      int     `json:"height" validate:"min=0"`
-	ZIndex      int     `json:"z_index" validate:"min=1"`
-	PostOrder   float64 `json:"post_order"`
-	PostGroupID string  `json:"post_group_id"`
+	BoardID       string  `json:"board_id" validate:"required,uuid"`
+	Content       string  `json:"content" validate:"max=2000"`
+	ContentFormat string  `json:"content_format" validate:"omitempty,oneof=PLAIN MARKDOWN"`
+	PosX          int     `json:"pos_x" validate:"required,min=0"`
+	PosY          int     `json:"pos_y" validate:"required,min=0"`
+	Color         string  `json:"color" validate:"required,min=7,max=7"`
+	Height        int     `json:"height" validate:"min=0"`
+	ZIndex        int     `json:"z_index" validate:"min=1"`
+	PostOrder     float64 `json:"post_order"`
+	PostGroupID   string  `json:"post_group_id"`
 }
 
 // RequestPostUpdate represents a request to update a post.
@@ -173,6 +329,41 @@ type ParamsPostDetach struct {
 	ZIndex  int    `json:"z_index"`
 }
 
+// RequestPostVote represents a request to cast or take back a vote on a post.
+type RequestPostVote struct {
+	Event  string         `json:"event"`
+	Params ParamsPostVote `json:"params"`
+}
+
+// ParamsPostVote contains the parameters for casting or taking back a vote on a post.
+type ParamsPostVote struct {
+	PostID string `json:"post_id"`
+}
+
+// RequestPostReaction represents a request to add or remove an emoji reaction on a post.
+type RequestPostReaction struct {
+	Event  string             `json:"event"`
+	Params ParamsPostReaction `json:"params"`
+}
+
+// ParamsPostReaction contains the parameters for adding or removing an emoji reaction on a post.
+type ParamsPostReaction struct {
+	PostID string `json:"post_id"`
+	Emoji  string `json:"emoji"`
+}
+
+// RequestPostTag represents a request to add a tag to a post or remove a tag from a post.
+type RequestPostTag struct {
+	Event  string        `json:"event"`
+	Params ParamsPostTag `json:"params"`
+}
+
+// ParamsPostTag contains the parameters for adding a tag to a post or removing a tag from a post.
+type ParamsPostTag struct {
+	PostID string `json:"post_id"`
+	TagID  string `json:"tag_id"`
+}
+
 // RequestPostGroupUpdate represents a request to update a post group.
 type RequestPostGroupUpdate struct {
 	Event  string                `json:"event"`
@@ -196,6 +387,79 @@ type ParamsPostGroupDelete struct {
 	PostGroupID string `json:"post_group_id" validate:"required,uuid"`
 }
 
+// RequestCommentCreate represents a request to comment on a post.
+type RequestCommentCreate struct {
+	Event  string              `json:"event"`
+	Params ParamsCommentCreate `json:"params"`
+}
+
+// ParamsCommentCreate contains the parameters for commenting on a post. ParentID is set when replying to
+// another comment.
+type ParamsCommentCreate struct {
+	PostID   string `json:"post_id"`
+	ParentID string `json:"parent_id"`
+	Content  string `json:"content"`
+}
+
+// RequestCommentUpdate represents a request to edit a comment.
+type RequestCommentUpdate struct {
+	Event  string              `json:"event"`
+	Params ParamsCommentUpdate `json:"params"`
+}
+
+// ParamsCommentUpdate contains the parameters for editing a comment.
+type ParamsCommentUpdate struct {
+	CommentID string `json:"comment_id"`
+	Content   string `json:"content"`
+}
+
+// RequestCommentDelete represents a request to delete a comment.
+type RequestCommentDelete struct {
+	Event  string              `json:"event"`
+	Params ParamsCommentDelete `json:"params"`
+}
+
+// ParamsCommentDelete contains the parameters for deleting a comment.
+type ParamsCommentDelete struct {
+	CommentID string `json:"comment_id"`
+}
+
+// RequestTimerStart represents a request to start or resume a board timer.
+type RequestTimerStart struct {
+	Event  string           `json:"event"`
+	Params ParamsTimerStart `json:"params"`
+}
+
+// ParamsTimerStart contains the parameters for starting a board timer. A paused timer is resumed when no
+// duration is given.
+type ParamsTimerStart struct {
+	BoardID  string `json:"board_id"`
+	Duration int    `json:"duration"`
+}
+
+// RequestTimerExtend represents a request to add time to a board timer.
+type RequestTimerExtend struct {
+	Event  string            `json:"event"`
+	Params ParamsTimerExtend `json:"params"`
+}
+
+// ParamsTimerExtend contains the parameters for extending a board timer.
+type ParamsTimerExtend struct {
+	BoardID  string `json:"board_id"`
+	Duration int    `json:"duration"`
+}
+
+// RequestTimer represents a request to pause or reset a board timer.
+type RequestTimer struct {
+	Event  string      `json:"event"`
+	Params ParamsTimer `json:"params"`
+}
+
+// ParamsTimer contains the parameters for pausing or resetting a board timer.
+type ParamsTimer struct {
+	BoardID string `json:"board_id"`
+}
+
 // ResponseBase represents the base response structure.
 type ResponseBase struct {
 	Event        string `json:"event"`
@@ -224,9 +488,85 @@ type ResponseBoardConnect struct {
 
 // ResultBoardConnect contains the result of board connection.
 type ResultBoardConnect struct {
-	BoardID        string        `json:"board_id"`
-	NewUser        models.User   `json:"new_user"`
-	ConnectedUsers []models.User `json:"connected_users"`
+	BoardID        string            `json:"board_id"`
+	NewUser        models.User       `json:"new_user"`
+	ConnectedUsers []models.User     `json:"connected_users"`
+	CanWrite       bool              `json:"can_write"`
+	CanComment     bool              `json:"can_comment"`
+	Phase          models.BoardPhase `json:"phase"`
+	Timer          *Timer            `json:"timer"`
+}
+
+// ResponseBoardUpdate represents the response for a board update.
+type ResponseBoardUpdate struct {
+	ResponseBase
+	Result models.Board `json:"result,omitempty"`
+}
+
+// ResponseBoardDelete represents the response for a board deletion.
+type ResponseBoardDelete struct {
+	ResponseBase
+	Result ResultBoardDelete `json:"result,omitempty"`
+}
+
+// ResultBoardDelete contains the result of a board deletion.
+type ResultBoardDelete struct {
+	BoardID string `json:"board_id"`
+}
+
+// ResponseBoardPhase represents the response for a board phase change.
+type ResponseBoardPhase struct {
+	ResponseBase
+	Result ResultBoardPhase `json:"result,omitempty"`
+}
+
+// ResultBoardPhase contains the result of a board phase change.
+type ResultBoardPhase struct {
+	BoardID string            `json:"board_id"`
+	Phase   models.BoardPhase `json:"phase"`
+}
+
+// ResponseBoardReveal represents the response for revealing the hidden posts of a board.
+type ResponseBoardReveal struct {
+	ResponseBase
+	Result ResultBoardReveal `json:"result,omitempty"`
+}
+
+// ResultBoardReveal contains the full contents of every post on a board that was revealed.
+type ResultBoardReveal struct {
+	BoardID string        `json:"board_id"`
+	Posts   []models.Post `json:"posts"`
+}
+
+// ResponseTimer represents the response for a board timer change.
+type ResponseTimer struct {
+	ResponseBase
+	Result Timer `json:"result,omitempty"`
+}
+
+// ResponseBoardMemberUpdate represents the response for a board member role change.
+type ResponseBoardMemberUpdate struct {
+	ResponseBase
+	Result ResultBoardMemberUpdate `json:"result,omitempty"`
+}
+
+// ResultBoardMemberUpdate contains the result of a board member role change.
+type ResultBoardMemberUpdate struct {
+	BoardID string          `json:"board_id"`
+	UserID  string          `json:"user_id"`
+	Member  board.MemberDTO `json:"member"`
+}
+
+// ResponseBoardMemberRemove represents the response for a board member removal.
+type ResponseBoardMemberRemove struct {
+	ResponseBase
+	Result ResultBoardMemberRemove `json:"result,omitempty"`
+}
+
+// ResultBoardMemberRemove contains the result of a board member removal.
+type ResultBoardMemberRemove struct {
+	BoardID string `json:"board_id"`
+	UserID  string `json:"user_id"`
 }
 
 // ResponsePostCreate represents the response for creating a new post.
@@ -269,6 +609,66 @@ type ResponsePostDelete struct {
 	Result models.Post `json:"result,omitempty"`
 }
 
+// ResponsePostVote represents the response for casting or taking back a vote on a post.
+type ResponsePostVote struct {
+	ResponseBase
+	Result ResultPostVote `json:"result,omitempty"`
+}
+
+// ResultPostVote contains the vote counts of a post. Votes is nil while the vote counts of the board are hidden,
+// and UserVotes and RemainingVotes are only sent to the user who voted.
+type ResultPostVote struct {
+	PostID         string `json:"post_id"`
+	Votes          *int   `json:"votes"`
+	UserVotes      *int   `json:"user_votes,omitempty"`
+	RemainingVotes *int   `json:"remaining_votes,omitempty"`
+}
+
+// ResponsePostReaction represents the response for adding or removing an emoji reaction on a post.
+type ResponsePostReaction struct {
+	ResponseBase
+	Result ResultPostReaction `json:"result,omitempty"`
+}
+
+// ResultPostReaction contains the reaction that was added or removed along with the reaction summaries of the post.
+type ResultPostReaction struct {
+	PostID    string                 `json:"post_id"`
+	UserID    string                 `json:"user_id"`
+	Emoji     string                 `json:"emoji"`
+	Reactions []post.ReactionSummary `json:"reactions"`
+}
+
+// ResponsePostTag represents the response for adding a tag to a post or removing a tag from a post.
+type ResponsePostTag struct {
+	ResponseBase
+	Result ResultPostTag `json:"result,omitempty"`
+}
+
+// ResultPostTag contains the tag that was added or removed along with the IDs of all the tags on the post.
+type ResultPostTag struct {
+	PostID string      `json:"post_id"`
+	TagID  string      `json:"tag_id"`
+	TagIDs []uuid.UUID `json:"tag_ids"`
+}
+
+// ResponseTag represents the response for adding a tag to or removing a tag from the tag catalog of a board.
+type ResponseTag struct {
+	ResponseBase
+	Result models.BoardTag `json:"result,omitempty"`
+}
+
+// ResponseActionItem represents the response for creating, updating, or deleting an action item.
+type ResponseActionItem struct {
+	ResponseBase
+	Result models.ActionItem `json:"result,omitempty"`
+}
+
+// ResponseComment represents the response for creating, editing, or deleting a comment.
+type ResponseComment struct {
+	ResponseBase
+	Result models.PostComment `json:"result,omitempty"`
+}
+
 // ResponsePostFocus represents the response for post focusing.
 type ResponsePostFocus struct {
 	ResponseBase
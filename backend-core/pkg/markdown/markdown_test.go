@@ -0,0 +1,25 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHTML(t *testing.T) {
+	t.Run("render markdown", func(t *testing.T) {
+		html, err := ToHTML("**Ship it** and *celebrate*\n\n- [docs](https://example.com)")
+		assert.NoError(t, err)
+		assert.Contains(t, html, "<strong>Ship it</strong>")
+		assert.Contains(t, html, "<em>celebrate</em>")
+		assert.Contains(t, html, `<a href="https://example.com" rel="nofollow">docs</a>`)
+	})
+
+	t.Run("sanitize html", func(t *testing.T) {
+		html, err := ToHTML("<script>alert(1)</script>\n\n[link](javascript:alert(1)) <img src=x onerror=alert(1)>")
+		assert.NoError(t, err)
+		assert.NotContains(t, html, "<script")
+		assert.NotContains(t, html, "javascript:")
+		assert.NotContains(t, html, "onerror")
+	})
+}
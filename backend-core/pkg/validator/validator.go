@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"unicode"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -16,9 +17,30 @@ type Validate struct {
 // New initializes a new validator.
 func New() Validate {
 	validate := validator.New()
+	if err := validate.RegisterValidation("emoji", isEmoji); err != nil {
+		panic(fmt.Sprintf("validator: failed to register emoji validation: %v", err))
+	}
 	return Validate{validate}
 }
 
+// isEmoji checks if a string field holds a single emoji, including emoji that are joined from several code
+// points such as flags, skin tones, and zero width joiner sequences.
+func isEmoji(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	hasSymbol := false
+	for _, r := range value {
+		switch {
+		case unicode.Is(unicode.So, r):
+			hasSymbol = true
+		case unicode.Is(unicode.Sk, r), unicode.Is(unicode.Mn, r), r == '\u200d':
+			// Skin tone modifiers, variation selectors, and zero width joiners only decorate other symbols
+		default:
+			return false
+		}
+	}
+	return hasSymbol
+}
+
 // GetValidationErrMsg checks to see if the provided err is a validation error and
 // returns the first validation error message.
 func GetValidationErrMsg(s interface{}, err error) (errMsg string) {
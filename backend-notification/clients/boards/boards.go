@@ -6,15 +6,20 @@ import (
 	"net/http"
 )
 
+// headerServiceToken is the header that the boards service reads the shared service token from.
+const headerServiceToken = "X-Service-Token"
+
 type BoardsClient struct {
-	BaseURL    string
-	HTTPClient *http.Client
+	BaseURL      string
+	ServiceToken string
+	HTTPClient   *http.Client
 }
 
-func NewClient(baseURL string) *BoardsClient {
+func NewClient(baseURL, serviceToken string) *BoardsClient {
 	return &BoardsClient{
-		BaseURL:    baseURL,
-		HTTPClient: &http.Client{},
+		BaseURL:      baseURL,
+		ServiceToken: serviceToken,
+		HTTPClient:   &http.Client{},
 	}
 }
 
@@ -26,6 +31,16 @@ func (c *BoardsClient) GetInvite(inviteID string) (*http.Response, error) {
 	return c.makeRequest("GET", "/invites/"+inviteID, nil)
 }
 
+// RemindInvites asks the boards service to publish reminders for pending invites that are about to expire.
+func (c *BoardsClient) RemindInvites() (*http.Response, error) {
+	return c.makeRequest("POST", "/invites/reminders", nil)
+}
+
+// ExpireInvites asks the boards service to expire pending invites that are past their expiry.
+func (c *BoardsClient) ExpireInvites() (*http.Response, error) {
+	return c.makeRequest("POST", "/invites/expirations", nil)
+}
+
 // makeRequest sends an HTTP request and returns the response.
 func (c *BoardsClient) makeRequest(method, endpoint string, payload interface{}) (*http.Response, error) {
 	url := c.BaseURL + endpoint
@@ -41,6 +56,7 @@ func (c *BoardsClient) makeRequest(method, endpoint string, payload interface{})
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerServiceToken, c.ServiceToken)
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
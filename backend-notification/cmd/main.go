@@ -8,6 +8,7 @@ import (
 	"github.com/Wave-95/boards/backend-notification/constants/queues"
 	"github.com/Wave-95/boards/backend-notification/internal/config"
 	"github.com/Wave-95/boards/backend-notification/internal/handlers"
+	"github.com/Wave-95/boards/backend-notification/internal/jobs"
 	"github.com/Wave-95/boards/wrappers/amqp"
 )
 
@@ -25,7 +26,11 @@ func main() {
 	amqp.Declare(queues.Notification, 10000, true) //10s ttl with dlx
 
 	emailClient := email.NewClient(cfg.Email.From, cfg.Email.Password, cfg.Email.Host, cfg.Email.Port)
-	boardsClient := boards.NewClient(cfg.BoardsBaseURL)
+	boardsClient := boards.NewClient(cfg.BoardsBaseURL, cfg.ServiceToken)
+
+	// Run invite reminders and expirations in the background
+	inviteJob := jobs.NewInviteJob(boardsClient, cfg.InviteJobInterval)
+	go inviteJob.Run()
 
 	taskHandler := handlers.New(emailClient, boardsClient, amqp)
 	err = taskHandler.Run()
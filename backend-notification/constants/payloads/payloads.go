@@ -4,14 +4,42 @@ type Invite struct {
 	ID string `json:"id"`
 }
 
+type SignupInvite struct {
+	Email      string `json:"email"`
+	SenderName string `json:"sender_name"`
+	BoardName  string `json:"board_name"`
+}
+
 type User struct {
 	ID    string `json:"id"`
 	Name  string `json:"name"`
 	Email string `json:"email"`
 }
 
+type OwnershipTransfer struct {
+	BoardID       string `json:"board_id"`
+	BoardName     string `json:"board_name"`
+	PreviousOwner User   `json:"previous_owner"`
+	NewOwner      User   `json:"new_owner"`
+}
+
 type EmailVerification struct {
 	Email string `json:"email"`
 	Name  string `json:"name"`
 	Code  string `json:"code"`
 }
+
+type AccessRequest struct {
+	BoardID   string `json:"board_id"`
+	BoardName string `json:"board_name"`
+	Requester User   `json:"requester"`
+	Admins    []User `json:"admins"`
+}
+
+type Mention struct {
+	BoardID     string `json:"board_id"`
+	BoardName   string `json:"board_name"`
+	MentionedBy string `json:"mentioned_by"`
+	InComment   bool   `json:"in_comment"`
+	Users       []User `json:"users"`
+}
@@ -3,8 +3,13 @@ package tasks
 import "encoding/json"
 
 const (
-	EmailInvite       = "task_email_invite"
-	EmailVerification = "task_email_verification"
+	EmailInvite         = "task_email_invite"
+	EmailInviteReminder = "task_email_invite_reminder"
+	EmailVerification   = "task_email_verification"
+	EmailOwnership      = "task_email_ownership"
+	EmailSignupInvite   = "task_email_signup_invite"
+	EmailAccessRequest  = "task_email_access_request"
+	EmailMention        = "task_email_mention"
 )
 
 type PublishMessage struct {
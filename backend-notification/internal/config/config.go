@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/joho/godotenv"
@@ -20,6 +21,10 @@ const (
 	keyEmailPassword = "EMAIL_PASSWORD"
 
 	keyBoardsBaseURL = "BOARDS_BASE_URL"
+	keyServiceToken  = "SERVICE_TOKEN"
+
+	keyInviteJobInterval     = "INVITE_JOB_INTERVAL"
+	defaultInviteJobInterval = time.Hour
 
 	keyEnv    = "ENV"
 	valEnvDev = "DEVELOPMENT"
@@ -27,9 +32,11 @@ const (
 
 // Config encapsulates all the server configuration values.
 type Config struct {
-	Amqp          AmqpConfig
-	Email         EmailConfig
-	BoardsBaseURL string
+	Amqp              AmqpConfig
+	Email             EmailConfig
+	BoardsBaseURL     string
+	ServiceToken      string
+	InviteJobInterval time.Duration
 }
 
 // Load looks for config values in environment table and .env files (development), and sets them
@@ -55,11 +62,22 @@ func Load(file string) (*Config, error) {
 	}
 
 	boardsBaseURL := os.Getenv(keyBoardsBaseURL)
+	serviceToken := os.Getenv(keyServiceToken)
+	if serviceToken == "" {
+		return nil, fmt.Errorf("missing %s env var", keyServiceToken)
+	}
+
+	inviteJobInterval, err := getInviteJobInterval()
+	if err != nil {
+		return nil, err
+	}
 
 	return &Config{
-		Amqp:          amqpConfig,
-		Email:         emailConfig,
-		BoardsBaseURL: boardsBaseURL,
+		Amqp:              amqpConfig,
+		Email:             emailConfig,
+		BoardsBaseURL:     boardsBaseURL,
+		ServiceToken:      serviceToken,
+		InviteJobInterval: inviteJobInterval,
 	}, nil
 }
 
@@ -130,3 +148,16 @@ func getEmailConfig() (EmailConfig, error) {
 
 	return cfg, nil
 }
+
+// getInviteJobInterval looks for the invite job interval env var and falls back to a default interval.
+func getInviteJobInterval() (time.Duration, error) {
+	interval := os.Getenv(keyInviteJobInterval)
+	if interval == "" {
+		return defaultInviteJobInterval, nil
+	}
+	duration, err := time.ParseDuration(interval)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid invite job interval: %v", interval)
+	}
+	return duration, nil
+}
@@ -14,6 +14,9 @@ import (
 	"github.com/Wave-95/boards/wrappers/amqp"
 )
 
+// statusPending is the status of a board invite that has not been answered yet.
+const statusPending = "PENDING"
+
 type TaskHandler struct {
 	emailClient  *email.EmailClient
 	boardsClient *boards.BoardsClient
@@ -26,7 +29,12 @@ func New(emailClient *email.EmailClient, boardsClient *boards.BoardsClient, amqp
 
 func (th *TaskHandler) RegisterHandlers() {
 	th.amqp.AddHandler(tasks.EmailInvite, th.emailInviteHandler)
+	th.amqp.AddHandler(tasks.EmailInviteReminder, th.emailInviteReminderHandler)
 	th.amqp.AddHandler(tasks.EmailVerification, th.emailVerificationHandler)
+	th.amqp.AddHandler(tasks.EmailOwnership, th.emailOwnershipHandler)
+	th.amqp.AddHandler(tasks.EmailSignupInvite, th.emailSignupInviteHandler)
+	th.amqp.AddHandler(tasks.EmailAccessRequest, th.emailAccessRequestHandler)
+	th.amqp.AddHandler(tasks.EmailMention, th.emailMentionHandler)
 }
 
 func (th *TaskHandler) Run() error {
@@ -75,3 +83,117 @@ func (th *TaskHandler) emailInviteHandler(payload []byte) error {
 	emailBody := templates.BuildEmailInvite(inviteResponse.Receiver.Email, inviteResponse.Receiver.Name, inviteResponse.Sender.Name)
 	return th.emailClient.Send(inviteResponse.Receiver.Email, emailBody)
 }
+
+// emailInviteReminderHandler reminds the receiver of a pending invite that the invite is about to expire.
+// Invites that have been answered since the reminder was scheduled are skipped.
+func (th *TaskHandler) emailInviteReminderHandler(payload []byte) error {
+	var invite payloads.Invite
+	err := json.Unmarshal(payload, &invite)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	resp, err := th.boardsClient.GetInvite(invite.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get invite details: %w", err)
+	}
+	defer resp.Body.Close()
+	inviteData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read invite details: %w", err)
+	}
+
+	var inviteResponse InviteResponse
+	err = json.Unmarshal(inviteData, &inviteResponse)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal invite details: %w", err)
+	}
+	if inviteResponse.Status != statusPending || inviteResponse.ExpiresAt == nil || inviteResponse.Receiver.Email == "" {
+		return nil
+	}
+
+	emailBody := templates.BuildEmailInviteReminder(inviteResponse.Receiver.Email, inviteResponse.Receiver.Name, inviteResponse.Sender.Name, *inviteResponse.ExpiresAt)
+	return th.emailClient.Send(inviteResponse.Receiver.Email, emailBody)
+}
+
+// emailSignupInviteHandler sends an invite to an email that does not belong to a user yet. The email
+// contains a signup link, and the invite shows up on the dashboard once the recipient signs up.
+func (th *TaskHandler) emailSignupInviteHandler(payload []byte) error {
+	var invite payloads.SignupInvite
+	err := json.Unmarshal(payload, &invite)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	emailBody := templates.BuildEmailSignupInvite(invite.Email, invite.SenderName, invite.BoardName)
+	if err := th.emailClient.Send(invite.Email, emailBody); err != nil {
+		return fmt.Errorf("failed to send signup invite email: %w", err)
+	}
+	return nil
+}
+
+// emailOwnershipHandler notifies both the previous and the new owner of a board that ownership of the
+// board has been transferred. Users without an email address, such as guests, are skipped.
+func (th *TaskHandler) emailOwnershipHandler(payload []byte) error {
+	var transfer payloads.OwnershipTransfer
+	err := json.Unmarshal(payload, &transfer)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	if transfer.PreviousOwner.Email != "" {
+		emailBody := templates.BuildEmailOwnershipTransferred(transfer.PreviousOwner.Email, transfer.PreviousOwner.Name, transfer.NewOwner.Name, transfer.BoardName)
+		if err := th.emailClient.Send(transfer.PreviousOwner.Email, emailBody); err != nil {
+			return fmt.Errorf("failed to send ownership email to previous owner: %w", err)
+		}
+	}
+	if transfer.NewOwner.Email != "" {
+		emailBody := templates.BuildEmailOwnershipReceived(transfer.NewOwner.Email, transfer.NewOwner.Name, transfer.PreviousOwner.Name, transfer.BoardName)
+		if err := th.emailClient.Send(transfer.NewOwner.Email, emailBody); err != nil {
+			return fmt.Errorf("failed to send ownership email to new owner: %w", err)
+		}
+	}
+	return nil
+}
+
+// emailAccessRequestHandler notifies the admins of a board that a user has requested access to the board.
+// Admins without an email address are skipped.
+func (th *TaskHandler) emailAccessRequestHandler(payload []byte) error {
+	var request payloads.AccessRequest
+	err := json.Unmarshal(payload, &request)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	for _, admin := range request.Admins {
+		if admin.Email == "" {
+			continue
+		}
+		emailBody := templates.BuildEmailAccessRequest(admin.Email, admin.Name, request.Requester.Name, request.BoardID, request.BoardName)
+		if err := th.emailClient.Send(admin.Email, emailBody); err != nil {
+			return fmt.Errorf("failed to send access request email: %w", err)
+		}
+	}
+	return nil
+}
+
+// emailMentionHandler notifies the users that were mentioned in a post or a comment. Users without an email
+// address are skipped.
+func (th *TaskHandler) emailMentionHandler(payload []byte) error {
+	var mention payloads.Mention
+	err := json.Unmarshal(payload, &mention)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	for _, user := range mention.Users {
+		if user.Email == "" {
+			continue
+		}
+		emailBody := templates.BuildEmailMention(user.Email, user.Name, mention.MentionedBy, mention.BoardID, mention.BoardName, mention.InComment)
+		if err := th.emailClient.Send(user.Email, emailBody); err != nil {
+			return fmt.Errorf("failed to send mention email: %w", err)
+		}
+	}
+	return nil
+}
@@ -1,13 +1,17 @@
 package handlers
 
+import "time"
+
 type CreateEmailVerificationInput struct {
 	UserID string `json:"user_id"`
 	Code   string `json:"code"`
 }
 
 type InviteResponse struct {
-	Sender   User `json:"sender"`
-	Receiver User `json:"receiver"`
+	Sender    User       `json:"sender"`
+	Receiver  User       `json:"receiver"`
+	Status    string     `json:"status"`
+	ExpiresAt *time.Time `json:"expires_at"`
 }
 
 type User struct {
@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Wave-95/boards/backend-notification/clients/boards"
+)
+
+// InviteJob periodically asks the boards service to send one reminder for pending invites that are about
+// to expire and to flip pending invites that are past their expiry to EXPIRED.
+type InviteJob struct {
+	boardsClient *boards.BoardsClient
+	interval     time.Duration
+}
+
+func NewInviteJob(boardsClient *boards.BoardsClient, interval time.Duration) InviteJob {
+	return InviteJob{boardsClient: boardsClient, interval: interval}
+}
+
+// Run is a blocking operation that runs the job right away and then on every interval.
+func (j *InviteJob) Run() {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		if err := j.runOnce(); err != nil {
+			log.Printf("invite job: %v", err)
+		}
+		<-ticker.C
+	}
+}
+
+// runOnce sends the reminders before expiring invites so that an invite is never reminded after it expired.
+func (j *InviteJob) runOnce() error {
+	resp, err := j.boardsClient.RemindInvites()
+	if err != nil {
+		return fmt.Errorf("failed to remind invites: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to remind invites: unexpected status %d", resp.StatusCode)
+	}
+
+	resp, err = j.boardsClient.ExpireInvites()
+	if err != nil {
+		return fmt.Errorf("failed to expire invites: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to expire invites: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package templates
 
-import "os"
+import (
+	"net/url"
+	"os"
+	"time"
+)
 
 func BuildEmailVerification(to string, name string, code string) []byte {
 	frontendURL := os.Getenv("FRONTEND_URL")
@@ -25,3 +29,83 @@ func BuildEmailInvite(to string, receiverName string, senderName string) []byte
 
 	return msg
 }
+
+func BuildEmailInviteReminder(to string, receiverName string, senderName string, expiresAt time.Time) []byte {
+	frontendURL := os.Getenv("FRONTEND_URL")
+	link := frontendURL + "/dashboard"
+	msg := []byte("To: " + to + "\r\n" +
+		"Subject: Boards: Your board invite is about to expire\r\n" +
+		"\r\n" +
+		"Hi " + receiverName + ",\n\n" +
+		"Your invite to a board from " + senderName + " expires on " + expiresAt.UTC().Format("January 2, 2006 at 15:04 UTC") + ". You can accept or ignore the invitation from your dashboard: " + link + "\r\n")
+
+	return msg
+}
+
+func BuildEmailSignupInvite(to string, senderName string, boardName string) []byte {
+	frontendURL := os.Getenv("FRONTEND_URL")
+	link := frontendURL + "/auth/signup?email=" + url.QueryEscape(to)
+	msg := []byte("To: " + to + "\r\n" +
+		"Subject: Boards: You've been invited to a board!\r\n" +
+		"\r\n" +
+		"Hi,\n\n" +
+		"You've been invited to the board " + boardName + " by " + senderName + ". Sign up with this email address to accept or ignore the invitation from your dashboard: " + link + "\r\n")
+
+	return msg
+}
+
+func BuildEmailOwnershipTransferred(to string, previousOwnerName string, newOwnerName string, boardName string) []byte {
+	frontendURL := os.Getenv("FRONTEND_URL")
+	link := frontendURL + "/dashboard"
+	msg := []byte("To: " + to + "\r\n" +
+		"Subject: Boards: Ownership of " + boardName + " has been transferred\r\n" +
+		"\r\n" +
+		"Hi " + previousOwnerName + ",\n\n" +
+		"Ownership of your board " + boardName + " has been transferred to " + newOwnerName + ". The board is still available from your dashboard: " + link + "\r\n")
+
+	return msg
+}
+
+func BuildEmailOwnershipReceived(to string, newOwnerName string, previousOwnerName string, boardName string) []byte {
+	frontendURL := os.Getenv("FRONTEND_URL")
+	link := frontendURL + "/dashboard"
+	msg := []byte("To: " + to + "\r\n" +
+		"Subject: Boards: You are now the owner of " + boardName + "\r\n" +
+		"\r\n" +
+		"Hi " + newOwnerName + ",\n\n" +
+		"Ownership of the board " + boardName + " has been transferred to you from " + previousOwnerName + ". You can find the board on your dashboard: " + link + "\r\n")
+
+	return msg
+}
+
+func BuildEmailAccessRequest(to string, adminName string, requesterName string, boardID string, boardName string) []byte {
+	frontendURL := os.Getenv("FRONTEND_URL")
+	link := frontendURL + "/boards/" + boardID
+	msg := []byte("To: " + to + "\r\n" +
+		"Subject: Boards: " + requesterName + " requested access to " + boardName + "\r\n" +
+		"\r\n" +
+		"Hi " + adminName + ",\n\n" +
+		requesterName + " has requested access to your board " + boardName + ". You can approve or deny the request from the board: " + link + "\r\n")
+
+	return msg
+}
+
+func BuildEmailMention(to string, name string, mentionedByName string, boardID string, boardName string, inComment bool) []byte {
+	frontendURL := os.Getenv("FRONTEND_URL")
+	link := frontendURL + "/boards/" + boardID
+	// The author of a post on an anonymous board is not named
+	if mentionedByName == "" {
+		mentionedByName = "Someone"
+	}
+	source := "a post"
+	if inComment {
+		source = "a comment"
+	}
+	msg := []byte("To: " + to + "\r\n" +
+		"Subject: Boards: " + mentionedByName + " mentioned you in " + boardName + "\r\n" +
+		"\r\n" +
+		"Hi " + name + ",\n\n" +
+		mentionedByName + " mentioned you in " + source + " on the board " + boardName + ". You can view it on the board: " + link + "\r\n")
+
+	return msg
+}